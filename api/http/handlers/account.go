@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+type EmailInput struct {
+	Email string `json:"email" validate:"required,email" example:"test@example.com"`
+}
+
+type ResetPasswordInput struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required" example:"1234Test@"`
+}
+
+type VerifyEmailInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ForgotPassword sends a password reset email
+// @Summary Forgot password
+// @Description emails a single-use password reset link; the response is the same whether or not the address is registered
+// @Tags Authentication
+// @Accept  json
+// @Produce json
+// @Param   body  body      EmailInput  true  "Account email"
+// @Success 200
+// @Failure 400
+// @Failure 429
+// @Failure 500
+// @Router /password/forgot [post]
+func ForgotPassword(accountService *services.AccountService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input EmailInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing forgot password request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing forgot password request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating forgot password request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		if err := accountService.ForgotPassword(c.UserContext(), input.Email); err != nil {
+			log.ErrorLog.Printf("Error sending password reset email: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "If the email is registered, a password reset link has been sent", nil)
+	}
+}
+
+// ResetPassword sets a new password
+// @Summary Reset password
+// @Description sets a new password with a token from the password reset email and logs out all sessions
+// @Tags Authentication
+// @Accept  json
+// @Produce json
+// @Param   body  body      ResetPasswordInput  true  "Reset token and new password"
+// @Success 200
+// @Failure 400
+// @Failure 500
+// @Router /password/reset [post]
+func ResetPassword(accountService *services.AccountService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input ResetPasswordInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing reset password request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing reset password request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating reset password request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		if err := accountService.ResetPassword(c.UserContext(), input.Token, input.Password); err != nil {
+			log.ErrorLog.Printf("Error resetting password: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Password reset successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
+
+// VerifyEmail confirms the email address of a user
+// @Summary Verify email
+// @Description marks the email of the user as verified with a token from the verification email, the user then joins the boards the email was invited to
+// @Tags Authentication
+// @Accept  json
+// @Produce json
+// @Param   body  body      VerifyEmailInput  true  "Verification token"
+// @Success 200
+// @Failure 400
+// @Failure 500
+// @Router /email/verify [post]
+func VerifyEmail(accountService *services.AccountService, invitationService *services.BoardInvitationService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input VerifyEmailInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing verify email request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing verify email request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating verify email request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := accountService.VerifyEmail(c.UserContext(), input.Token)
+		if err != nil {
+			log.ErrorLog.Printf("Error verifying email: %v\n", err)
+			return SendError(c, err)
+		}
+		if err = invitationService.JoinInvitedBoards(c.UserContext(), userID); err != nil {
+			// the email is verified already, the invitations can still be accepted from their links
+			log.ErrorLog.Printf("Error joining invited boards: %v\n", err)
+		}
+
+		msg := "Email verified successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
+
+// ResendVerificationEmail sends a new verification email
+// @Summary Resend verification email
+// @Description emails a new verification link; the response is the same whether or not the address is registered
+// @Tags Authentication
+// @Accept  json
+// @Produce json
+// @Param   body  body      EmailInput  true  "Account email"
+// @Success 200
+// @Failure 400
+// @Failure 429
+// @Failure 500
+// @Router /email/verify/resend [post]
+func ResendVerificationEmail(accountService *services.AccountService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input EmailInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing resend verification request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing resend verification request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating resend verification request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		if err := accountService.ResendVerificationEmail(c.UserContext(), input.Email); err != nil {
+			log.ErrorLog.Printf("Error resending verification email: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "If the email is registered and not verified, a verification link has been sent", nil)
+	}
+}
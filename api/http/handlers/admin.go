@@ -0,0 +1,543 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidRoleIDParam  = fiber.NewError(fiber.StatusBadRequest, "invalid role id")
+	ErrInvalidDisabledFlag = fiber.NewError(fiber.StatusBadRequest, "disabled must be true or false")
+	ErrInvalidAuditFilter  = fiber.NewError(fiber.StatusBadRequest, "invalid audit log filter, ids must be numbers and dates YYYY-MM-DD")
+)
+
+// AdminGetUsers lists the users
+// @Summary List users
+// @Description lists every user, filtered by name or email and by disabled state
+// @Tags Admin
+// @Produce json
+// @Param   search     query  string  false  "Search in user names and emails"
+// @Param   disabled   query  bool    false  "Only disabled or only enabled users"
+// @Param   page       query  int     false  "Page number"
+// @Param   page_size  query  int     false  "Page size"
+// @Success 200 {array} presenter.AdminUserPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /admin/users [get]
+// @Security ApiKeyAuth
+func AdminGetUsers(adminService *services.AdminService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		page, pageSize := PageAndPageSize(c)
+
+		filter := domains.UserFilter{Search: c.Query("search")}
+		if c.Query("disabled") != "" {
+			disabled, err := strconv.ParseBool(c.Query("disabled"))
+			if err != nil {
+				log.ErrorLog.Printf("Error parsing disabled flag: %v\n", err)
+				return SendError(c, ErrInvalidDisabledFlag)
+			}
+			filter.Disabled = &disabled
+		}
+
+		users, total, err := adminService.GetUsers(c.UserContext(), filter, uint(page), uint(pageSize))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting users: %v\n", err)
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Println("Users loaded successfully")
+		return SendSuccessPaginateResponse(c, "Successfully fetched.", presenter.NewAdminUserPresenters(users),
+			uint(page), uint(pageSize), total)
+	}
+}
+
+// AdminGetUser gets a user
+// @Summary Get user
+// @Description gets a user with the account state
+// @Tags Admin
+// @Produce json
+// @Param   id  path  string  true  "User ID"
+// @Success 200 {object} presenter.AdminUserPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /admin/users/{id} [get]
+// @Security ApiKeyAuth
+func AdminGetUser(adminService *services.AdminService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing user id: %v\n", errParam)
+			return SendError(c, ErrInvalidUserIDParam)
+		}
+
+		user, err := adminService.GetUser(c.UserContext(), uint(id))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "User loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewAdminUserPresenter(*user))
+	}
+}
+
+// AdminDisableUser disables a user
+// @Summary Disable user
+// @Description disables the account and ends its sessions, disabled users can not log in or use their tokens
+// @Tags Admin
+// @Produce json
+// @Param   id  path  string  true  "User ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /admin/users/{id}/disable [post]
+// @Security ApiKeyAuth
+func AdminDisableUser(adminService *services.AdminService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing user id: %v\n", errParam)
+			return SendError(c, ErrInvalidUserIDParam)
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = adminService.DisableUser(c.UserContext(), actorID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error disabling user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Printf("User %d disabled by admin %d\n", id, actorID)
+		return SendSuccessResponse(c, "User disabled successfully", id)
+	}
+}
+
+// AdminEnableUser enables a disabled user
+// @Summary Enable user
+// @Description enables a disabled account again
+// @Tags Admin
+// @Produce json
+// @Param   id  path  string  true  "User ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /admin/users/{id}/enable [post]
+// @Security ApiKeyAuth
+func AdminEnableUser(adminService *services.AdminService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing user id: %v\n", errParam)
+			return SendError(c, ErrInvalidUserIDParam)
+		}
+
+		if err := adminService.EnableUser(c.UserContext(), uint(id)); err != nil {
+			log.ErrorLog.Printf("Error enabling user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Printf("User %d enabled by an admin\n", id)
+		return SendSuccessResponse(c, "User enabled successfully", id)
+	}
+}
+
+// AdminForcePasswordReset forces a user to reset the password
+// @Summary Force password reset
+// @Description ends the sessions of the user, revokes their personal access tokens and mails a password reset link, password logins are refused until the password is reset
+// @Tags Admin
+// @Produce json
+// @Param   id  path  string  true  "User ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /admin/users/{id}/password-reset [post]
+// @Security ApiKeyAuth
+func AdminForcePasswordReset(adminService *services.AdminService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing user id: %v\n", errParam)
+			return SendError(c, ErrInvalidUserIDParam)
+		}
+
+		if err := adminService.ForcePasswordReset(c.UserContext(), uint(id)); err != nil {
+			log.ErrorLog.Printf("Error forcing password reset: %v\n", err)
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Printf("Password reset of user %d forced by an admin\n", id)
+		return SendSuccessResponse(c, "Password reset forced successfully", id)
+	}
+}
+
+// AdminGetBoards lists every board
+// @Summary List boards
+// @Description lists every board, private and archived ones included
+// @Tags Admin
+// @Produce json
+// @Param   workspace_id  query  int     false  "Workspace ID"
+// @Param   search        query  string  false  "Search in board names"
+// @Param   page          query  int     false  "Page number"
+// @Param   page_size     query  int     false  "Page size"
+// @Success 200 {array} domains.Board
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /admin/boards [get]
+// @Security ApiKeyAuth
+func AdminGetBoards(adminService *services.AdminService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		page, pageSize := PageAndPageSize(c)
+
+		filter := domains.BoardFilter{Search: c.Query("search")}
+		if c.Query("workspace_id") != "" {
+			workspaceID, err := strconv.ParseUint(c.Query("workspace_id"), 10, 32)
+			if err != nil {
+				log.ErrorLog.Printf("Error parsing workspace id: %v\n", err)
+				return SendError(c, ErrInvalidWorkspaceIDParam)
+			}
+			id := uint(workspaceID)
+			filter.WorkspaceID = &id
+		}
+
+		boards, total, err := adminService.GetBoards(c.UserContext(), filter, uint(page), uint(pageSize))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting boards: %v\n", err)
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Println("Boards loaded successfully")
+		return SendSuccessPaginateResponse(c, "Successfully fetched.", boards, uint(page), uint(pageSize), total)
+	}
+}
+
+// AdminGetStats gets the system statistics
+// @Summary Get system statistics
+// @Description counts users, workspaces, boards and tasks
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} presenter.SystemStatsPresenter
+// @Failure 403
+// @Failure 500
+// @Router /admin/stats [get]
+// @Security ApiKeyAuth
+func AdminGetStats(adminService *services.AdminService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		stats, err := adminService.GetStats(c.UserContext())
+		if err != nil {
+			log.ErrorLog.Printf("Error getting system statistics: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "System statistics loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewSystemStatsPresenter(stats))
+	}
+}
+
+// AdminGetRoles lists every role
+// @Summary List roles
+// @Description lists the global roles and the custom roles of every board
+// @Tags Admin
+// @Produce json
+// @Success 200 {array} domains.Role
+// @Failure 403
+// @Failure 500
+// @Router /admin/roles [get]
+// @Security ApiKeyAuth
+func AdminGetRoles(roleService *services.RoleService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		roles, err := roleService.GetAllRoles(c.UserContext())
+		if err != nil {
+			log.ErrorLog.Printf("Error getting roles: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Roles loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, roles)
+	}
+}
+
+// AdminCreateRole creates a global role
+// @Summary Create global role
+// @Description creates a role that can be granted on every board
+// @Tags Admin
+// @Accept  json
+// @Produce json
+// @Param   body  body      UpdateRoleRequest  true  "Role"
+// @Success 200 {object} domains.Role
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /admin/roles [post]
+// @Security ApiKeyAuth
+func AdminCreateRole(roleService *services.RoleService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input UpdateRoleRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing role request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing role request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating role request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		role := domains.Role{
+			Name:        input.Name,
+			Description: input.Description,
+			Permissions: toBoardActions(input.Permissions),
+		}
+		if err := roleService.CreateGlobalRole(c.UserContext(), &role); err != nil {
+			log.ErrorLog.Printf("Error creating role: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Role created successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, role)
+	}
+}
+
+// AdminUpdateRole updates a global role
+// @Summary Update global role
+// @Description updates a role that can be granted on every board, built-in roles keep their name and permissions, custom roles of boards are managed on their board
+// @Tags Admin
+// @Accept  json
+// @Produce json
+// @Param   id    path      string             true  "Role ID"
+// @Param   body  body      UpdateRoleRequest  true  "Role"
+// @Success 200 {object} domains.Role
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /admin/roles/{id} [put]
+// @Security ApiKeyAuth
+func AdminUpdateRole(roleService *services.RoleService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing role id: %v\n", errParam)
+			return SendError(c, ErrInvalidRoleIDParam)
+		}
+
+		var input UpdateRoleRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing role request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing role request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating role request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		role := domains.Role{
+			ID:          uint(id),
+			Name:        input.Name,
+			Description: input.Description,
+			Permissions: toBoardActions(input.Permissions),
+		}
+		if err := roleService.UpdateGlobalRole(c.UserContext(), &role); err != nil {
+			log.ErrorLog.Printf("Error updating role: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Role updated successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, role)
+	}
+}
+
+// AdminDeleteRole deletes a global role
+// @Summary Delete global role
+// @Description deletes a role that can be granted on every board, built-in roles and roles still granted can not be deleted
+// @Tags Admin
+// @Produce json
+// @Param   id  path  string  true  "Role ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Router /admin/roles/{id} [delete]
+// @Security ApiKeyAuth
+func AdminDeleteRole(roleService *services.RoleService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing role id: %v\n", errParam)
+			return SendError(c, ErrInvalidRoleIDParam)
+		}
+
+		if err := roleService.DeleteGlobalRole(c.UserContext(), uint(id)); err != nil {
+			log.ErrorLog.Printf("Error deleting role: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Role deleted successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, id)
+	}
+}
+
+// GetAuditLogs lists the audit log
+// @Summary List audit log
+// @Description lists logins, token refreshes and changes of board access, newest first
+// @Tags Admin
+// @Produce json
+// @Param   action          query  string  false  "Action, such as auth.login.failed or board.member.role_changed"
+// @Param   actor_id        query  int     false  "User who acted"
+// @Param   target_user_id  query  int     false  "User acted on"
+// @Param   board_id        query  int     false  "Board ID"
+// @Param   from            query  string  false  "First day, YYYY-MM-DD"
+// @Param   to              query  string  false  "Last day, YYYY-MM-DD"
+// @Param   page            query  int     false  "Page number"
+// @Param   page_size       query  int     false  "Page size"
+// @Success 200 {array} presenter.AuditLogPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /admin/audit-logs [get]
+// @Security ApiKeyAuth
+func GetAuditLogs(auditService *services.AuditService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		page, pageSize := PageAndPageSize(c)
+
+		filter, err := parseAuditLogFilter(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		entries, total, err := auditService.GetAuditLogs(c.UserContext(), filter, uint(page), uint(pageSize))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting audit log: %v\n", err)
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Println("Audit log loaded successfully")
+		return SendSuccessPaginateResponse(c, "Successfully fetched.", presenter.NewAuditLogPresenters(entries),
+			uint(page), uint(pageSize), total)
+	}
+}
+
+// ExportAuditLogs exports the audit log
+// @Summary Export audit log
+// @Description exports the matching audit log entries as JSON lines, oldest first
+// @Tags Admin
+// @Produce application/x-ndjson
+// @Param   action          query  string  false  "Action, such as auth.login.failed or board.member.role_changed"
+// @Param   actor_id        query  int     false  "User who acted"
+// @Param   target_user_id  query  int     false  "User acted on"
+// @Param   board_id        query  int     false  "Board ID"
+// @Param   from            query  string  false  "First day, YYYY-MM-DD"
+// @Param   to              query  string  false  "Last day, YYYY-MM-DD"
+// @Success 200 {file} file
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /admin/audit-logs/export [get]
+// @Security ApiKeyAuth
+func ExportAuditLogs(auditService *services.AuditService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		filter, err := parseAuditLogFilter(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		w := bufio.NewWriter(c.Response().BodyWriter())
+		encoder := json.NewEncoder(w)
+		err = auditService.ExportAuditLogs(c.UserContext(), filter, func(entry domains.AuditLog) error {
+			return encoder.Encode(presenter.NewAuditLogPresenter(entry))
+		})
+		if err == nil {
+			err = w.Flush()
+		}
+		if err != nil {
+			log.ErrorLog.Printf("Error exporting audit log: %v\n", err)
+			c.Response().ResetBody()
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Println("Audit log exported successfully")
+		c.Set(fiber.HeaderContentType, "application/x-ndjson")
+		c.Set(fiber.HeaderContentDisposition, `attachment; filename="audit-log.jsonl"`)
+		return nil
+	}
+}
+
+// parseAuditLogFilter reads the audit log filter from the query, the to date
+// includes the whole day.
+func parseAuditLogFilter(c *fiber.Ctx) (domains.AuditLogFilter, error) {
+	filter := domains.AuditLogFilter{Action: domains.AuditAction(c.Query("action"))}
+
+	ids := []struct {
+		key string
+		id  **uint
+	}{
+		{"actor_id", &filter.ActorID},
+		{"target_user_id", &filter.TargetUserID},
+		{"board_id", &filter.BoardID},
+	}
+	for _, param := range ids {
+		if c.Query(param.key) == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(c.Query(param.key), 10, 32)
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing %s: %v\n", param.key, err)
+			return filter, ErrInvalidAuditFilter
+		}
+		value := uint(id)
+		*param.id = &value
+	}
+
+	from, err := parseDateQuery(c, "from", ErrInvalidAuditFilter)
+	if err != nil {
+		return filter, err
+	}
+	if !from.IsZero() {
+		filter.From = &from
+	}
+	to, err := parseDateQuery(c, "to", ErrInvalidAuditFilter)
+	if err != nil {
+		return filter, err
+	}
+	if !to.IsZero() {
+		to = to.Add(24 * time.Hour)
+		filter.To = &to
+	}
+	return filter, nil
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidFromDateLayout = fiber.NewError(fiber.StatusBadRequest, "invalid from date format, example: "+time.DateOnly)
+	ErrInvalidToDateLayout   = fiber.NewError(fiber.StatusBadRequest, "invalid to date format, example: "+time.DateOnly)
+)
+
+// GetBoardAnalytics get flow metrics of a board
+// @Summary Get Board Analytics
+// @Description gets lead time, cycle time, weekly throughput and cumulative flow data of a board, derived from task column moves
+// @Tags Analytics
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Param   from      query     string  false  "Range start date, defaults to 30 days ago"  example(2024-06-01)
+// @Param   to      query     string  false  "Range end date, defaults to today"  example(2024-06-30)
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/analytics [get]
+// @Security ApiKeyAuth
+func GetBoardAnalytics(analyticsService *services.AnalyticsService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		from, err := parseDateQuery(c, "from", ErrInvalidFromDateLayout)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		to, err := parseDateQuery(c, "to", ErrInvalidToDateLayout)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		analytics, err := analyticsService.GetBoardAnalytics(c.Context(), userID, uint(boardID), from, to)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting board analytics: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Board analytics loaded successfully", presenter.NewBoardAnalyticsPresenter(analytics))
+	}
+}
+
+// parseDateQuery parses an optional date query param, returning the zero time when it is missing.
+func parseDateQuery(c *fiber.Ctx, key string, errLayout error) (time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		log.ErrorLog.Printf("Error invalid date: %v\n", err)
+		return time.Time{}, errLayout
+	}
+	return date, nil
+}
+
+// GetBoardWorkload get workload of board members
+// @Summary Get Board Workload
+// @Description gets open, overdue and per column assigned task counts of every board member
+// @Tags Analytics
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/workload [get]
+// @Security ApiKeyAuth
+func GetBoardWorkload(analyticsService *services.AnalyticsService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		workloads, err := analyticsService.GetBoardWorkload(c.Context(), userID, uint(boardID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting board workload: %v\n", err)
+			return SendError(c, err)
+		}
+
+		data := make([]presenter.MemberWorkloadPresenter, len(workloads))
+		for i, workload := range workloads {
+			data[i] = presenter.NewMemberWorkloadPresenter(workload)
+		}
+
+		return SendSuccessResponse(c, "Board workload loaded successfully", data)
+	}
+}
@@ -16,13 +16,14 @@ var (
 )
 
 type CreateBoardRequest struct {
-	Name      string `json:"name" validate:"required,min=3,max=50,excludesall=;" example:"new board"`
-	IsPrivate bool   `json:"is_private" example:"false"`
+	Name        string `json:"name" validate:"required,min=3,max=50,excludesall=;" example:"new board"`
+	IsPrivate   bool   `json:"is_private" example:"false"`
+	WorkspaceID *uint  `json:"workspace_id" example:"1"`
 }
 
 // CreateBoard creates a new board
 // @Summary Create Board
-// @Description creates a board
+// @Description creates a board, the creator becomes the board owner. Boards of a workspace can be created by its members who are not guests.
 // @Tags Board
 // @Accept  json
 // @Produce json
@@ -55,12 +56,13 @@ func CreateBoard(boardService *services.BoardService) fiber.Handler {
 		}
 
 		boardModel := domains.Board{
-			CreatedBy: userId,
-			Name:      input.Name,
-			IsPrivate: input.IsPrivate,
+			CreatedBy:   userId,
+			Name:        input.Name,
+			IsPrivate:   input.IsPrivate,
+			WorkspaceID: input.WorkspaceID,
 		}
 
-		err = boardService.CreateBoard(c.Context(), &boardModel)
+		err = boardService.CreateBoard(c.UserContext(), &boardModel)
 		if err != nil {
 			log.ErrorLog.Printf("Error creating board: %v\n", err)
 			return SendError(c, err)
@@ -74,12 +76,13 @@ func CreateBoard(boardService *services.BoardService) fiber.Handler {
 
 // GetBoardByID get a board
 // @Summary Get Board
-// @Description gets a board
+// @Description gets a board, private boards are only visible to their members
 // @Tags Board
 // @Produce json
 // @Param   id      path     string  true  "Board ID"
 // @Success 200 {object} domains.Board
 // @Failure 400
+// @Failure 403
 // @Failure 404
 // @Failure 500
 // @Router /boards/{id} [get]
@@ -92,7 +95,13 @@ func GetBoardByID(boardService *services.BoardService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Parsing board id"})
 		}
 
-		board, err := boardService.GetBoardByID(c.Context(), uint(id))
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		board, err := boardService.GetBoardByID(c.Context(), userID, uint(id))
 		if err != nil {
 			log.ErrorLog.Printf("Error getting board: %v\n", err)
 			return SendError(c, err)
@@ -104,6 +113,50 @@ func GetBoardByID(boardService *services.BoardService) fiber.Handler {
 	}
 }
 
+// GetBoards lists the boards of the user
+// @Summary Get Boards
+// @Description lists the boards the user is a member of and the public boards the user can see, public boards of a workspace are only listed to its members who are not guests
+// @Tags Board
+// @Produce json
+// @Param   workspace_id  query  int     false  "Workspace ID"
+// @Param   search        query  string  false  "Search in board names"
+// @Success 200 {array} domains.Board
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards [get]
+// @Security ApiKeyAuth
+func GetBoards(boardService *services.BoardService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		filter := domains.BoardFilter{Search: c.Query("search")}
+		if c.Query("workspace_id") != "" {
+			workspaceID, err := strconv.ParseUint(c.Query("workspace_id"), 10, 32)
+			if err != nil {
+				log.ErrorLog.Printf("Error parsing workspace id: %v\n", err)
+				return SendError(c, ErrInvalidWorkspaceIDParam)
+			}
+			id := uint(workspaceID)
+			filter.WorkspaceID = &id
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		boards, err := boardService.GetBoards(c.UserContext(), userID, filter)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting boards: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Boards loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, boards)
+	}
+}
+
 type UpdateBoardRequest struct {
 	Name      string `json:"name" validate:"required,min=3,max=50,excludesall=;" example:"new board"`
 	IsPrivate bool   `json:"is_private" example:"false"`
@@ -119,6 +172,7 @@ type UpdateBoardRequest struct {
 // @Param   body  body      UpdateBoardRequest  true  "Update Board"
 // @Success 200
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /boards/{id} [put]
 // @Security ApiKeyAuth
@@ -143,13 +197,19 @@ func UpdateBoard(boardService *services.BoardService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error validating board update request body"})
 		}
 
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
 		boardModel := domains.Board{
 			ID:        uint(id),
 			Name:      input.Name,
 			IsPrivate: input.IsPrivate,
 		}
 
-		err = boardService.UpdateBoard(c.Context(), &boardModel)
+		err = boardService.UpdateBoard(c.UserContext(), userID, &boardModel)
 		if err != nil {
 			log.ErrorLog.Printf("Error updating board: %v\n", err)
 			return SendError(c, err)
@@ -163,12 +223,13 @@ func UpdateBoard(boardService *services.BoardService) fiber.Handler {
 
 // DeleteBoard delete a board
 // @Summary Delete Board
-// @Description deleted a board
+// @Description deletes a board with its columns, tasks, comments, dependencies and memberships, only the board owner can delete it
 // @Tags Board
 // @Produce json
 // @Param   id      path     string  true  "Board ID"
 // @Success 204
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /boards/{id} [delete]
 // @Security ApiKeyAuth
@@ -180,7 +241,13 @@ func DeleteBoard(boardService *services.BoardService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing board id"})
 		}
 
-		err = boardService.DeleteBoard(c.Context(), uint(id))
+		userID, errUserID := utils.GetUserID(c)
+		if errUserID != nil {
+			log.ErrorLog.Printf("Error getting user id: %v\n", errUserID)
+			return SendError(c, &fiber.Error{Code: fiber.StatusUnauthorized, Message: "Invalid token"})
+		}
+
+		err = boardService.DeleteBoard(c.UserContext(), userID, uint(id))
 		if err != nil {
 			log.ErrorLog.Printf("Error deleting board: %v\n", err)
 			return SendError(c, err)
@@ -192,6 +259,76 @@ func DeleteBoard(boardService *services.BoardService) fiber.Handler {
 	}
 }
 
+// ArchiveBoard archive a board
+// @Summary Archive Board
+// @Description archives a board, archived boards are hidden from board listings
+// @Tags Board
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/archive [post]
+// @Security ApiKeyAuth
+func ArchiveBoard(boardService *services.BoardService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := boardService.ArchiveBoard(c.Context(), userID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error archiving board: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Board archived successfully", id)
+	}
+}
+
+// UnarchiveBoard unarchive a board
+// @Summary Unarchive Board
+// @Description brings an archived board back to board listings
+// @Tags Board
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/unarchive [post]
+// @Security ApiKeyAuth
+func UnarchiveBoard(boardService *services.BoardService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := boardService.UnarchiveBoard(c.Context(), userID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error unarchiving board: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Board unarchived successfully", id)
+	}
+}
+
 type InviteUserRequest struct {
 	UserId   uint   `json:"user_id"`
 	RoleName string `json:"role_name"`
@@ -207,6 +344,7 @@ type InviteUserRequest struct {
 // @Param   body  body      InviteUserRequest  true  "Create Board"
 // @Success 200
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /boards/{id}/add-user [post]
 // @Security ApiKeyAuth
@@ -231,7 +369,13 @@ func InviteUserToBoard(boardService *services.BoardService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error validating user invitation request body"})
 		}
 
-		if err = boardService.InviteUserToBoard(c.Context(), input.UserId, uint(boardId), input.RoleName); err != nil {
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = boardService.InviteUserToBoard(c.UserContext(), actorID, input.UserId, uint(boardId), input.RoleName); err != nil {
 			log.ErrorLog.Printf("Error inviting user: %v\n", err)
 			return SendError(c, err)
 		}
@@ -252,6 +396,7 @@ func InviteUserToBoard(boardService *services.BoardService) fiber.Handler {
 // @Param   user_id      path     string  true  "User ID"
 // @Success 200
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /boards/{board_id}/users/{user_id} [delete]
 // @Security ApiKeyAuth
@@ -269,7 +414,13 @@ func RemoveUserFromBoard(boardService *services.BoardService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing user id"})
 		}
 
-		if err = boardService.RemoveUserFromBoard(c.Context(), uint(userId), uint(boardId)); err != nil {
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = boardService.RemoveUserFromBoard(c.UserContext(), actorID, uint(userId), uint(boardId)); err != nil {
 			log.ErrorLog.Printf("Error removing user from board: %v\n", err)
 			return SendError(c, err)
 		}
@@ -280,6 +431,61 @@ func RemoveUserFromBoard(boardService *services.BoardService) fiber.Handler {
 	}
 }
 
+type TransferOwnershipRequest struct {
+	UserID uint `json:"user_id" validate:"required" example:"2"`
+}
+
+// TransferBoardOwnership hand the board over to another member
+// @Summary Transfer Board Ownership
+// @Description makes another board member, directly or through a team, a direct board owner, a current direct owner stays on the board as a maintainer
+// @Tags Board
+// @Accept  json
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Param   body  body      TransferOwnershipRequest  true  "Transfer Ownership"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/transfer-ownership [post]
+// @Security ApiKeyAuth
+func TransferBoardOwnership(boardService *services.BoardService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		validate := validation.NewValidator()
+		var input TransferOwnershipRequest
+
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing ownership transfer request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing ownership transfer request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating ownership transfer request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error validating ownership transfer request body"})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = boardService.TransferOwnership(c.UserContext(), userID, uint(id), input.UserID); err != nil {
+			log.ErrorLog.Printf("Error transferring board ownership: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Board ownership transferred successfully", nil)
+	}
+}
+
 type ChangeUserRoleRequest struct {
 	RoleName string `json:"role_name"`
 }
@@ -295,6 +501,7 @@ type ChangeUserRoleRequest struct {
 // @Param   body  body      ChangeUserRoleRequest  true  "Create Board"
 // @Success 200
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /boards/{board_id}/users/{user_id}  [put]
 // @Security ApiKeyAuth
@@ -325,7 +532,13 @@ func ChangeUserRoleInBoard(boardService *services.BoardService) fiber.Handler {
 			return SendError(c, err)
 		}
 
-		if err = boardService.ChangeUserRole(c.Context(), uint(userId), uint(boardId), input.RoleName); err != nil {
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = boardService.ChangeUserRole(c.UserContext(), actorID, uint(userId), uint(boardId), input.RoleName); err != nil {
 			log.ErrorLog.Printf("Error changeing user role: %v\n", err)
 			return SendError(c, err)
 		}
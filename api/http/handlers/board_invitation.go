@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidInvitationIDParam = fiber.NewError(fiber.StatusBadRequest, "invalid invitation id")
+)
+
+type InviteByEmailRequest struct {
+	Email    string `json:"email" validate:"required,email" example:"new.member@example.com"`
+	RoleName string `json:"role_name" validate:"required" example:"Editor"`
+}
+
+type InvitationTokenInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// InviteByEmail invites an email address to the board
+// @Summary Invite by email
+// @Description invites an email address to the board with a role and mails it an accept link, the address does not need an account yet
+// @Tags Board Invitations
+// @Accept  json
+// @Produce json
+// @Param   id    path      string                true  "Board ID"
+// @Param   body  body      InviteByEmailRequest  true  "Invitation"
+// @Success 200 {object} presenter.BoardInvitationPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/invitations [post]
+// @Security ApiKeyAuth
+func InviteByEmail(invitationService *services.BoardInvitationService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		boardID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing board id"})
+		}
+
+		var input InviteByEmailRequest
+		if err = c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing invitation request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing invitation request body"})
+		}
+
+		if err = validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating invitation request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		invitation, err := invitationService.InviteByEmail(c.UserContext(), actorID, uint(boardID), input.Email, input.RoleName)
+		if err != nil {
+			log.ErrorLog.Printf("Error inviting by email: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Invitation sent successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewBoardInvitationPresenter(*invitation))
+	}
+}
+
+// GetBoardInvitations lists the invitations of a board
+// @Summary Get board invitations
+// @Description lists the invitations of a board with their status
+// @Tags Board Invitations
+// @Produce json
+// @Param   id    path      string  true  "Board ID"
+// @Success 200 {array} presenter.BoardInvitationPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/invitations [get]
+// @Security ApiKeyAuth
+func GetBoardInvitations(invitationService *services.BoardInvitationService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing board id"})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		invitations, err := invitationService.GetBoardInvitations(c.UserContext(), userID, uint(boardID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting board invitations: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Invitations loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewBoardInvitationPresenters(invitations))
+	}
+}
+
+// GetMyInvitations lists the pending invitations of the user
+// @Summary Get my invitations
+// @Description lists the pending board invitations sent to the verified email of the user
+// @Tags Board Invitations
+// @Produce json
+// @Success 200 {array} presenter.BoardInvitationPresenter
+// @Failure 401
+// @Failure 500
+// @Router /invitations [get]
+// @Security ApiKeyAuth
+func GetMyInvitations(invitationService *services.BoardInvitationService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		invitations, err := invitationService.GetUserInvitations(c.UserContext(), userID)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting invitations: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Invitations loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewBoardInvitationPresenters(invitations))
+	}
+}
+
+// AcceptInvitationByToken accepts an invitation from its email link
+// @Summary Accept invitation link
+// @Description joins the board with the token from the invitation email, the invitation must have been sent to the email of the user
+// @Tags Board Invitations
+// @Accept  json
+// @Produce json
+// @Param   body  body      InvitationTokenInput  true  "Invitation token"
+// @Success 200 {object} presenter.BoardInvitationPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /invitations/accept [post]
+// @Security ApiKeyAuth
+func AcceptInvitationByToken(invitationService *services.BoardInvitationService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input InvitationTokenInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing accept invitation request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing accept invitation request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating accept invitation request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		invitation, err := invitationService.AcceptByToken(c.UserContext(), userID, input.Token)
+		if err != nil {
+			log.ErrorLog.Printf("Error accepting invitation: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Invitation accepted successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewBoardInvitationPresenter(*invitation))
+	}
+}
+
+// DeclineInvitationByToken declines an invitation from its email link
+// @Summary Decline invitation link
+// @Description declines an invitation with the token from the invitation email, no account is needed
+// @Tags Board Invitations
+// @Accept  json
+// @Produce json
+// @Param   body  body      InvitationTokenInput  true  "Invitation token"
+// @Success 200
+// @Failure 400
+// @Failure 500
+// @Router /invitations/decline [post]
+func DeclineInvitationByToken(invitationService *services.BoardInvitationService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input InvitationTokenInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing decline invitation request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing decline invitation request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating decline invitation request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		if err := invitationService.DeclineByToken(c.UserContext(), input.Token); err != nil {
+			log.ErrorLog.Printf("Error declining invitation: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Invitation declined successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
+
+// AcceptInvitation accepts an invitation of the user
+// @Summary Accept invitation
+// @Description joins the board of an invitation sent to the verified email of the user
+// @Tags Board Invitations
+// @Produce json
+// @Param   id    path      string  true  "Invitation ID"
+// @Success 200 {object} presenter.BoardInvitationPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /invitations/{id}/accept [post]
+// @Security ApiKeyAuth
+func AcceptInvitation(invitationService *services.BoardInvitationService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing invitation id: %v\n", err)
+			return SendError(c, ErrInvalidInvitationIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		invitation, err := invitationService.AcceptInvitation(c.UserContext(), userID, uint(id))
+		if err != nil {
+			log.ErrorLog.Printf("Error accepting invitation: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Invitation accepted successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewBoardInvitationPresenter(*invitation))
+	}
+}
+
+// DeclineInvitation declines an invitation of the user
+// @Summary Decline invitation
+// @Description declines an invitation sent to the verified email of the user
+// @Tags Board Invitations
+// @Produce json
+// @Param   id    path      string  true  "Invitation ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /invitations/{id}/decline [post]
+// @Security ApiKeyAuth
+func DeclineInvitation(invitationService *services.BoardInvitationService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing invitation id: %v\n", err)
+			return SendError(c, ErrInvalidInvitationIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = invitationService.DeclineInvitation(c.UserContext(), userID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error declining invitation: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Invitation declined successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
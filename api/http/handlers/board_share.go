@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidShareLinkIDParam = fiber.NewError(fiber.StatusBadRequest, "invalid share link id")
+)
+
+type CreateShareLinkInput struct {
+	ExpiresAt *time.Time `json:"expires_at" example:"2030-01-01T00:00:00Z"`
+}
+
+// CreateShareLink creates a public share link for a board
+// @Summary Create share link
+// @Description creates a link giving anyone holding it read-only access to the columns and tasks of the board, without comments or member details. Links without expires_at stay valid until revoked. The token is only returned once.
+// @Tags Board Share Links
+// @Accept  json
+// @Produce json
+// @Param   id    path      string                true   "Board ID"
+// @Param   body  body      CreateShareLinkInput  false  "Share link"
+// @Success 200 {object} presenter.CreatedBoardShareLinkPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/share-links [post]
+// @Security ApiKeyAuth
+func CreateShareLink(shareService *services.BoardShareService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil || boardID <= 0 {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		var input CreateShareLinkInput
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&input); err != nil {
+				log.ErrorLog.Printf("Error parsing share link request body: %v\n", err)
+				return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing share link request body"})
+			}
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		link, token, err := shareService.CreateLink(c.UserContext(), userID, uint(boardID), input.ExpiresAt)
+		if err != nil {
+			log.ErrorLog.Printf("Error creating share link: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Share link created, copy it now as it will not be shown again", presenter.CreatedBoardShareLinkPresenter{
+			BoardShareLinkPresenter: presenter.NewBoardShareLinkPresenter(*link),
+			Token:                   token,
+		})
+	}
+}
+
+// GetShareLinks lists the share links of a board
+// @Summary List share links
+// @Description lists the share links of the board, including revoked and expired ones
+// @Tags Board Share Links
+// @Produce json
+// @Param   id  path  string  true  "Board ID"
+// @Success 200 {array} presenter.BoardShareLinkPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/share-links [get]
+// @Security ApiKeyAuth
+func GetShareLinks(shareService *services.BoardShareService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil || boardID <= 0 {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		links, err := shareService.GetLinks(c.UserContext(), userID, uint(boardID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting share links: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Share links fetched successfully", presenter.NewBoardShareLinkPresenters(links))
+	}
+}
+
+// RevokeShareLink revokes a share link of a board
+// @Summary Revoke share link
+// @Description revokes a share link of the board, it stops working immediately
+// @Tags Board Share Links
+// @Produce json
+// @Param   id      path  string  true  "Board ID"
+// @Param   linkID  path  string  true  "Share link ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/share-links/{linkID} [delete]
+// @Security ApiKeyAuth
+func RevokeShareLink(shareService *services.BoardShareService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil || boardID <= 0 {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		linkID, errParam := c.ParamsInt("linkID")
+		if errParam != nil || linkID <= 0 {
+			log.ErrorLog.Printf("Error parsing share link id: %v\n", errParam)
+			return SendError(c, ErrInvalidShareLinkIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := shareService.RevokeLink(c.UserContext(), userID, uint(boardID), uint(linkID)); err != nil {
+			log.ErrorLog.Printf("Error revoking share link: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Share link revoked successfully", linkID)
+	}
+}
+
+// GetSharedBoard gets a board from a share link
+// @Summary Get shared board
+// @Description gets the board and its columns from a share link, no authentication needed
+// @Tags Board Share Links
+// @Produce json
+// @Param   token  path  string  true  "Share link token"
+// @Success 200 {object} presenter.SharedBoardPresenter
+// @Failure 404
+// @Failure 500
+// @Router /shared/{token} [get]
+func GetSharedBoard(shareService *services.BoardShareService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, err := utils.GetSharedBoardID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading shared board: %v\n", err)
+			return SendError(c, err)
+		}
+
+		board, columns, err := shareService.GetSharedBoard(c.UserContext(), boardID)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting shared board: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Board fetched successfully", presenter.NewSharedBoardPresenter(board, columns))
+	}
+}
+
+// GetSharedTasks gets the tasks of a board from a share link
+// @Summary Get shared tasks
+// @Description gets a page of the tasks of the board from a share link, without comments or assignees, no authentication needed
+// @Tags Board Share Links
+// @Produce json
+// @Param   token      path   string  true   "Share link token"
+// @Param   page       query  int     false  "Page"
+// @Param   page_size  query  int     false  "Page size"
+// @Success 200 {array} presenter.SharedTaskPresenter
+// @Failure 404
+// @Failure 500
+// @Router /shared/{token}/tasks [get]
+func GetSharedTasks(shareService *services.BoardShareService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, err := utils.GetSharedBoardID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading shared board: %v\n", err)
+			return SendError(c, err)
+		}
+
+		page, pageSize := PageAndPageSize(c)
+
+		tasks, total, err := shareService.GetSharedTasks(c.UserContext(), boardID, uint(page), uint(pageSize))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting shared tasks: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessPaginateResponse(c, "Tasks fetched successfully", presenter.NewSharedTaskPresenters(tasks), uint(page), uint(pageSize), total)
+	}
+}
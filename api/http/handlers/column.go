@@ -351,13 +351,15 @@ func ChangeFinalColumn(columnService *services.ColumnService) fiber.Handler {
 
 // DeleteColumn delete a column
 // @Summary Delete Column
-// @Description delete a column
+// @Description delete a column, a column with tasks requires move_to to move its tasks into another column of the board
 // @Tags Column
 // @Accept json
 // @Produce json
 // @Param   id      path     string  true  "Column ID"
 // @Param   boardId      path     string  true  "Board ID"
+// @Param   move_to      query     int  false  "Column ID to move the tasks to"
 // @Success 200
+// @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /boards/{boardId}/columns/{id} [delete]
@@ -382,7 +384,13 @@ func DeleteColumn(columnService *services.ColumnService) fiber.Handler {
 			return SendError(c, err)
 		}
 
-		if err := columnService.Delete(c.Context(), uint(boardId), userId, uint(id)); err != nil {
+		var moveTo *uint
+		if target := c.QueryInt("move_to"); target > 0 {
+			targetID := uint(target)
+			moveTo = &targetID
+		}
+
+		if err := columnService.Delete(c.Context(), uint(boardId), userId, uint(id), moveTo); err != nil {
 			log.ErrorLog.Printf("Error deleting column: %v\n", err)
 			return SendError(c, err)
 		}
@@ -395,3 +403,87 @@ func DeleteColumn(columnService *services.ColumnService) fiber.Handler {
 			id)
 	}
 }
+
+// ArchiveColumn archive a column
+// @Summary Archive Column
+// @Description archives a column, archived columns are hidden from column listings
+// @Tags Column
+// @Produce json
+// @Param   boardId      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Column ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{boardId}/columns/{id}/archive [post]
+// @Security ApiKeyAuth
+func ArchiveColumn(columnService *services.ColumnService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardId")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing column id: %v\n", errParam)
+			return SendError(c, ErrInvalidColumnIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := columnService.ArchiveColumn(c.Context(), uint(boardID), userID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error archiving column: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Column archived successfully", id)
+	}
+}
+
+// UnarchiveColumn unarchive a column
+// @Summary Unarchive Column
+// @Description brings an archived column back to column listings
+// @Tags Column
+// @Produce json
+// @Param   boardId      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Column ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{boardId}/columns/{id}/unarchive [post]
+// @Security ApiKeyAuth
+func UnarchiveColumn(columnService *services.ColumnService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardId")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing column id: %v\n", errParam)
+			return SendError(c, ErrInvalidColumnIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := columnService.UnarchiveColumn(c.Context(), uint(boardID), userID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error unarchiving column: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Column unarchived successfully", id)
+	}
+}
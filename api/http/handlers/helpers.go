@@ -6,6 +6,7 @@ import (
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/jwt"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
 	"github.com/gofiber/fiber/v2"
@@ -84,6 +85,21 @@ func SendUserToken(c *fiber.Ctx, authToken *services.UserToken) error {
 	return c.Status(fiber.StatusOK).JSON(response)
 }
 
+// SendLoginResult sends the tokens of a login, or the MFA challenge when the
+// user still has to enter a two-factor code.
+func SendLoginResult(c *fiber.Ctx, result *services.LoginResult) error {
+	if result.MFARequired {
+		return SendSuccessResponse(c, "Two-factor authentication code required", fiber.Map{
+			"mfa_required": true,
+			"mfa_token":    result.MFAToken,
+			"exp":          result.MFAExpiresAt,
+		})
+	}
+
+	log.InfoLog.Println("User logged in successfully")
+	return SendUserToken(c, result.Token)
+}
+
 func PageAndPageSize(c *fiber.Ctx) (int, int) {
 	page, pageSize := c.QueryInt("page"), c.QueryInt("page_size")
 	if page <= 0 {
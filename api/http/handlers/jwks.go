@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetJWKS publishes the public keys other services use to verify access
+// tokens, served at /.well-known/jwks.json outside the versioned API. Keys
+// stay listed until their grace period after a rotation ends.
+func GetJWKS(keySet *jwt.KeySet) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+		return c.JSON(keySet.JWKS())
+	}
+}
@@ -0,0 +1,293 @@
+package handlers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidUserIDParam = fiber.NewError(fiber.StatusBadRequest, "invalid user id")
+)
+
+type MFACodeInput struct {
+	Code string `json:"code" validate:"required" example:"123456"`
+}
+
+type MFALoginInput struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required" example:"123456"`
+}
+
+type DisableMFAInput struct {
+	Password string `json:"password" validate:"required" example:"1234Test@"`
+	Code     string `json:"code" validate:"required" example:"123456"`
+}
+
+// LoginMFA finishes a login with a two-factor code
+// @Summary MFA login
+// @Description exchanges the MFA challenge token from /login and a TOTP or recovery code for the access and refresh tokens
+// @Tags Authentication
+// @Accept  json
+// @Produce json
+// @Param   body  body      MFALoginInput  true  "MFA challenge token and code"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /login/mfa [post]
+func LoginMFA(authService *services.AuthService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input MFALoginInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing MFA login request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing MFA login request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating MFA login request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		authToken, err := authService.CompleteMFALogin(c.UserContext(), input.MFAToken, input.Code)
+		if err != nil {
+			log.ErrorLog.Printf("Error logging in user with MFA: %v\n", err)
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Println("User logged in successfully")
+		return SendUserToken(c, authToken)
+	}
+}
+
+// EnrollMFA starts the two-factor enrollment
+// @Summary Start MFA enrollment
+// @Description returns a new TOTP secret and its otpauth:// provisioning URI to show as a QR code. MFA is enabled once a code is verified.
+// @Tags MFA
+// @Produce json
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /mfa/enroll [post]
+// @Security ApiKeyAuth
+func EnrollMFA(mfaService *services.MFAService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		enrollment, err := mfaService.Enroll(c.UserContext(), userID)
+		if err != nil {
+			log.ErrorLog.Printf("Error enrolling MFA: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Scan the QR code with your authenticator app and verify a code", fiber.Map{
+			"secret":           enrollment.Secret,
+			"provisioning_uri": enrollment.ProvisioningURI,
+		})
+	}
+}
+
+// VerifyMFAEnrollment enables two-factor authentication
+// @Summary Verify MFA enrollment
+// @Description enables MFA with a code of the pending secret and returns the recovery codes, which are shown only once
+// @Tags MFA
+// @Accept  json
+// @Produce json
+// @Param   body  body      MFACodeInput  true  "TOTP code"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /mfa/enroll/verify [post]
+// @Security ApiKeyAuth
+func VerifyMFAEnrollment(mfaService *services.MFAService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input MFACodeInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing MFA verification request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing MFA verification request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating MFA verification request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		codes, err := mfaService.ConfirmEnrollment(c.UserContext(), userID, input.Code)
+		if err != nil {
+			log.ErrorLog.Printf("Error verifying MFA enrollment: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Two-factor authentication enabled, store the recovery codes in a safe place", fiber.Map{
+			"recovery_codes": codes,
+		})
+	}
+}
+
+// GetRecoveryCodesStatus returns how many recovery codes are left
+// @Summary Recovery codes status
+// @Description returns the number of unused recovery codes of the logged in user
+// @Tags MFA
+// @Produce json
+// @Success 200
+// @Failure 401
+// @Failure 500
+// @Router /mfa/recovery-codes [get]
+// @Security ApiKeyAuth
+func GetRecoveryCodesStatus(mfaService *services.MFAService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		remaining, err := mfaService.RemainingRecoveryCodes(c.UserContext(), userID)
+		if err != nil {
+			log.ErrorLog.Printf("Error counting recovery codes: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Recovery codes fetched successfully", fiber.Map{
+			"remaining": remaining,
+		})
+	}
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes
+// @Summary Regenerate recovery codes
+// @Description replaces all recovery codes after checking a TOTP code, the old codes stop working
+// @Tags MFA
+// @Accept  json
+// @Produce json
+// @Param   body  body      MFACodeInput  true  "TOTP code"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /mfa/recovery-codes [post]
+// @Security ApiKeyAuth
+func RegenerateRecoveryCodes(mfaService *services.MFAService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input MFACodeInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing recovery codes request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing recovery codes request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating recovery codes request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		codes, err := mfaService.RegenerateRecoveryCodes(c.UserContext(), userID, input.Code)
+		if err != nil {
+			log.ErrorLog.Printf("Error regenerating recovery codes: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Recovery codes regenerated successfully", fiber.Map{
+			"recovery_codes": codes,
+		})
+	}
+}
+
+// DisableMFA turns two-factor authentication off
+// @Summary Disable MFA
+// @Description turns MFA off, confirmed with the password and a TOTP or recovery code
+// @Tags MFA
+// @Accept  json
+// @Produce json
+// @Param   body  body      DisableMFAInput  true  "Password and code"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /mfa/disable [post]
+// @Security ApiKeyAuth
+func DisableMFA(mfaService *services.MFAService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input DisableMFAInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing disable MFA request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing disable MFA request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating disable MFA request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := mfaService.Disable(c.UserContext(), userID, input.Password, input.Code); err != nil {
+			log.ErrorLog.Printf("Error disabling MFA: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Two-factor authentication disabled", nil)
+	}
+}
+
+// ResetUserMFA turns two-factor authentication off for a user
+// @Summary Reset user MFA
+// @Description lets an admin turn MFA off for a user who lost their authenticator and recovery codes
+// @Tags Admin
+// @Produce json
+// @Param   id      path     string  true  "User ID"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 403
+// @Failure 500
+// @Router /admin/users/{id}/mfa/reset [post]
+// @Security ApiKeyAuth
+func ResetUserMFA(mfaService *services.MFAService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing user id: %v\n", errParam)
+			return SendError(c, ErrInvalidUserIDParam)
+		}
+
+		if err := mfaService.ResetMFA(c.UserContext(), uint(id)); err != nil {
+			log.ErrorLog.Printf("Error resetting MFA: %v\n", err)
+			return SendError(c, err)
+		}
+
+		log.InfoLog.Printf("MFA of user %d reset by an admin\n", id)
+		return SendSuccessResponse(c, "Two-factor authentication reset successfully", id)
+	}
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidTokenIDParam = fiber.NewError(fiber.StatusBadRequest, "invalid token id")
+)
+
+type CreatePersonalAccessTokenInput struct {
+	Name      string     `json:"name" validate:"required,max=100" example:"CI bot"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1" example:"tasks:write,boards:read"`
+	BoardID   *uint      `json:"board_id" example:"1"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2030-01-01T00:00:00Z"`
+}
+
+// CreatePersonalAccessToken creates a personal access token
+// @Summary Create personal access token
+// @Description creates a token for scripts and bots, sent as "Authorization: Bearer <token>". Scopes are "<resource>:read" or "<resource>:write" for boards, columns, tasks, sprints, views, analytics, trash and notifications. The token is only returned once.
+// @Tags Personal Access Tokens
+// @Accept  json
+// @Produce json
+// @Param   body  body      CreatePersonalAccessTokenInput  true  "Token"
+// @Success 200 {object} presenter.CreatedPersonalAccessTokenPresenter
+// @Failure 400
+// @Failure 401
+// @Failure 403
+// @Failure 500
+// @Router /me/tokens [post]
+// @Security ApiKeyAuth
+func CreatePersonalAccessToken(tokenService *services.PersonalAccessTokenService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input CreatePersonalAccessTokenInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing personal access token request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing personal access token request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating personal access token request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		token := &domains.PersonalAccessToken{
+			UserID:    userID,
+			Name:      input.Name,
+			Scopes:    input.Scopes,
+			BoardID:   input.BoardID,
+			ExpiresAt: input.ExpiresAt,
+		}
+		plain, err := tokenService.CreateToken(c.UserContext(), token)
+		if err != nil {
+			log.ErrorLog.Printf("Error creating personal access token: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Personal access token created, copy it now as it will not be shown again", presenter.CreatedPersonalAccessTokenPresenter{
+			PersonalAccessTokenPresenter: presenter.NewPersonalAccessTokenPresenter(*token),
+			Token:                        plain,
+		})
+	}
+}
+
+// GetPersonalAccessTokens lists the personal access tokens
+// @Summary List personal access tokens
+// @Description lists the tokens of the logged in user, including revoked and expired ones
+// @Tags Personal Access Tokens
+// @Produce json
+// @Success 200 {array} presenter.PersonalAccessTokenPresenter
+// @Failure 401
+// @Failure 500
+// @Router /me/tokens [get]
+// @Security ApiKeyAuth
+func GetPersonalAccessTokens(tokenService *services.PersonalAccessTokenService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		tokens, err := tokenService.GetUserTokens(c.UserContext(), userID)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting personal access tokens: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Personal access tokens fetched successfully", presenter.NewPersonalAccessTokenPresenters(tokens))
+	}
+}
+
+// RevokePersonalAccessToken revokes a personal access token
+// @Summary Revoke personal access token
+// @Description revokes a token of the logged in user, it stops working immediately
+// @Tags Personal Access Tokens
+// @Produce json
+// @Param   id      path     string  true  "Token ID"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 404
+// @Failure 500
+// @Router /me/tokens/{id} [delete]
+// @Security ApiKeyAuth
+func RevokePersonalAccessToken(tokenService *services.PersonalAccessTokenService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing token id: %v\n", errParam)
+			return SendError(c, ErrInvalidTokenIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := tokenService.RevokeToken(c.UserContext(), userID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error revoking personal access token: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Personal access token revoked successfully", id)
+	}
+}
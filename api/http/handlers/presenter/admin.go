@@ -0,0 +1,90 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+type AdminUserPresenter struct {
+	ID                    uint       `json:"id"`
+	Name                  string     `json:"name"`
+	Email                 string     `json:"email"`
+	Role                  string     `json:"role"`
+	EmailVerifiedAt       *time.Time `json:"email_verified_at"`
+	MFAEnabled            bool       `json:"mfa_enabled"`
+	DisabledAt            *time.Time `json:"disabled_at"`
+	PasswordResetRequired bool       `json:"password_reset_required"`
+	CreatedAt             time.Time  `json:"created_at"`
+}
+
+func NewAdminUserPresenter(user domains.User) AdminUserPresenter {
+	return AdminUserPresenter{
+		ID:                    user.ID,
+		Name:                  user.Name,
+		Email:                 user.Email,
+		Role:                  user.Role.String(),
+		EmailVerifiedAt:       user.EmailVerifiedAt,
+		MFAEnabled:            user.MFAEnabled(),
+		DisabledAt:            user.DisabledAt,
+		PasswordResetRequired: user.PasswordResetRequired,
+		CreatedAt:             user.CreatedAt,
+	}
+}
+
+func NewAdminUserPresenters(users []domains.User) []AdminUserPresenter {
+	return fp.Map(users, NewAdminUserPresenter)
+}
+
+type SystemStatsPresenter struct {
+	Users           int64 `json:"users"`
+	DisabledUsers   int64 `json:"disabled_users"`
+	UnverifiedUsers int64 `json:"unverified_users"`
+	Workspaces      int64 `json:"workspaces"`
+	Boards          int64 `json:"boards"`
+	ArchivedBoards  int64 `json:"archived_boards"`
+	Tasks           int64 `json:"tasks"`
+}
+
+func NewSystemStatsPresenter(stats domains.SystemStats) SystemStatsPresenter {
+	return SystemStatsPresenter{
+		Users:           stats.Users,
+		DisabledUsers:   stats.DisabledUsers,
+		UnverifiedUsers: stats.UnverifiedUsers,
+		Workspaces:      stats.Workspaces,
+		Boards:          stats.Boards,
+		ArchivedBoards:  stats.ArchivedBoards,
+		Tasks:           stats.Tasks,
+	}
+}
+
+type AuditLogPresenter struct {
+	ID           uint              `json:"id"`
+	Action       string            `json:"action"`
+	ActorID      *uint             `json:"actor_id"`
+	TargetUserID *uint             `json:"target_user_id"`
+	BoardID      *uint             `json:"board_id"`
+	Details      map[string]string `json:"details,omitempty"`
+	IP           string            `json:"ip"`
+	UserAgent    string            `json:"user_agent"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+func NewAuditLogPresenter(entry domains.AuditLog) AuditLogPresenter {
+	return AuditLogPresenter{
+		ID:           entry.ID,
+		Action:       string(entry.Action),
+		ActorID:      entry.ActorID,
+		TargetUserID: entry.TargetUserID,
+		BoardID:      entry.BoardID,
+		Details:      entry.Details,
+		IP:           entry.IP,
+		UserAgent:    entry.UserAgent,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
+
+func NewAuditLogPresenters(entries []domains.AuditLog) []AuditLogPresenter {
+	return fp.Map(entries, NewAuditLogPresenter)
+}
@@ -0,0 +1,114 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type BoardAnalyticsPresenter struct {
+	BoardID        uint                           `json:"board_id"`
+	From           string                         `json:"from"`
+	To             string                         `json:"to"`
+	LeadTime       DurationStatsPresenter         `json:"lead_time"`
+	CycleTime      DurationStatsPresenter         `json:"cycle_time"`
+	Throughput     []ThroughputPointPresenter     `json:"throughput"`
+	CumulativeFlow []CumulativeFlowPointPresenter `json:"cumulative_flow"`
+}
+
+type DurationStatsPresenter struct {
+	Count        int     `json:"count"`
+	AverageHours float64 `json:"average_hours"`
+	MedianHours  float64 `json:"median_hours"`
+	P85Hours     float64 `json:"p85_hours"`
+}
+
+type ThroughputPointPresenter struct {
+	WeekStart   string `json:"week_start"`
+	Completed   int    `json:"completed"`
+	StoryPoints int    `json:"story_points"`
+}
+
+type CumulativeFlowPointPresenter struct {
+	Date    string                     `json:"date"`
+	Columns []ColumnTaskCountPresenter `json:"columns"`
+}
+
+type ColumnTaskCountPresenter struct {
+	ColumnID   uint   `json:"column_id"`
+	ColumnName string `json:"column_name"`
+	Count      int    `json:"count"`
+}
+
+func NewBoardAnalyticsPresenter(analytics *domains.BoardAnalytics) *BoardAnalyticsPresenter {
+	throughput := make([]ThroughputPointPresenter, len(analytics.Throughput))
+	for i, point := range analytics.Throughput {
+		throughput[i] = ThroughputPointPresenter{
+			WeekStart:   point.WeekStart.Format(time.DateOnly),
+			Completed:   point.Completed,
+			StoryPoints: point.StoryPoints,
+		}
+	}
+
+	cumulativeFlow := make([]CumulativeFlowPointPresenter, len(analytics.CumulativeFlow))
+	for i, point := range analytics.CumulativeFlow {
+		columns := make([]ColumnTaskCountPresenter, len(point.Columns))
+		for j, column := range point.Columns {
+			columns[j] = ColumnTaskCountPresenter{
+				ColumnID:   column.ColumnID,
+				ColumnName: column.ColumnName,
+				Count:      column.Count,
+			}
+		}
+		cumulativeFlow[i] = CumulativeFlowPointPresenter{
+			Date:    point.Date.Format(time.DateOnly),
+			Columns: columns,
+		}
+	}
+
+	return &BoardAnalyticsPresenter{
+		BoardID:        analytics.BoardID,
+		From:           analytics.From.Format(time.DateOnly),
+		To:             analytics.To.Format(time.DateOnly),
+		LeadTime:       newDurationStatsPresenter(analytics.LeadTime),
+		CycleTime:      newDurationStatsPresenter(analytics.CycleTime),
+		Throughput:     throughput,
+		CumulativeFlow: cumulativeFlow,
+	}
+}
+
+func newDurationStatsPresenter(stats domains.DurationStats) DurationStatsPresenter {
+	return DurationStatsPresenter{
+		Count:        stats.Count,
+		AverageHours: stats.Average.Hours(),
+		MedianHours:  stats.Median.Hours(),
+		P85Hours:     stats.P85.Hours(),
+	}
+}
+
+type MemberWorkloadPresenter struct {
+	User            *UserPresenter             `json:"user"`
+	OpenTasks       int                        `json:"open_tasks"`
+	OpenStoryPoints int                        `json:"open_story_points"`
+	OverdueTasks    int                        `json:"overdue_tasks"`
+	TasksPerColumn  []ColumnTaskCountPresenter `json:"tasks_per_column"`
+}
+
+func NewMemberWorkloadPresenter(workload domains.MemberWorkload) MemberWorkloadPresenter {
+	columns := make([]ColumnTaskCountPresenter, len(workload.TasksPerColumn))
+	for i, column := range workload.TasksPerColumn {
+		columns[i] = ColumnTaskCountPresenter{
+			ColumnID:   column.ColumnID,
+			ColumnName: column.ColumnName,
+			Count:      column.Count,
+		}
+	}
+
+	return MemberWorkloadPresenter{
+		User:            NewUserPresenter(workload.User),
+		OpenTasks:       workload.OpenTasks,
+		OpenStoryPoints: workload.OpenStoryPoints,
+		OverdueTasks:    workload.OverdueTasks,
+		TasksPerColumn:  columns,
+	}
+}
@@ -0,0 +1,47 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+type BoardInvitationPresenter struct {
+	ID          uint                     `json:"id"`
+	BoardID     uint                     `json:"board_id"`
+	BoardName   string                   `json:"board_name,omitempty"`
+	Email       string                   `json:"email"`
+	RoleID      uint                     `json:"role_id"`
+	RoleName    string                   `json:"role_name,omitempty"`
+	InvitedBy   uint                     `json:"invited_by"`
+	Status      domains.InvitationStatus `json:"status"`
+	ExpiresAt   time.Time                `json:"expires_at"`
+	RespondedAt *time.Time               `json:"responded_at"`
+	CreatedAt   time.Time                `json:"created_at"`
+}
+
+func NewBoardInvitationPresenter(invitation domains.BoardInvitation) BoardInvitationPresenter {
+	p := BoardInvitationPresenter{
+		ID:          invitation.ID,
+		BoardID:     invitation.BoardID,
+		Email:       invitation.Email,
+		RoleID:      invitation.RoleID,
+		InvitedBy:   invitation.InvitedBy,
+		Status:      invitation.Status,
+		ExpiresAt:   invitation.ExpiresAt,
+		RespondedAt: invitation.RespondedAt,
+		CreatedAt:   invitation.CreatedAt,
+	}
+	if invitation.Board != nil {
+		p.BoardName = invitation.Board.Name
+	}
+	if invitation.Role != nil {
+		p.RoleName = invitation.Role.Name
+	}
+	return p
+}
+
+func NewBoardInvitationPresenters(invitations []domains.BoardInvitation) []BoardInvitationPresenter {
+	return fp.Map(invitations, NewBoardInvitationPresenter)
+}
@@ -0,0 +1,89 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+type BoardShareLinkPresenter struct {
+	ID        uint       `json:"id"`
+	BoardID   uint       `json:"board_id"`
+	CreatedBy uint       `json:"created_by"`
+	Prefix    string     `json:"prefix"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type CreatedBoardShareLinkPresenter struct {
+	BoardShareLinkPresenter
+	Token string `json:"token"`
+}
+
+func NewBoardShareLinkPresenter(link domains.BoardShareLink) BoardShareLinkPresenter {
+	return BoardShareLinkPresenter{
+		ID:        link.ID,
+		BoardID:   link.BoardID,
+		CreatedBy: link.CreatedBy,
+		Prefix:    link.Prefix,
+		ExpiresAt: link.ExpiresAt,
+		RevokedAt: link.RevokedAt,
+		CreatedAt: link.CreatedAt,
+	}
+}
+
+func NewBoardShareLinkPresenters(links []domains.BoardShareLink) []BoardShareLinkPresenter {
+	return fp.Map(links, NewBoardShareLinkPresenter)
+}
+
+// SharedBoardPresenter is the read-only view of a board opened from a share
+// link, it leaves out everything about the members of the board.
+type SharedBoardPresenter struct {
+	ID      uint                       `json:"id"`
+	Name    string                     `json:"name"`
+	Columns []*ColumnOutBoundPresenter `json:"columns"`
+}
+
+func NewSharedBoardPresenter(board *domains.Board, columns []*domains.Column) SharedBoardPresenter {
+	return SharedBoardPresenter{
+		ID:      board.ID,
+		Name:    board.Name,
+		Columns: fp.Map(columns, NewColumnOutBoundPresenter),
+	}
+}
+
+type SharedTaskPresenter struct {
+	ID            uint       `json:"id"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	ColumnID      uint       `json:"column_id"`
+	ParentID      *uint      `json:"parent_id"`
+	OrderPosition int        `json:"order_position"`
+	Name          string     `json:"name"`
+	Description   string     `json:"description"`
+	StartDateTime *time.Time `json:"start_datetime"`
+	EndDateTime   *time.Time `json:"end_datetime"`
+	StoryPoint    int        `json:"story_point"`
+}
+
+func NewSharedTaskPresenter(task domains.Task) SharedTaskPresenter {
+	return SharedTaskPresenter{
+		ID:            task.ID,
+		CreatedAt:     task.CreatedAt,
+		UpdatedAt:     task.UpdatedAt,
+		ColumnID:      task.ColumnID,
+		ParentID:      task.ParentID,
+		OrderPosition: task.OrderPosition,
+		Name:          task.Name,
+		Description:   task.Description,
+		StartDateTime: task.StartDateTime,
+		EndDateTime:   task.EndDateTime,
+		StoryPoint:    task.StoryPoint,
+	}
+}
+
+func NewSharedTaskPresenters(tasks []domains.Task) []SharedTaskPresenter {
+	return fp.Map(tasks, NewSharedTaskPresenter)
+}
@@ -0,0 +1,43 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+type PersonalAccessTokenPresenter struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	BoardID    *uint      `json:"board_id"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreatedPersonalAccessTokenPresenter struct {
+	PersonalAccessTokenPresenter
+	Token string `json:"token"`
+}
+
+func NewPersonalAccessTokenPresenter(token domains.PersonalAccessToken) PersonalAccessTokenPresenter {
+	return PersonalAccessTokenPresenter{
+		ID:         token.ID,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     token.Scopes,
+		BoardID:    token.BoardID,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+func NewPersonalAccessTokenPresenters(tokens []domains.PersonalAccessToken) []PersonalAccessTokenPresenter {
+	return fp.Map(tokens, NewPersonalAccessTokenPresenter)
+}
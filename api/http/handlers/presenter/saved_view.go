@@ -0,0 +1,65 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type SavedViewPresenter struct {
+	ID             uint                `json:"id"`
+	CreatedAt      time.Time           `json:"created_at"`
+	UpdatedAt      time.Time           `json:"updated_at"`
+	BoardID        uint                `json:"board_id"`
+	UserID         uint                `json:"user_id"`
+	Name           string              `json:"name"`
+	Filter         TaskFilterPresenter `json:"filter"`
+	Sort           string              `json:"sort"`
+	GroupBy        string              `json:"group_by"`
+	VisibleColumns []uint              `json:"visible_columns"`
+	IsShared       bool                `json:"is_shared"`
+}
+
+type TaskFilterPresenter struct {
+	ColumnIDs   []uint  `json:"column_ids"`
+	AssigneeIDs []uint  `json:"assignee_ids"`
+	Unassigned  bool    `json:"unassigned"`
+	SprintID    *uint   `json:"sprint_id"`
+	Backlog     bool    `json:"backlog"`
+	Search      string  `json:"search"`
+	DueFrom     *string `json:"due_from"`
+	DueTo       *string `json:"due_to"`
+}
+
+func NewSavedViewPresenter(view *domains.SavedView, sort string) *SavedViewPresenter {
+	return &SavedViewPresenter{
+		ID:        view.ID,
+		CreatedAt: view.CreatedAt,
+		UpdatedAt: view.UpdatedAt,
+		BoardID:   view.BoardID,
+		UserID:    view.UserID,
+		Name:      view.Name,
+		Filter: TaskFilterPresenter{
+			ColumnIDs:   view.Filter.ColumnIDs,
+			AssigneeIDs: view.Filter.AssigneeIDs,
+			Unassigned:  view.Filter.Unassigned,
+			SprintID:    view.Filter.SprintID,
+			Backlog:     view.Filter.Backlog,
+			Search:      view.Filter.Search,
+			DueFrom:     formatDateOrNil(view.Filter.DueFrom),
+			DueTo:       formatDateOrNil(view.Filter.DueTo),
+		},
+		Sort:           sort,
+		GroupBy:        string(view.Filter.Sort.GroupBy),
+		VisibleColumns: view.VisibleColumns,
+		IsShared:       view.IsShared,
+	}
+}
+
+func formatDateOrNil(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	value := t.Format(time.DateOnly)
+	return &value
+}
@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type SprintPresenter struct {
+	ID        uint       `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	BoardID   uint       `json:"board_id"`
+	Name      string     `json:"name"`
+	Goal      string     `json:"goal"`
+	StartDate string     `json:"start_date"`
+	EndDate   string     `json:"end_date"`
+	State     string     `json:"state"`
+	StartedAt *time.Time `json:"started_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+}
+
+func NewSprintPresenter(sprint *domains.Sprint) *SprintPresenter {
+	return &SprintPresenter{
+		ID:        sprint.ID,
+		CreatedAt: sprint.CreatedAt,
+		UpdatedAt: sprint.UpdatedAt,
+		BoardID:   sprint.BoardID,
+		Name:      sprint.Name,
+		Goal:      sprint.Goal,
+		StartDate: sprint.StartDate.Format(time.DateOnly),
+		EndDate:   sprint.EndDate.Format(time.DateOnly),
+		State:     string(sprint.State),
+		StartedAt: sprint.StartedAt,
+		ClosedAt:  sprint.ClosedAt,
+	}
+}
+
+type BurndownPointPresenter struct {
+	Date            string  `json:"date"`
+	RemainingPoints int     `json:"remaining_points"`
+	IdealPoints     float64 `json:"ideal_points"`
+}
+
+func NewBurndownPointPresenter(point domains.BurndownPoint) BurndownPointPresenter {
+	return BurndownPointPresenter{
+		Date:            point.Date.Format(time.DateOnly),
+		RemainingPoints: point.RemainingPoints,
+		IdealPoints:     point.IdealPoints,
+	}
+}
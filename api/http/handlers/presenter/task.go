@@ -1,9 +1,11 @@
 package presenter
 
 import (
+	"errors"
 	"time"
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 )
 
@@ -107,3 +109,43 @@ func NewTaskCommentPresenter(comment *domains.TaskComment) *TaskCommentPresenter
 		User:      user,
 	}
 }
+
+type BulkTaskResultPresenter struct {
+	TaskID  uint   `json:"task_id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+type BulkTaskResponsePresenter struct {
+	Succeeded int                       `json:"succeeded"`
+	Failed    int                       `json:"failed"`
+	Results   []BulkTaskResultPresenter `json:"results"`
+}
+
+func NewBulkTaskResponsePresenter(results []domains.BulkTaskResult) *BulkTaskResponsePresenter {
+	response := &BulkTaskResponsePresenter{
+		Results: make([]BulkTaskResultPresenter, len(results)),
+	}
+
+	for i, result := range results {
+		item := BulkTaskResultPresenter{TaskID: result.TaskID, Success: result.Err == nil}
+		if result.Err != nil {
+			response.Failed++
+			item.Error = errorMessage(result.Err)
+		} else {
+			response.Succeeded++
+		}
+		response.Results[i] = item
+	}
+
+	return response
+}
+
+// errorMessage hides unexpected errors, only fiber errors are meant for clients.
+func errorMessage(err error) string {
+	var fiberError *fiber.Error
+	if errors.As(err, &fiberError) {
+		return fiberError.Message
+	}
+	return "Internal Server Error"
+}
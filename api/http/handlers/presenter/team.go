@@ -0,0 +1,80 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+type TeamPresenter struct {
+	ID          uint      `json:"id"`
+	WorkspaceID uint      `json:"workspace_id"`
+	Name        string    `json:"name"`
+	CreatedBy   uint      `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func NewTeamPresenter(team domains.Team) TeamPresenter {
+	return TeamPresenter{
+		ID:          team.ID,
+		WorkspaceID: team.WorkspaceID,
+		Name:        team.Name,
+		CreatedBy:   team.CreatedBy,
+		CreatedAt:   team.CreatedAt,
+	}
+}
+
+func NewTeamPresenters(teams []domains.Team) []TeamPresenter {
+	return fp.Map(teams, NewTeamPresenter)
+}
+
+type TeamMemberPresenter struct {
+	UserID    uint      `json:"user_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewTeamMemberPresenter(member domains.TeamMember) TeamMemberPresenter {
+	p := TeamMemberPresenter{
+		UserID:    member.UserID,
+		CreatedAt: member.CreatedAt,
+	}
+	if member.User != nil {
+		p.Name = member.User.Name
+		p.Email = member.User.Email
+	}
+	return p
+}
+
+func NewTeamMemberPresenters(members []domains.TeamMember) []TeamMemberPresenter {
+	return fp.Map(members, NewTeamMemberPresenter)
+}
+
+type BoardTeamPresenter struct {
+	TeamID    uint      `json:"team_id"`
+	TeamName  string    `json:"team_name"`
+	RoleID    uint      `json:"role_id"`
+	RoleName  string    `json:"role_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewBoardTeamPresenter(boardTeam domains.BoardTeam) BoardTeamPresenter {
+	p := BoardTeamPresenter{
+		TeamID:    boardTeam.TeamID,
+		RoleID:    boardTeam.RoleID,
+		CreatedAt: boardTeam.CreatedAt,
+	}
+	if boardTeam.Team != nil {
+		p.TeamName = boardTeam.Team.Name
+	}
+	if boardTeam.Role != nil {
+		p.RoleName = boardTeam.Role.Name
+	}
+	return p
+}
+
+func NewBoardTeamPresenters(boardTeams []domains.BoardTeam) []BoardTeamPresenter {
+	return fp.Map(boardTeams, NewBoardTeamPresenter)
+}
@@ -0,0 +1,37 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+type TrashItemPresenter struct {
+	ID        uint           `json:"id"`
+	Type      string         `json:"type"`
+	BoardID   uint           `json:"board_id"`
+	Name      string         `json:"name"`
+	DeletedAt time.Time      `json:"deleted_at"`
+	DeletedBy *UserPresenter `json:"deleted_by"`
+}
+
+func NewTrashItemPresenter(item domains.TrashItem) TrashItemPresenter {
+	var deletedBy *UserPresenter
+	if item.DeletedBy != nil {
+		deletedBy = NewUserPresenter(item.DeletedBy)
+	}
+
+	return TrashItemPresenter{
+		ID:        item.ID,
+		Type:      string(item.Type),
+		BoardID:   item.BoardID,
+		Name:      item.Name,
+		DeletedAt: item.DeletedAt,
+		DeletedBy: deletedBy,
+	}
+}
+
+func NewTrashItemsPresenter(items []domains.TrashItem) []TrashItemPresenter {
+	return fp.Map(items, NewTrashItemPresenter)
+}
@@ -1,6 +1,10 @@
 package presenter
 
-import "github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
 
 type UserPresenter struct {
 	ID    uint   `json:"id"`
@@ -15,3 +19,25 @@ func NewUserPresenter(user *domains.User) *UserPresenter {
 		Email: user.Email,
 	}
 }
+
+type ProfilePresenter struct {
+	ID              uint       `json:"id"`
+	Name            string     `json:"name"`
+	Email           string     `json:"email"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	AvatarURL       string     `json:"avatar_url"`
+	Timezone        string     `json:"timezone"`
+	Locale          string     `json:"locale"`
+}
+
+func NewProfilePresenter(user *domains.User) *ProfilePresenter {
+	return &ProfilePresenter{
+		ID:              user.ID,
+		Name:            user.Name,
+		Email:           user.Email,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+		AvatarURL:       user.AvatarURL,
+		Timezone:        user.Timezone,
+		Locale:          user.Locale,
+	}
+}
@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+type WorkspacePresenter struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	CreatedBy uint      `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewWorkspacePresenter(workspace domains.Workspace) WorkspacePresenter {
+	return WorkspacePresenter{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		CreatedBy: workspace.CreatedBy,
+		CreatedAt: workspace.CreatedAt,
+	}
+}
+
+func NewWorkspacePresenters(workspaces []domains.Workspace) []WorkspacePresenter {
+	return fp.Map(workspaces, NewWorkspacePresenter)
+}
+
+type WorkspaceMemberPresenter struct {
+	UserID    uint                  `json:"user_id"`
+	Name      string                `json:"name"`
+	Email     string                `json:"email"`
+	Role      domains.WorkspaceRole `json:"role"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+func NewWorkspaceMemberPresenter(member domains.WorkspaceMember) WorkspaceMemberPresenter {
+	p := WorkspaceMemberPresenter{
+		UserID:    member.UserID,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+	}
+	if member.User != nil {
+		p.Name = member.User.Name
+		p.Email = member.User.Email
+	}
+	return p
+}
+
+func NewWorkspaceMemberPresenters(members []domains.WorkspaceMember) []WorkspaceMemberPresenter {
+	return fp.Map(members, NewWorkspaceMemberPresenter)
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+type UpdateProfileInput struct {
+	Name      *string `json:"name" validate:"omitempty,min=3,max=20,excludesall=;" example:"test"`
+	Email     *string `json:"email" validate:"omitempty,email,excludesall=;" example:"test@example.com"`
+	AvatarURL *string `json:"avatar_url" validate:"omitempty,http_url,max=2048" example:"https://example.com/avatar.png"`
+	Timezone  *string `json:"timezone" validate:"omitempty,timezone" example:"Europe/Berlin"`
+	Locale    *string `json:"locale" validate:"omitempty,bcp47_language_tag" example:"en-US"`
+	// CurrentPassword is required to change the email, unless the user has no password
+	CurrentPassword string `json:"current_password" example:"1234Test@"`
+}
+
+type ChangePasswordInput struct {
+	CurrentPassword string `json:"current_password" example:"1234Test@"`
+	NewPassword     string `json:"new_password" validate:"required,excludesall=;" example:"5678Test@"`
+}
+
+type DeleteAccountInput struct {
+	Password   string `json:"password" example:"1234Test@"`
+	ReassignTo *uint  `json:"reassign_to" example:"2"`
+}
+
+// GetProfile returns the profile of the logged in user
+// @Summary Get profile
+// @Description returns the profile of the logged in user
+// @Tags Profile
+// @Produce json
+// @Success 200 {object} presenter.ProfilePresenter
+// @Failure 401
+// @Failure 500
+// @Router /me [get]
+// @Security ApiKeyAuth
+func GetProfile(userService *services.UserService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		user, err := userService.GetUserByID(c.UserContext(), userID)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting profile: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Profile fetched successfully", presenter.NewProfilePresenter(user))
+	}
+}
+
+// UpdateProfile updates the profile of the logged in user
+// @Summary Update profile
+// @Description updates name, email, avatar, timezone and locale, only the given fields are changed. Changing the email needs the current password, unless the user has none, and the new email has to be verified again.
+// @Tags Profile
+// @Accept  json
+// @Produce json
+// @Param   body  body      UpdateProfileInput  true  "Profile fields"
+// @Success 200 {object} presenter.ProfilePresenter
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /me [put]
+// @Security ApiKeyAuth
+func UpdateProfile(userService *services.UserService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input UpdateProfileInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing update profile request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing update profile request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating update profile request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		user, err := userService.UpdateProfile(c.UserContext(), userID, services.ProfileUpdate{
+			Name:            input.Name,
+			Email:           input.Email,
+			AvatarURL:       input.AvatarURL,
+			Timezone:        input.Timezone,
+			Locale:          input.Locale,
+			CurrentPassword: input.CurrentPassword,
+		})
+		if err != nil {
+			log.ErrorLog.Printf("Error updating profile: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Profile updated successfully", presenter.NewProfilePresenter(user))
+	}
+}
+
+// ChangePassword changes the password of the logged in user
+// @Summary Change password
+// @Description sets a new password after checking the current one and logs out all sessions. Users without a password, created by single sign-on, leave current_password empty.
+// @Tags Profile
+// @Accept  json
+// @Produce json
+// @Param   body  body      ChangePasswordInput  true  "Current and new password"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /me/password [put]
+// @Security ApiKeyAuth
+func ChangePassword(userService *services.UserService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input ChangePasswordInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing change password request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing change password request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating change password request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := userService.ChangePassword(c.UserContext(), userID, input.CurrentPassword, input.NewPassword); err != nil {
+			log.ErrorLog.Printf("Error changing password: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Password changed successfully, please log in again", nil)
+	}
+}
+
+// DeleteAccount deletes the account of the logged in user
+// @Summary Delete account
+// @Description anonymizes the logged in user, the password is required unless the user has none. Assigned tasks are reassigned to reassign_to on boards that user is a member of and unassigned elsewhere. Boards where the user is the only owner are handed over to the highest ranked member, or deleted when nobody else is a member.
+// @Tags Profile
+// @Accept  json
+// @Produce json
+// @Param   body  body      DeleteAccountInput  true  "Current password and optional task assignee"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /me [delete]
+// @Security ApiKeyAuth
+func DeleteAccount(userService *services.UserService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input DeleteAccountInput
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing delete account request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing delete account request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating delete account request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := userService.DeleteAccount(c.UserContext(), userID, input.Password, input.ReassignTo); err != nil {
+			log.ErrorLog.Printf("Error deleting account: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Account deleted successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
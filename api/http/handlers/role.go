@@ -5,7 +5,9 @@ import (
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
 	"github.com/gofiber/fiber/v2"
 )
@@ -15,20 +17,28 @@ var (
 )
 
 type CreateRoleRequest struct {
-	Name        string `json:"name" validate:"required,min=3,max=50" example:"new role"`
-	Description string `json:"description" validate:"required,max=255" example:"role description"`
-	Weight      int    `json:"weight" validate:"required" example:"1"`
+	Name        string   `json:"name" validate:"required,min=3,max=50" example:"new role"`
+	Description string   `json:"description" validate:"required,max=255" example:"role description"`
+	BoardID     *uint    `json:"board_id" example:"1"`
+	Permissions []string `json:"permissions" validate:"required,min=1" example:"task:create,task:move"`
+}
+
+func toBoardActions(permissions []string) []domains.BoardAction {
+	return fp.Map(permissions, func(permission string) domains.BoardAction {
+		return domains.BoardAction(permission)
+	})
 }
 
 // CreateRole creates a new role
 // @Summary Create Role
-// @Description creates a role
+// @Description creates a role, roles with a board_id can only be granted on that board. Roles without a board_id can only be created by admins
 // @Tags Role
 // @Accept  json
 // @Produce json
 // @Param   body  body      CreateRoleRequest  true  "Create Role"
 // @Success 200
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /roles [post]
 // @Security ApiKeyAuth
@@ -48,13 +58,20 @@ func CreateRole(roleService *services.RoleService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error validating role creation request body"})
 		}
 
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
 		roleModel := domains.Role{
 			Name:        input.Name,
 			Description: input.Description,
-			Weight:      input.Weight,
+			BoardID:     input.BoardID,
+			Permissions: toBoardActions(input.Permissions),
 		}
 
-		err = roleService.CreateRole(c.Context(), &roleModel)
+		err = roleService.CreateRole(c.UserContext(), userID, &roleModel)
 		if err != nil {
 			log.ErrorLog.Printf("Error creating role: %v\n", err)
 			return SendError(c, err)
@@ -66,6 +83,29 @@ func CreateRole(roleService *services.RoleService) fiber.Handler {
 	}
 }
 
+// GetRoles lists the roles
+// @Summary Get Roles
+// @Description lists the roles available on every board and the custom roles of boards
+// @Tags Role
+// @Produce json
+// @Success 200 {array} domains.Role
+// @Failure 500
+// @Router /roles [get]
+// @Security ApiKeyAuth
+func GetRoles(roleService *services.RoleService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		roles, err := roleService.GetAllRoles(c.UserContext())
+		if err != nil {
+			log.ErrorLog.Printf("Error getting roles: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Roles loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, roles)
+	}
+}
+
 // GetRoleByID get a role
 // @Summary Get Role
 // @Description gets a role
@@ -86,7 +126,7 @@ func GetRoleByID(roleService *services.RoleService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing role id"})
 		}
 
-		role, err := roleService.GetRoleById(c.Context(), uint(id))
+		role, err := roleService.GetRoleById(c.UserContext(), uint(id))
 		if err != nil {
 			log.ErrorLog.Printf("Error getting role: %v\n", err)
 			return SendError(c, err)
@@ -104,14 +144,14 @@ func GetRoleByID(roleService *services.RoleService) fiber.Handler {
 }
 
 type UpdateRoleRequest struct {
-	Name        string `json:"name" validate:"required,min=3,max=50" example:"updated role"`
-	Description string `json:"description" validate:"required,max=255" example:"updated description"`
-	Weight      int    `json:"weight" validate:"required" example:"1"`
+	Name        string   `json:"name" validate:"required,min=3,max=50" example:"updated role"`
+	Description string   `json:"description" validate:"required,max=255" example:"updated description"`
+	Permissions []string `json:"permissions" validate:"required,min=1" example:"task:create,task:move"`
 }
 
 // UpdateRole updates an existing role
 // @Summary Update Role
-// @Description updates a role
+// @Description updates a role, roles available on every board can only be updated by admins and built-in roles can not be renamed or given other permissions
 // @Tags Role
 // @Accept  json
 // @Produce json
@@ -119,6 +159,7 @@ type UpdateRoleRequest struct {
 // @Param   body  body      UpdateRoleRequest  true  "Update Role"
 // @Success 200
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /roles/{id} [put]
 // @Security ApiKeyAuth
@@ -143,14 +184,20 @@ func UpdateRole(roleService *services.RoleService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error validating role update request body"})
 		}
 
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
 		roleModel := domains.Role{
 			ID:          uint(id),
 			Name:        input.Name,
 			Description: input.Description,
-			Weight:      input.Weight,
+			Permissions: toBoardActions(input.Permissions),
 		}
 
-		err = roleService.UpdateRole(c.Context(), &roleModel)
+		err = roleService.UpdateRole(c.UserContext(), userID, &roleModel)
 		if err != nil {
 			log.ErrorLog.Printf("Error updating role: %v\n", err)
 			return SendError(c, err)
@@ -164,12 +211,14 @@ func UpdateRole(roleService *services.RoleService) fiber.Handler {
 
 // DeleteRole delete a role
 // @Summary Delete Role
-// @Description deletes a role
+// @Description deletes a role, built-in roles and roles still granted on a board can not be deleted
 // @Tags Role
 // @Produce json
 // @Param   id      path     string  true  "Role ID"
 // @Success 204
 // @Failure 400
+// @Failure 403
+// @Failure 409
 // @Failure 500
 // @Router /roles/{id} [delete]
 // @Security ApiKeyAuth
@@ -181,7 +230,13 @@ func DeleteRole(roleService *services.RoleService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing role id"})
 		}
 
-		err = roleService.DeleteRole(c.Context(), uint(id))
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		err = roleService.DeleteRole(c.UserContext(), userID, uint(id))
 		if err != nil {
 			log.ErrorLog.Printf("Error deleting role: %v\n", err)
 			return SendError(c, err)
@@ -192,3 +247,41 @@ func DeleteRole(roleService *services.RoleService) fiber.Handler {
 		return SendSuccessResponse(c, msg, id)
 	}
 }
+
+// GetBoardRoles lists the roles of a board
+// @Summary Get Board Roles
+// @Description lists the roles that can be granted on a board, custom roles of the board included
+// @Tags Role
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Success 200 {array} domains.Role
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/roles [get]
+// @Security ApiKeyAuth
+func GetBoardRoles(roleService *services.RoleService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing board id"})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		roles, err := roleService.GetBoardRoles(c.UserContext(), userID, uint(boardID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting board roles: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Roles loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, roles)
+	}
+}
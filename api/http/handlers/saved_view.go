@@ -0,0 +1,378 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SavedViewRequest struct {
+	Name           string            `json:"name" validate:"required,min=3,max=50" example:"My open bugs"`
+	Filter         TaskFilterRequest `json:"filter"`
+	Sort           string            `json:"sort" example:"-created_at"`
+	GroupBy        string            `json:"group_by" validate:"omitempty,oneof=column assignee sprint" example:"column"`
+	VisibleColumns []uint            `json:"visible_columns" validate:"omitempty,dive,gte=1" example:"1,2"`
+	IsShared       bool              `json:"is_shared" example:"false"`
+}
+
+type TaskFilterRequest struct {
+	ColumnIDs   []uint `json:"column_ids" validate:"omitempty,dive,gte=1" example:"1,2"`
+	AssigneeIDs []uint `json:"assignee_ids" validate:"omitempty,dive,gte=1" example:"3"`
+	Unassigned  bool   `json:"unassigned" example:"false"`
+	SprintID    *uint  `json:"sprint_id,omitempty" validate:"omitempty,gte=1" example:"1"`
+	Backlog     bool   `json:"backlog" example:"false"`
+	Search      string `json:"search" validate:"max=100" example:"login"`
+	DueFrom     string `json:"due_from" example:"2024-06-01"`
+	DueTo       string `json:"due_to" example:"2024-06-30"`
+}
+
+var (
+	ErrInvalidSavedViewIDParam = fiber.NewError(fiber.StatusBadRequest, "invalid saved view id")
+)
+
+// CreateSavedView creates a saved view
+// @Summary Create Saved View
+// @Description saves a task filter, sort, grouping and visible columns of a board for the user, maintainers can share it with the board
+// @Tags Saved View
+// @Accept json
+// @Produce json
+// @Param   body      body     SavedViewRequest  true  "Create Saved View"
+// @Param   boardID      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{boardID}/views [post]
+// @Security ApiKeyAuth
+func CreateSavedView(viewService *services.SavedViewService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		view, err := parseSavedViewRequest(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		view.BoardID = uint(boardID)
+		view.UserID = userID
+
+		if err = viewService.CreateView(c.Context(), view); err != nil {
+			log.ErrorLog.Printf("Error creating saved view: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Saved view created successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, presenter.NewSavedViewPresenter(view, formatTaskSort(view.Filter.Sort)))
+	}
+}
+
+// GetSavedViews get saved views of a board
+// @Summary Get Saved Views
+// @Description gets the saved views of the user and the shared views of a board
+// @Tags Saved View
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{boardID}/views [get]
+// @Security ApiKeyAuth
+func GetSavedViews(viewService *services.SavedViewService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		views, err := viewService.GetViews(c.Context(), userID, uint(boardID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting saved views: %v\n", err)
+			return SendError(c, err)
+		}
+
+		data := make([]*presenter.SavedViewPresenter, len(views))
+		for i := range views {
+			data[i] = presenter.NewSavedViewPresenter(&views[i], formatTaskSort(views[i].Filter.Sort))
+		}
+
+		return SendSuccessResponse(c, "Saved views loaded successfully", data)
+	}
+}
+
+// GetSavedViewByID get a saved view
+// @Summary Get Saved View
+// @Description gets a saved view
+// @Tags Saved View
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Saved View ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{boardID}/views/{id} [get]
+// @Security ApiKeyAuth
+func GetSavedViewByID(viewService *services.SavedViewService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, viewID, err := boardAndSavedViewParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		view, err := viewService.GetViewByID(c.Context(), userID, boardID, viewID)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting saved view: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Saved view loaded successfully", presenter.NewSavedViewPresenter(view, formatTaskSort(view.Filter.Sort)))
+	}
+}
+
+// UpdateSavedView update a saved view
+// @Summary Update Saved View
+// @Description updates a saved view, shared views can also be updated by maintainers
+// @Tags Saved View
+// @Accept json
+// @Produce json
+// @Param   body      body     SavedViewRequest  true  "Update Saved View"
+// @Param   boardID      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Saved View ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{boardID}/views/{id} [put]
+// @Security ApiKeyAuth
+func UpdateSavedView(viewService *services.SavedViewService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, viewID, err := boardAndSavedViewParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		view, err := parseSavedViewRequest(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		view.ID = viewID
+		view.BoardID = boardID
+
+		updatedView, err := viewService.UpdateView(c.Context(), userID, view)
+		if err != nil {
+			log.ErrorLog.Printf("Error updating saved view: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Saved view updated successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, presenter.NewSavedViewPresenter(updatedView, formatTaskSort(updatedView.Filter.Sort)))
+	}
+}
+
+// DeleteSavedView delete a saved view
+// @Summary Delete Saved View
+// @Description deletes a saved view, shared views can also be deleted by maintainers
+// @Tags Saved View
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Saved View ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{boardID}/views/{id} [delete]
+// @Security ApiKeyAuth
+func DeleteSavedView(viewService *services.SavedViewService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, viewID, err := boardAndSavedViewParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = viewService.DeleteView(c.Context(), userID, boardID, viewID); err != nil {
+			log.ErrorLog.Printf("Error deleting saved view: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Saved view deleted successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
+
+// ExecuteSavedView get tasks of a saved view
+// @Summary Execute Saved View
+// @Description gets the task page matching a saved view
+// @Tags Saved View
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Saved View ID"
+// @Param   page      query     int  false  "Page"
+// @Param   page_size      query     int  false  "Page size"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{boardID}/views/{id}/tasks [get]
+// @Security ApiKeyAuth
+func ExecuteSavedView(viewService *services.SavedViewService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, viewID, err := boardAndSavedViewParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		page, pageSize := PageAndPageSize(c)
+
+		tasks, total, err := viewService.ExecuteView(c.Context(), userID, boardID, viewID, uint(page), uint(pageSize))
+		if err != nil {
+			log.ErrorLog.Printf("Error executing saved view: %v\n", err)
+			return SendError(c, err)
+		}
+
+		taskPresenters := make([]*presenter.TaskPresenter, len(tasks))
+		for i := range tasks {
+			taskPresenters[i] = presenter.NewTaskPresenter(&tasks[i])
+		}
+
+		return SendSuccessPaginateResponse(
+			c,
+			"Successfully fetched.",
+			taskPresenters,
+			uint(page),
+			uint(pageSize),
+			total,
+		)
+	}
+}
+
+func boardAndSavedViewParams(c *fiber.Ctx) (uint, uint, error) {
+	boardID, err := c.ParamsInt("boardID")
+	if err != nil {
+		log.ErrorLog.Printf("Error parsing board id: %v\n", err)
+		return 0, 0, ErrInvalidBoardIDParam
+	}
+
+	viewID, err := c.ParamsInt("id")
+	if err != nil {
+		log.ErrorLog.Printf("Error parsing saved view id: %v\n", err)
+		return 0, 0, ErrInvalidSavedViewIDParam
+	}
+
+	return uint(boardID), uint(viewID), nil
+}
+
+func parseSavedViewRequest(c *fiber.Ctx) (*domains.SavedView, error) {
+	validate := validation.NewValidator()
+	var input SavedViewRequest
+
+	if err := c.BodyParser(&input); err != nil {
+		log.ErrorLog.Printf("Error parsing saved view request body: %v\n", err)
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Error parsing request body")
+	}
+
+	if err := validate.Struct(input); err != nil {
+		log.ErrorLog.Printf("Error validating saved view request body: %v\n", err)
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Error validating request body")
+	}
+
+	sort, err := parseTaskSort(input.Sort, input.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	dueFrom, err := parseOptionalDate(input.Filter.DueFrom, "due_from")
+	if err != nil {
+		return nil, err
+	}
+
+	dueTo, err := parseOptionalDate(input.Filter.DueTo, "due_to")
+	if err != nil {
+		return nil, err
+	}
+
+	return &domains.SavedView{
+		Name: input.Name,
+		Filter: domains.TaskFilter{
+			ColumnIDs:   input.Filter.ColumnIDs,
+			AssigneeIDs: input.Filter.AssigneeIDs,
+			Unassigned:  input.Filter.Unassigned,
+			SprintID:    input.Filter.SprintID,
+			Backlog:     input.Filter.Backlog,
+			Search:      input.Filter.Search,
+			DueFrom:     dueFrom,
+			DueTo:       dueTo,
+			Sort:        sort,
+		},
+		VisibleColumns: input.VisibleColumns,
+		IsShared:       input.IsShared,
+	}, nil
+}
+
+func parseOptionalDate(value string, field string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		log.ErrorLog.Printf("Error invalid date: %v\n", err)
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid "+field+" format, example: "+time.DateOnly)
+	}
+	return &date, nil
+}
@@ -0,0 +1,531 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SprintRequest struct {
+	Name      string `json:"name" validate:"required,min=3,max=50" example:"Sprint 12"`
+	Goal      string `json:"goal" validate:"max=2000" example:"Ship the new onboarding flow"`
+	StartDate string `json:"start_date" validate:"required" example:"2024-06-03"`
+	EndDate   string `json:"end_date" validate:"required" example:"2024-06-16"`
+}
+
+type AssignSprintTasksRequest struct {
+	TaskIDs []uint `json:"task_ids" validate:"required,min=1,dive,gte=1" example:"1,2,3"`
+}
+
+type CloseSprintRequest struct {
+	CarryOverSprintID *uint `json:"carry_over_sprint_id,omitempty" validate:"omitempty,gte=1" example:"2"`
+}
+
+var (
+	ErrInvalidSprintIDParam   = fiber.NewError(fiber.StatusBadRequest, "invalid sprint id")
+	ErrInvalidStartDateLayout = fiber.NewError(fiber.StatusBadRequest, "invalid start date format, example: "+time.DateOnly)
+	ErrInvalidEndDateLayout   = fiber.NewError(fiber.StatusBadRequest, "invalid end date format, example: "+time.DateOnly)
+)
+
+// CreateSprint creates a sprint
+// @Summary Create Sprint
+// @Description creates a planned sprint in a board
+// @Tags Sprint
+// @Accept json
+// @Produce json
+// @Param   body      body     SprintRequest  true  "Create Sprint"
+// @Param   id      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/sprints [post]
+// @Security ApiKeyAuth
+func CreateSprint(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		sprintModel, err := parseSprintRequest(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		sprintModel.BoardID = uint(boardID)
+		sprintModel.CreatedBy = userID
+
+		if err = sprintService.CreateSprint(c.Context(), sprintModel); err != nil {
+			log.ErrorLog.Printf("Error creating sprint: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Sprint created successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, presenter.NewSprintPresenter(sprintModel))
+	}
+}
+
+// GetSprints get sprints of a board
+// @Summary Get Sprints
+// @Description gets all sprints of a board
+// @Tags Sprint
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/sprints [get]
+// @Security ApiKeyAuth
+func GetSprints(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		sprints, err := sprintService.GetSprints(c.Context(), userID, uint(boardID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting sprints: %v\n", err)
+			return SendError(c, err)
+		}
+
+		data := make([]*presenter.SprintPresenter, len(sprints))
+		for i := range sprints {
+			data[i] = presenter.NewSprintPresenter(&sprints[i])
+		}
+
+		return SendSuccessResponse(c, "Sprints loaded successfully", data)
+	}
+}
+
+// GetSprintByID get a sprint
+// @Summary Get Sprint
+// @Description gets a sprint
+// @Tags Sprint
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Param   sprintId      path     string  true  "Sprint ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/sprints/{sprintId} [get]
+// @Security ApiKeyAuth
+func GetSprintByID(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, sprintID, err := boardAndSprintParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		sprint, err := sprintService.GetSprintByID(c.Context(), userID, boardID, sprintID)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting sprint: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Sprint loaded successfully", presenter.NewSprintPresenter(sprint))
+	}
+}
+
+// UpdateSprint update a sprint
+// @Summary Update Sprint
+// @Description updates name, goal and dates of a sprint that is not closed
+// @Tags Sprint
+// @Accept json
+// @Produce json
+// @Param   body      body     SprintRequest  true  "Update Sprint"
+// @Param   id      path     string  true  "Board ID"
+// @Param   sprintId      path     string  true  "Sprint ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/sprints/{sprintId} [put]
+// @Security ApiKeyAuth
+func UpdateSprint(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, sprintID, err := boardAndSprintParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		sprintModel, err := parseSprintRequest(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		sprintModel.ID = sprintID
+		sprintModel.BoardID = boardID
+
+		sprint, err := sprintService.UpdateSprint(c.Context(), userID, sprintModel)
+		if err != nil {
+			log.ErrorLog.Printf("Error updating sprint: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Sprint updated successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, presenter.NewSprintPresenter(sprint))
+	}
+}
+
+// DeleteSprint delete a sprint
+// @Summary Delete Sprint
+// @Description deletes a sprint and moves its tasks back to the backlog
+// @Tags Sprint
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Param   sprintId      path     string  true  "Sprint ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/sprints/{sprintId} [delete]
+// @Security ApiKeyAuth
+func DeleteSprint(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, sprintID, err := boardAndSprintParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = sprintService.DeleteSprint(c.Context(), userID, boardID, sprintID); err != nil {
+			log.ErrorLog.Printf("Error deleting sprint: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Sprint deleted successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
+
+// AssignTasksToSprint assign tasks to a sprint
+// @Summary Assign Tasks To Sprint
+// @Description assigns board tasks to a sprint
+// @Tags Sprint
+// @Accept json
+// @Produce json
+// @Param   body      body     AssignSprintTasksRequest  true  "Task IDs"
+// @Param   id      path     string  true  "Board ID"
+// @Param   sprintId      path     string  true  "Sprint ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/sprints/{sprintId}/tasks [post]
+// @Security ApiKeyAuth
+func AssignTasksToSprint(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, sprintID, err := boardAndSprintParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		validate := validation.NewValidator()
+		var input AssignSprintTasksRequest
+
+		if err = c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing sprint tasks request body: %v\n", err)
+			return SendError(c, fiber.NewError(fiber.StatusBadRequest, "Error parsing request body"))
+		}
+
+		if err = validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating sprint tasks request body: %v\n", err)
+			return SendError(c, fiber.NewError(fiber.StatusBadRequest, "Error validating request body"))
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = sprintService.AssignTasks(c.Context(), userID, boardID, sprintID, input.TaskIDs); err != nil {
+			log.ErrorLog.Printf("Error assigning tasks to sprint: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Tasks assigned to sprint successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
+
+// RemoveTaskFromSprint remove a task from a sprint
+// @Summary Remove Task From Sprint
+// @Description moves a task from a sprint back to the backlog
+// @Tags Sprint
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Param   sprintId      path     string  true  "Sprint ID"
+// @Param   taskId      path     string  true  "Task ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/sprints/{sprintId}/tasks/{taskId} [delete]
+// @Security ApiKeyAuth
+func RemoveTaskFromSprint(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, sprintID, err := boardAndSprintParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		taskID, errParam := c.ParamsInt("taskId")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing task id: %v\n", errParam)
+			return SendError(c, ErrInvalidTaskIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = sprintService.RemoveTask(c.Context(), userID, boardID, sprintID, uint(taskID)); err != nil {
+			log.ErrorLog.Printf("Error removing task from sprint: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Task removed from sprint successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
+
+// StartSprint start a sprint
+// @Summary Start Sprint
+// @Description starts a planned sprint, a board can only have one active sprint
+// @Tags Sprint
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Param   sprintId      path     string  true  "Sprint ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/sprints/{sprintId}/start [post]
+// @Security ApiKeyAuth
+func StartSprint(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, sprintID, err := boardAndSprintParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		sprint, err := sprintService.StartSprint(c.Context(), userID, boardID, sprintID)
+		if err != nil {
+			log.ErrorLog.Printf("Error starting sprint: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Sprint started successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, presenter.NewSprintPresenter(sprint))
+	}
+}
+
+// CloseSprint close a sprint
+// @Summary Close Sprint
+// @Description closes an active sprint, unfinished tasks are carried over to another sprint or to the backlog
+// @Tags Sprint
+// @Accept json
+// @Produce json
+// @Param   body      body     CloseSprintRequest  false  "Carry over target"
+// @Param   id      path     string  true  "Board ID"
+// @Param   sprintId      path     string  true  "Sprint ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/sprints/{sprintId}/close [post]
+// @Security ApiKeyAuth
+func CloseSprint(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, sprintID, err := boardAndSprintParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		validate := validation.NewValidator()
+		var input CloseSprintRequest
+
+		if len(c.Body()) > 0 {
+			if err = c.BodyParser(&input); err != nil {
+				log.ErrorLog.Printf("Error parsing sprint close request body: %v\n", err)
+				return SendError(c, fiber.NewError(fiber.StatusBadRequest, "Error parsing request body"))
+			}
+		}
+
+		if err = validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating sprint close request body: %v\n", err)
+			return SendError(c, fiber.NewError(fiber.StatusBadRequest, "Error validating request body"))
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		sprint, err := sprintService.CloseSprint(c.Context(), userID, boardID, sprintID, input.CarryOverSprintID)
+		if err != nil {
+			log.ErrorLog.Printf("Error closing sprint: %v\n", err)
+			return SendError(c, err)
+		}
+		msg := "Sprint closed successfully"
+		log.InfoLog.Println(msg)
+
+		return SendSuccessResponse(c, msg, presenter.NewSprintPresenter(sprint))
+	}
+}
+
+// GetSprintBurndown get burndown of a sprint
+// @Summary Get Sprint Burndown
+// @Description gets daily remaining story points of a sprint, computed from task column moves
+// @Tags Sprint
+// @Produce json
+// @Param   id      path     string  true  "Board ID"
+// @Param   sprintId      path     string  true  "Sprint ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/sprints/{sprintId}/burndown [get]
+// @Security ApiKeyAuth
+func GetSprintBurndown(sprintService *services.SprintService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, sprintID, err := boardAndSprintParams(c)
+		if err != nil {
+			return SendError(c, err)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		points, err := sprintService.GetBurndown(c.Context(), userID, boardID, sprintID)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting sprint burndown: %v\n", err)
+			return SendError(c, err)
+		}
+
+		data := make([]presenter.BurndownPointPresenter, len(points))
+		for i, point := range points {
+			data[i] = presenter.NewBurndownPointPresenter(point)
+		}
+
+		return SendSuccessResponse(c, "Sprint burndown loaded successfully", data)
+	}
+}
+
+func boardAndSprintParams(c *fiber.Ctx) (uint, uint, error) {
+	boardID, err := c.ParamsInt("id")
+	if err != nil {
+		log.ErrorLog.Printf("Error parsing board id: %v\n", err)
+		return 0, 0, ErrInvalidBoardIDParam
+	}
+
+	sprintID, err := c.ParamsInt("sprintId")
+	if err != nil {
+		log.ErrorLog.Printf("Error parsing sprint id: %v\n", err)
+		return 0, 0, ErrInvalidSprintIDParam
+	}
+
+	return uint(boardID), uint(sprintID), nil
+}
+
+func parseSprintRequest(c *fiber.Ctx) (*domains.Sprint, error) {
+	validate := validation.NewValidator()
+	var input SprintRequest
+
+	if err := c.BodyParser(&input); err != nil {
+		log.ErrorLog.Printf("Error parsing sprint request body: %v\n", err)
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Error parsing request body")
+	}
+
+	if err := validate.Struct(input); err != nil {
+		log.ErrorLog.Printf("Error validating sprint request body: %v\n", err)
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Error validating request body")
+	}
+
+	startDate, err := time.Parse(time.DateOnly, input.StartDate)
+	if err != nil {
+		log.ErrorLog.Printf("Error invalid date: %v\n", err)
+		return nil, ErrInvalidStartDateLayout
+	}
+
+	endDate, err := time.Parse(time.DateOnly, input.EndDate)
+	if err != nil {
+		log.ErrorLog.Printf("Error invalid date: %v\n", err)
+		return nil, ErrInvalidEndDateLayout
+	}
+
+	return &domains.Sprint{
+		Name:      input.Name,
+		Goal:      input.Goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetSSOProviders lists the identity providers
+// @Summary SSO providers
+// @Description lists the names of the configured OpenID Connect providers
+// @Tags Authentication
+// @Produce json
+// @Success 200
+// @Router /auth/oidc/providers [get]
+func GetSSOProviders(ssoService *services.SSOService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return SendSuccessResponse(c, "Identity providers fetched successfully", ssoService.Providers())
+	}
+}
+
+// SSOLogin starts a login with an identity provider
+// @Summary SSO login
+// @Description redirects to the login page of the OpenID Connect provider (authorization code flow with PKCE)
+// @Tags Authentication
+// @Param   provider  path  string  true  "Provider name"
+// @Success 302
+// @Failure 404
+// @Failure 502
+// @Router /auth/oidc/{provider}/login [get]
+func SSOLogin(ssoService *services.SSOService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		authURL, err := ssoService.AuthURL(c.UserContext(), c.Params("provider"))
+		if err != nil {
+			log.ErrorLog.Printf("Error starting SSO login: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return c.Redirect(authURL, fiber.StatusFound)
+	}
+}
+
+// SSOCallback finishes a login with an identity provider
+// @Summary SSO callback
+// @Description the provider redirects here after the login; users are matched by provider account or verified email and created when new. Returns the tokens, or an MFA challenge like /login.
+// @Tags Authentication
+// @Produce json
+// @Param   provider  path   string  true  "Provider name"
+// @Param   code      query  string  true  "Authorization code"
+// @Param   state     query  string  true  "Login state"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /auth/oidc/{provider}/callback [get]
+func SSOCallback(ssoService *services.SSOService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if providerErr := c.Query("error"); providerErr != "" {
+			log.ErrorLog.Printf("Error returned by identity provider: %s %s\n", providerErr, c.Query("error_description"))
+			return SendError(c, services.ErrSSOLoginFailed)
+		}
+
+		result, err := ssoService.Callback(c.UserContext(), c.Params("provider"), c.Query("state"), c.Query("code"))
+		if err != nil {
+			log.ErrorLog.Printf("Error finishing SSO login: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendLoginResult(c, result)
+	}
+}
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"errors"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,6 +13,8 @@ import (
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -180,6 +184,16 @@ func GetTaskByID(taskService *services.TaskService) fiber.Handler {
 // @Tags Task
 // @Produce json
 // @Param   boardID  path     string  true  "Board ID"
+// @Param   column_id  query     string  false  "Comma separated column IDs"  example(1,2)
+// @Param   assignee_id  query     string  false  "Comma separated assignee IDs"  example(3)
+// @Param   unassigned  query     bool  false  "Include tasks without assignee"
+// @Param   sprint_id  query     int  false  "Sprint ID"
+// @Param   backlog  query     bool  false  "Only tasks without sprint"
+// @Param   search  query     string  false  "Search in name and description"
+// @Param   due_from  query     string  false  "Due date lower bound"  example(2024-06-01)
+// @Param   due_to  query     string  false  "Due date upper bound"  example(2024-06-30)
+// @Param   sort  query     string  false  "Sort field, prefixed with - for descending"  example(-created_at)
+// @Param   group_by  query     string  false  "Group by column, assignee or sprint"
 // @Success 200 {array} Response
 // @Failure 400
 // @Failure 404
@@ -201,10 +215,16 @@ func GetTasksByBoardID(taskService *services.TaskService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusUnauthorized, Message: "Invalid token"})
 		}
 
+		filter, err := parseTaskFilterQuery(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing task filter: %v\n", err)
+			return SendError(c, err)
+		}
+
 		// init variables for pagination
 		page, pageSize := PageAndPageSize(c)
 
-		tasks, total, err := taskService.GetTasksByBoardID(c.Context(), userID, uint(boardID), uint(page), uint(pageSize))
+		tasks, total, err := taskService.GetTasksByBoardID(c.Context(), userID, uint(boardID), filter, uint(page), uint(pageSize))
 		if err != nil {
 			log.ErrorLog.Printf("Error gettings tasks: %v\n", err)
 			return SendError(c, err)
@@ -358,6 +378,90 @@ func DeleteTask(taskService *services.TaskService) fiber.Handler {
 	}
 }
 
+// ArchiveTask archive a task
+// @Summary Archive Task
+// @Description archives a task, archived tasks are hidden from task listings
+// @Tags Task
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Task ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{boardID}/tasks/{id}/archive [post]
+// @Security ApiKeyAuth
+func ArchiveTask(taskService *services.TaskService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing task id: %v\n", errParam)
+			return SendError(c, ErrInvalidTaskIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := taskService.ArchiveTask(c.Context(), userID, uint(boardID), uint(id)); err != nil {
+			log.ErrorLog.Printf("Error archiving task: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Task archived successfully", id)
+	}
+}
+
+// UnarchiveTask unarchive a task
+// @Summary Unarchive Task
+// @Description brings an archived task back to task listings
+// @Tags Task
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Task ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{boardID}/tasks/{id}/unarchive [post]
+// @Security ApiKeyAuth
+func UnarchiveTask(taskService *services.TaskService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing task id: %v\n", errParam)
+			return SendError(c, ErrInvalidTaskIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := taskService.UnarchiveTask(c.Context(), userID, uint(boardID), uint(id)); err != nil {
+			log.ErrorLog.Printf("Error unarchiving task: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Task unarchived successfully", id)
+	}
+}
+
 // GetTaskChildren get a list of task children
 // @Summary Get TaskChildren
 // @Description get list of a task children
@@ -487,10 +591,12 @@ func ChangeTaskColumn(taskService *services.TaskService) fiber.Handler {
 // @Description adds a dependency between two tasks
 // @Tags Task
 // @Accept  json
+// @Param   boardID  path   uint  true  "Board ID"
 // @Param   taskID  path   uint  true  "Task ID"
 // @Param   dependentTaskID  path   uint  true  "Dependent Task ID"
 // @Success 200
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /boards/{boardID}/tasks/{taskID}/dependencies/{dependentTaskID} [post]
 // @Security ApiKeyAuth
@@ -509,8 +615,21 @@ func AddTaskDependency(taskService *services.TaskService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing dependentTaskID"})
 		}
 
+		boardID, err := c.ParamsInt("boardID")
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", err)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		//Get User ID
+		userID, errUserID := utils.GetUserID(c)
+		if errUserID != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", errUserID)
+			return SendError(c, &fiber.Error{Code: fiber.StatusUnauthorized, Message: "Invalid token"})
+		}
+
 		// Add task dependency
-		err = taskService.AddTaskDependency(c.Context(), uint(taskID), uint(dependentTaskID))
+		err = taskService.AddTaskDependency(c.Context(), userID, uint(boardID), uint(taskID), uint(dependentTaskID))
 		if err != nil {
 			log.ErrorLog.Printf("Error adding task dependency: %v\n", err)
 			return SendError(c, err)
@@ -526,10 +645,12 @@ func AddTaskDependency(taskService *services.TaskService) fiber.Handler {
 // @Description removes a dependency between two tasks
 // @Tags Task
 // @Accept  json
+// @Param   boardID  path   uint  true  "Board ID"
 // @Param   taskID  path   uint  true  "Task ID"
 // @Param   dependentTaskID  path   uint  true  "Dependent Task ID"
 // @Success 204
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /boards/{boardID}/tasks/{taskID}/dependencies/{dependentTaskID} [delete]
 // @Security ApiKeyAuth
@@ -548,8 +669,21 @@ func RemoveTaskDependency(taskService *services.TaskService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing dependentTaskID"})
 		}
 
+		boardID, err := c.ParamsInt("boardID")
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", err)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		//Get User ID
+		userID, errUserID := utils.GetUserID(c)
+		if errUserID != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", errUserID)
+			return SendError(c, &fiber.Error{Code: fiber.StatusUnauthorized, Message: "Invalid token"})
+		}
+
 		// Remove task dependency
-		err = taskService.RemoveTaskDependency(c.Context(), uint(taskID), uint(dependentTaskID))
+		err = taskService.RemoveTaskDependency(c.Context(), userID, uint(boardID), uint(taskID), uint(dependentTaskID))
 		if err != nil {
 			log.ErrorLog.Printf("Error removing task dependency: %v\n", err)
 			return SendError(c, err)
@@ -565,9 +699,11 @@ func RemoveTaskDependency(taskService *services.TaskService) fiber.Handler {
 // @Description retrieves the dependencies for a given task
 // @Tags Task
 // @Accept  json
+// @Param   boardID  path   uint  true  "Board ID"
 // @Param   taskID  path   uint  true  "Task ID"
 // @Success 200 {array} domains.TaskDependency
 // @Failure 400
+// @Failure 403
 // @Failure 500
 // @Router /boards/{boardID}/tasks/{taskID}/dependencies [get]
 // @Security ApiKeyAuth
@@ -580,8 +716,21 @@ func GetTaskDependencies(taskService *services.TaskService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing taskID"})
 		}
 
+		boardID, err := c.ParamsInt("boardID")
+		if err != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", err)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		//Get User ID
+		userID, errUserID := utils.GetUserID(c)
+		if errUserID != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", errUserID)
+			return SendError(c, &fiber.Error{Code: fiber.StatusUnauthorized, Message: "Invalid token"})
+		}
+
 		// Get task dependencies
-		taskDependencies, err := taskService.GetTaskDependencies(c.Context(), uint(taskID))
+		taskDependencies, err := taskService.GetTaskDependencies(c.Context(), userID, uint(boardID), uint(taskID))
 		if err != nil {
 			log.ErrorLog.Printf("Error retrieving task dependencies: %v\n", err)
 			return SendError(c, err)
@@ -888,3 +1037,195 @@ func AssignTask(taskService *services.TaskService) fiber.Handler {
 		)
 	}
 }
+
+// parseTaskFilterQuery builds a task filter from the query string of a task listing.
+func parseTaskFilterQuery(c *fiber.Ctx) (domains.TaskFilter, error) {
+	columnIDs, err := parseIDList(c.Query("column_id"))
+	if err != nil {
+		return domains.TaskFilter{}, fiber.NewError(fiber.StatusBadRequest, "invalid column_id filter")
+	}
+
+	assigneeIDs, err := parseIDList(c.Query("assignee_id"))
+	if err != nil {
+		return domains.TaskFilter{}, fiber.NewError(fiber.StatusBadRequest, "invalid assignee_id filter")
+	}
+
+	var sprintID *uint
+	if c.Query("sprint_id") != "" {
+		id, errSprint := strconv.ParseUint(c.Query("sprint_id"), 10, 0)
+		if errSprint != nil {
+			return domains.TaskFilter{}, fiber.NewError(fiber.StatusBadRequest, "invalid sprint_id filter")
+		}
+		value := uint(id)
+		sprintID = &value
+	}
+
+	dueFrom, err := parseDateQuery(c, "due_from", fiber.NewError(fiber.StatusBadRequest, "invalid due_from format, example: "+time.DateOnly))
+	if err != nil {
+		return domains.TaskFilter{}, err
+	}
+
+	dueTo, err := parseDateQuery(c, "due_to", fiber.NewError(fiber.StatusBadRequest, "invalid due_to format, example: "+time.DateOnly))
+	if err != nil {
+		return domains.TaskFilter{}, err
+	}
+
+	sort, err := parseTaskSort(c.Query("sort"), c.Query("group_by"))
+	if err != nil {
+		return domains.TaskFilter{}, err
+	}
+
+	return domains.TaskFilter{
+		ColumnIDs:   columnIDs,
+		AssigneeIDs: assigneeIDs,
+		Unassigned:  c.QueryBool("unassigned"),
+		SprintID:    sprintID,
+		Backlog:     c.QueryBool("backlog"),
+		Search:      c.Query("search"),
+		DueFrom:     timeOrNil(dueFrom),
+		DueTo:       timeOrNil(dueTo),
+		Sort:        sort,
+	}, nil
+}
+
+// parseTaskSort parses a sort like "-created_at", the minus sign meaning descending.
+func parseTaskSort(sort string, groupBy string) (domains.TaskSort, error) {
+	result := domains.TaskSort{GroupBy: domains.TaskGroupBy(groupBy)}
+	if !result.GroupBy.IsValid() {
+		return domains.TaskSort{}, fiber.NewError(fiber.StatusBadRequest, "invalid group_by, allowed: column, assignee, sprint")
+	}
+
+	if sort == "" {
+		return result, nil
+	}
+
+	if strings.HasPrefix(sort, "-") {
+		result.Desc = true
+		sort = strings.TrimPrefix(sort, "-")
+	}
+
+	result.Field = domains.TaskSortField(sort)
+	if !result.Field.IsValid() {
+		return domains.TaskSort{}, fiber.NewError(fiber.StatusBadRequest, "invalid sort field")
+	}
+
+	return result, nil
+}
+
+func formatTaskSort(sort domains.TaskSort) string {
+	if sort.Field == "" {
+		return ""
+	}
+	if sort.Desc {
+		return "-" + string(sort.Field)
+	}
+	return string(sort.Field)
+}
+
+func parseIDList(value string) ([]uint, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	ids := make([]uint, 0, len(parts))
+	for _, part := range parts {
+		id, err := strconv.ParseUint(strings.TrimSpace(part), 10, 0)
+		if err != nil || id == 0 {
+			return nil, fmt.Errorf("invalid id %q", part)
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids, nil
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+type BulkTaskRequest struct {
+	TaskIDs    []uint `json:"task_ids" validate:"required,min=1,max=200,dive,gte=1" example:"1,2,3"`
+	Operation  string `json:"operation" validate:"required,oneof=move assign set_story_points add_label delete archive" example:"move"`
+	ColumnID   uint   `json:"column_id,omitempty" example:"2"`
+	AssigneeID uint   `json:"assignee_id,omitempty" example:"4"`
+	StoryPoint int    `json:"story_point,omitempty" validate:"gte=0" example:"3"`
+	Label      string `json:"label,omitempty" validate:"max=50" example:"bug"`
+	Mode       string `json:"mode,omitempty" validate:"omitempty,oneof=atomic best_effort" example:"atomic"`
+}
+
+// BulkTaskOperation applies an operation to many tasks
+// @Summary Bulk Task Operation
+// @Description moves, assigns, sets story points, labels, deletes or archives many tasks in one transaction. In atomic mode (default) any failure rolls back every task, in best_effort mode only the failed tasks are skipped.
+// @Tags Task
+// @Accept  json
+// @Produce json
+// @Param   body  body      BulkTaskRequest  true  "Bulk operation"
+// @Param 	boardID	path	string	true "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 422 {object} Response
+// @Failure 500
+// @Router /boards/{boardID}/tasks/bulk [post]
+// @Security ApiKeyAuth
+func BulkTaskOperation(taskService *services.TaskService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		validate := validation.NewValidator()
+		var input BulkTaskRequest
+
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing bulk task request body: %v\n", err)
+			return SendError(c, fiber.NewError(fiber.StatusBadRequest, "Error parsing request body"))
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating bulk task request body: %v\n", err)
+			return SendError(c, fiber.NewError(fiber.StatusBadRequest, "Error validating request body"))
+		}
+
+		userID, errUserID := utils.GetUserID(c)
+		if errUserID != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", errUserID)
+			return SendError(c, &fiber.Error{Code: fiber.StatusUnauthorized, Message: "Invalid token"})
+		}
+
+		boardID, errBoardID := c.ParamsInt("boardID")
+		if errBoardID != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errBoardID)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		operation := domains.BulkTaskOperation{
+			Type:       domains.BulkTaskOperationType(input.Operation),
+			TaskIDs:    input.TaskIDs,
+			ColumnID:   input.ColumnID,
+			AssigneeID: input.AssigneeID,
+			StoryPoint: input.StoryPoint,
+			Label:      input.Label,
+			Atomic:     input.Mode != "best_effort",
+		}
+
+		// user context carries the request transaction
+		results, err := taskService.BulkTaskOperation(c.UserContext(), userID, uint(boardID), operation)
+		if errors.Is(err, services.ErrBulkTaskAtomicFailed) {
+			log.ErrorLog.Printf("Error applying bulk task operation: %v\n", err)
+			c.Locals(valuecontext.IsTxError, err)
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(Response{
+				Success: false,
+				Status:  fiber.StatusUnprocessableEntity,
+				Data:    presenter.NewBulkTaskResponsePresenter(results),
+				Message: services.ErrBulkTaskAtomicFailed.Message,
+			})
+		}
+		if err != nil {
+			log.ErrorLog.Printf("Error applying bulk task operation: %v\n", err)
+			return SendError(c, err)
+		}
+		log.InfoLog.Println("Bulk task operation applied")
+
+		return SendSuccessResponse(c, "Bulk operation applied.", presenter.NewBulkTaskResponsePresenter(results))
+	}
+}
@@ -0,0 +1,646 @@
+package handlers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidTeamIDParam = fiber.NewError(fiber.StatusBadRequest, "invalid team id")
+)
+
+type TeamRequest struct {
+	Name string `json:"name" validate:"required,min=2,max=100" example:"Backend"`
+}
+
+type AddTeamMemberRequest struct {
+	UserID uint `json:"user_id" validate:"required" example:"2"`
+}
+
+type NotifyTeamRequest struct {
+	Message string `json:"message" validate:"required,max=500" example:"Release freeze starts tomorrow"`
+}
+
+type BoardTeamRequest struct {
+	TeamID   uint   `json:"team_id" validate:"required" example:"1"`
+	RoleName string `json:"role_name" validate:"required" example:"Editor"`
+}
+
+type ChangeBoardTeamRoleRequest struct {
+	RoleName string `json:"role_name" validate:"required" example:"Maintainer"`
+}
+
+// CreateTeam creates a team in a workspace
+// @Summary Create team
+// @Description creates a team in the workspace, only its admins and owners can
+// @Tags Teams
+// @Accept  json
+// @Produce json
+// @Param   id    path      string       true  "Workspace ID"
+// @Param   body  body      TeamRequest  true  "Team"
+// @Success 200 {object} presenter.TeamPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /workspaces/{id}/teams [post]
+// @Security ApiKeyAuth
+func CreateTeam(teamService *services.TeamService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		workspaceID, errParam := c.ParamsInt("id")
+		if errParam != nil || workspaceID <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		var input TeamRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing team request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing team request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating team request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		team := domains.Team{WorkspaceID: uint(workspaceID), Name: input.Name, CreatedBy: userID}
+		if err = teamService.CreateTeam(c.UserContext(), &team); err != nil {
+			log.ErrorLog.Printf("Error creating team: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team created successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewTeamPresenter(team))
+	}
+}
+
+// GetWorkspaceTeams lists the teams of a workspace
+// @Summary Get workspace teams
+// @Description lists the teams of the workspace, guests can not see them
+// @Tags Teams
+// @Produce json
+// @Param   id  path  string  true  "Workspace ID"
+// @Success 200 {array} presenter.TeamPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /workspaces/{id}/teams [get]
+// @Security ApiKeyAuth
+func GetWorkspaceTeams(teamService *services.TeamService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		workspaceID, errParam := c.ParamsInt("id")
+		if errParam != nil || workspaceID <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		teams, err := teamService.GetWorkspaceTeams(c.UserContext(), userID, uint(workspaceID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting teams: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Teams loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewTeamPresenters(teams))
+	}
+}
+
+// GetTeam gets a team
+// @Summary Get team
+// @Description gets a team of a workspace of the user
+// @Tags Teams
+// @Produce json
+// @Param   id  path  string  true  "Team ID"
+// @Success 200 {object} presenter.TeamPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /teams/{id} [get]
+// @Security ApiKeyAuth
+func GetTeam(teamService *services.TeamService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		team, err := teamService.GetTeam(c.UserContext(), userID, uint(id))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting team: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewTeamPresenter(*team))
+	}
+}
+
+// UpdateTeam renames a team
+// @Summary Update team
+// @Description renames a team, only admins and owners of its workspace can
+// @Tags Teams
+// @Accept  json
+// @Produce json
+// @Param   id    path      string       true  "Team ID"
+// @Param   body  body      TeamRequest  true  "Team"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /teams/{id} [put]
+// @Security ApiKeyAuth
+func UpdateTeam(teamService *services.TeamService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		var input TeamRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing team request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing team request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating team request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		team := domains.Team{ID: uint(id), Name: input.Name}
+		if err = teamService.UpdateTeam(c.UserContext(), userID, &team); err != nil {
+			log.ErrorLog.Printf("Error updating team: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team updated successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, id)
+	}
+}
+
+// DeleteTeam deletes a team
+// @Summary Delete team
+// @Description deletes a team, its members lose the board roles of the team
+// @Tags Teams
+// @Produce json
+// @Param   id  path  string  true  "Team ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /teams/{id} [delete]
+// @Security ApiKeyAuth
+func DeleteTeam(teamService *services.TeamService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = teamService.DeleteTeam(c.UserContext(), userID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error deleting team: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team deleted successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, id)
+	}
+}
+
+// GetTeamMembers lists the members of a team
+// @Summary Get team members
+// @Description lists the members of a team
+// @Tags Teams
+// @Produce json
+// @Param   id  path  string  true  "Team ID"
+// @Success 200 {array} presenter.TeamMemberPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /teams/{id}/members [get]
+// @Security ApiKeyAuth
+func GetTeamMembers(teamService *services.TeamService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		members, err := teamService.GetTeamMembers(c.UserContext(), userID, uint(id))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting team members: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team members loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewTeamMemberPresenters(members))
+	}
+}
+
+// AddTeamMember adds a workspace member to a team
+// @Summary Add team member
+// @Description adds a member of the workspace to the team, only admins and owners of the workspace can
+// @Tags Teams
+// @Accept  json
+// @Produce json
+// @Param   id    path      string                true  "Team ID"
+// @Param   body  body      AddTeamMemberRequest  true  "Member"
+// @Success 200 {object} presenter.TeamMemberPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /teams/{id}/members [post]
+// @Security ApiKeyAuth
+func AddTeamMember(teamService *services.TeamService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		var input AddTeamMemberRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing team member request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing team member request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating team member request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		member, err := teamService.AddTeamMember(c.UserContext(), actorID, uint(id), input.UserID)
+		if err != nil {
+			log.ErrorLog.Printf("Error adding team member: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team member added successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewTeamMemberPresenter(*member))
+	}
+}
+
+// RemoveTeamMember removes a member from a team
+// @Summary Remove team member
+// @Description removes a member from the team, who loses the board roles of the team. Members can leave by removing themselves.
+// @Tags Teams
+// @Produce json
+// @Param   id      path  string  true  "Team ID"
+// @Param   userID  path  string  true  "User ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /teams/{id}/members/{userID} [delete]
+// @Security ApiKeyAuth
+func RemoveTeamMember(teamService *services.TeamService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		userID, errParam := c.ParamsInt("userID")
+		if errParam != nil || userID <= 0 {
+			log.ErrorLog.Printf("Error parsing user id: %v\n", errParam)
+			return SendError(c, ErrInvalidUserIDParam)
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = teamService.RemoveTeamMember(c.UserContext(), actorID, uint(id), uint(userID)); err != nil {
+			log.ErrorLog.Printf("Error removing team member: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team member removed successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, userID)
+	}
+}
+
+// NotifyTeam notifies every member of a team
+// @Summary Notify team
+// @Description sends an in-app notification to every member of the team
+// @Tags Teams
+// @Accept  json
+// @Produce json
+// @Param   id    path      string             true  "Team ID"
+// @Param   body  body      NotifyTeamRequest  true  "Notification"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /teams/{id}/notifications [post]
+// @Security ApiKeyAuth
+func NotifyTeam(teamService *services.TeamService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		var input NotifyTeamRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing team notification request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing team notification request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating team notification request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = teamService.NotifyTeam(c.UserContext(), userID, uint(id), input.Message); err != nil {
+			log.ErrorLog.Printf("Error notifying team: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team notified successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, id)
+	}
+}
+
+// GetBoardTeams lists the teams of a board
+// @Summary Get board teams
+// @Description lists the teams holding a role on the board
+// @Tags Teams
+// @Produce json
+// @Param   id  path  string  true  "Board ID"
+// @Success 200 {array} presenter.BoardTeamPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{id}/teams [get]
+// @Security ApiKeyAuth
+func GetBoardTeams(teamService *services.TeamService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil || boardID <= 0 {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		boardTeams, err := teamService.GetBoardTeams(c.UserContext(), userID, uint(boardID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting board teams: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Board teams loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewBoardTeamPresenters(boardTeams))
+	}
+}
+
+// AddTeamToBoard grants a team a role on a board
+// @Summary Add team to board
+// @Description gives every member of a team of the workspace of the board the role, on top of their own board role. Members need every permission of the role.
+// @Tags Teams
+// @Accept  json
+// @Produce json
+// @Param   id    path      string            true  "Board ID"
+// @Param   body  body      BoardTeamRequest  true  "Team and role"
+// @Success 200 {object} presenter.BoardTeamPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/teams [post]
+// @Security ApiKeyAuth
+func AddTeamToBoard(teamService *services.TeamService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil || boardID <= 0 {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		var input BoardTeamRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing board team request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing board team request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating board team request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		boardTeam, err := teamService.GrantBoardRole(c.UserContext(), actorID, uint(boardID), input.TeamID, input.RoleName)
+		if err != nil {
+			log.ErrorLog.Printf("Error adding team to board: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team added to board successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewBoardTeamPresenter(*boardTeam))
+	}
+}
+
+// ChangeBoardTeamRole changes the role of a team on a board
+// @Summary Change board team role
+// @Description changes the role of a team on the board, members need every permission of both roles
+// @Tags Teams
+// @Accept  json
+// @Produce json
+// @Param   id      path      string                      true  "Board ID"
+// @Param   teamID  path      string                      true  "Team ID"
+// @Param   body    body      ChangeBoardTeamRoleRequest  true  "Role"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/teams/{teamID} [put]
+// @Security ApiKeyAuth
+func ChangeBoardTeamRole(teamService *services.TeamService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil || boardID <= 0 {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		teamID, errParam := c.ParamsInt("teamID")
+		if errParam != nil || teamID <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		var input ChangeBoardTeamRoleRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing board team request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing board team request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating board team request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = teamService.ChangeBoardTeamRole(c.UserContext(), actorID, uint(boardID), uint(teamID), input.RoleName); err != nil {
+			log.ErrorLog.Printf("Error changing board team role: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Board team role changed successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, teamID)
+	}
+}
+
+// RemoveTeamFromBoard takes the role of a team on a board away
+// @Summary Remove team from board
+// @Description takes the role of the team on the board away, its members keep their own board roles
+// @Tags Teams
+// @Produce json
+// @Param   id      path  string  true  "Board ID"
+// @Param   teamID  path  string  true  "Team ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{id}/teams/{teamID} [delete]
+// @Security ApiKeyAuth
+func RemoveTeamFromBoard(teamService *services.TeamService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("id")
+		if errParam != nil || boardID <= 0 {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		teamID, errParam := c.ParamsInt("teamID")
+		if errParam != nil || teamID <= 0 {
+			log.ErrorLog.Printf("Error parsing team id: %v\n", errParam)
+			return SendError(c, ErrInvalidTeamIDParam)
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = teamService.RevokeBoardRole(c.UserContext(), actorID, uint(boardID), uint(teamID)); err != nil {
+			log.ErrorLog.Printf("Error removing team from board: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Team removed from board successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, teamID)
+	}
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidColumnIDParam = fiber.NewError(fiber.StatusBadRequest, "invalid column id")
+)
+
+// GetBoardTrash get deleted items of a board
+// @Summary Get Board Trash
+// @Description lists soft-deleted tasks and columns of a board with who deleted them, newest first
+// @Tags Trash
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /boards/{boardID}/trash [get]
+// @Security ApiKeyAuth
+func GetBoardTrash(trashService *services.TrashService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		items, err := trashService.GetBoardTrash(c.Context(), userID, uint(boardID))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting board trash: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Board trash loaded successfully", presenter.NewTrashItemsPresenter(items))
+	}
+}
+
+// RestoreTask restore a deleted task
+// @Summary Restore Task
+// @Description restores a deleted task; its column must not be deleted, a deleted parent is dropped and a taken position moves the task to the end of the column
+// @Tags Trash
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Task ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{boardID}/trash/tasks/{id}/restore [post]
+// @Security ApiKeyAuth
+func RestoreTask(trashService *services.TrashService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing task id: %v\n", errParam)
+			return SendError(c, ErrInvalidTaskIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := trashService.RestoreTask(c.Context(), userID, uint(boardID), uint(id)); err != nil {
+			log.ErrorLog.Printf("Error restoring task: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Task restored successfully", id)
+	}
+}
+
+// RestoreColumn restore a deleted column
+// @Summary Restore Column
+// @Description restores a deleted column; fails on a name clash, a taken position moves the column to the end and the final flag is dropped when the board has another final column
+// @Tags Trash
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Param   id      path     string  true  "Column ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{boardID}/trash/columns/{id}/restore [post]
+// @Security ApiKeyAuth
+func RestoreColumn(trashService *services.TrashService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing column id: %v\n", errParam)
+			return SendError(c, ErrInvalidColumnIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := trashService.RestoreColumn(c.Context(), userID, uint(boardID), uint(id)); err != nil {
+			log.ErrorLog.Printf("Error restoring column: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Column restored successfully", id)
+	}
+}
+
+// RestoreBoard restore a deleted board
+// @Summary Restore Board
+// @Description restores a deleted board with the columns, tasks, comments and memberships deleted along with it, only the owner who deleted it can restore it
+// @Tags Trash
+// @Produce json
+// @Param   boardID      path     string  true  "Board ID"
+// @Success 200 {object} Response
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /boards/{boardID}/restore [post]
+// @Security ApiKeyAuth
+func RestoreBoard(trashService *services.TrashService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err := trashService.RestoreBoard(c.Context(), userID, uint(boardID)); err != nil {
+			log.ErrorLog.Printf("Error restoring board: %v\n", err)
+			return SendError(c, err)
+		}
+
+		return SendSuccessResponse(c, "Board restored successfully", boardID)
+	}
+}
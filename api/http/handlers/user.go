@@ -2,10 +2,13 @@ package handlers
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/jwt"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
 	"github.com/gofiber/fiber/v2"
@@ -18,12 +21,12 @@ var (
 type SignUpInput struct {
 	Email    string `json:"email" validate:"required,email,excludesall=;" example:"test@example.com"`
 	Name     string `json:"name" validate:"required,min=3,max=20,excludesall=;" example:"test"`
-	Password string `json:"password" validate:"required,min=8,excludesall=;,password" example:"1234Test@"`
+	Password string `json:"password" validate:"required,excludesall=;" example:"1234Test@"`
 }
 
 // SignUpUser handles the registration of a new user
 // @Summary User registration
-// @Description Register a user with email, name and password
+// @Description Register a user with email, name and password and send an email verification link
 // @Tags Authentication
 // @Accept  json
 // @Produce json
@@ -32,7 +35,7 @@ type SignUpInput struct {
 // @Failure 400
 // @Failure 500
 // @Router /signup [post]
-func SignUpUser(userService *services.UserService) fiber.Handler {
+func SignUpUser(userService *services.UserService, accountService *services.AccountService) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		validate := validation.NewValidator()
 
@@ -60,21 +63,26 @@ func SignUpUser(userService *services.UserService) fiber.Handler {
 			log.ErrorLog.Printf("Error creating user: %v\n", err)
 			return SendError(c, err)
 		}
+		if err := accountService.SendVerificationEmail(c.UserContext(), &userModel); err != nil {
+			// the account exists already, the user can ask for a new link
+			log.ErrorLog.Printf("Error sending verification email: %v\n", err)
+		}
+
 		msg := "User signed up (created) successfully"
 		log.InfoLog.Println(msg)
 
-		return SendSuccessResponse(c, msg, userModel)
+		return SendSuccessResponse(c, msg, presenter.NewUserPresenter(&userModel))
 	}
 }
 
 type LoginInput struct {
 	Email    string `json:"email" validate:"required,email,excludesall=;" example:"test1@test.com"`
-	Password string `json:"password" validate:"required,min=8,excludesall=;,password" example:"1234Test@"`
+	Password string `json:"password" validate:"required" example:"1234Test@"`
 }
 
 // LoginUser handles the login of a user
 // @Summary User login
-// @Description login user with email and password
+// @Description login user with email and password. Users with two-factor authentication get an MFA challenge token to send to /login/mfa with a code instead of the tokens.
 // @Tags Authentication
 // @Accept  json
 // @Produce json
@@ -107,26 +115,35 @@ func LoginUser(authService *services.AuthService) fiber.Handler {
 			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error validating user login request body"})
 		}
 
-		authToken, err := authService.Login(c.Context(), input.Email, input.Password)
+		result, err := authService.Login(c.UserContext(), input.Email, input.Password)
 		if err != nil {
 			log.ErrorLog.Printf("Error logging in user: %v\n", err)
 			return SendError(c, err)
 		}
 
-		log.InfoLog.Println("User logged in successfully")
-		return SendUserToken(c, authToken)
+		return SendLoginResult(c, result)
 	}
 }
 
+// RefreshCreds rotates the refresh token
+// @Summary Refresh tokens
+// @Description exchanges a refresh token for a new access and refresh token pair, every refresh token can be used once and reusing one revokes all tokens rotated from the same login
+// @Tags Authentication
+// @Produce json
+// @Param   Authorization  header      string  true  "Refresh token"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Router /refresh [get]
 func RefreshCreds(authService *services.AuthService) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		refToken := c.GetReqHeaders()["Authorization"]
-		if len(refToken[0]) == 0 {
+		refToken := strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+		if len(refToken) == 0 {
 			log.ErrorLog.Printf("Error refreshing token: %v\n", ErrRefreshTokenNotProvided)
 			return SendError(c, ErrRefreshTokenNotProvided)
 		}
 
-		authToken, err := authService.RefreshAuth(c.UserContext(), refToken[0])
+		authToken, err := authService.RefreshAuth(c.UserContext(), refToken)
 		if err != nil {
 			log.ErrorLog.Printf("Error refreshing token, not authorized: %v\n", err)
 			return SendError(c, err)
@@ -136,3 +153,74 @@ func RefreshCreds(authService *services.AuthService) fiber.Handler {
 		return SendUserToken(c, authToken)
 	}
 }
+
+type LogoutInput struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Logout revokes the current session
+// @Summary User logout
+// @Description revokes the access token of the request and, when given, the refresh token issued with it
+// @Tags Authentication
+// @Accept  json
+// @Produce json
+// @Param   body  body      LogoutInput  false  "Refresh token to revoke"
+// @Success 200
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /logout [post]
+// @Security ApiKeyAuth
+func Logout(authService *services.AuthService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var input LogoutInput
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&input); err != nil {
+				log.ErrorLog.Printf("Error parsing logout request body: %v\n", err)
+				return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing logout request body"})
+			}
+		}
+
+		claims, ok := c.Locals(jwt.UserClaimKey).(*jwt.UserClaims)
+		if !ok {
+			return SendError(c, fiber.NewError(fiber.StatusUnauthorized, "Invalid user claims"))
+		}
+
+		if err := authService.Logout(c.UserContext(), claims, input.RefreshToken); err != nil {
+			log.ErrorLog.Printf("Error logging out user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "User logged out successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
+
+// LogoutAll revokes all sessions of the user
+// @Summary Logout all sessions
+// @Description revokes every access and refresh token issued to the user
+// @Tags Authentication
+// @Produce json
+// @Success 200
+// @Failure 401
+// @Failure 500
+// @Router /logout/all [post]
+// @Security ApiKeyAuth
+func LogoutAll(authService *services.AuthService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		claims, ok := c.Locals(jwt.UserClaimKey).(*jwt.UserClaims)
+		if !ok {
+			return SendError(c, fiber.NewError(fiber.StatusUnauthorized, "Invalid user claims"))
+		}
+
+		if err := authService.LogoutAll(c.UserContext(), claims); err != nil {
+			log.ErrorLog.Printf("Error logging out all sessions: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "All sessions logged out successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, nil)
+	}
+}
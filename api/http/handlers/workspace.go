@@ -0,0 +1,477 @@
+package handlers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers/presenter"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidWorkspaceIDParam = fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+)
+
+type WorkspaceRequest struct {
+	Name string `json:"name" validate:"required,min=3,max=100" example:"Acme"`
+}
+
+type AddWorkspaceMemberRequest struct {
+	Email string `json:"email" validate:"required,email" example:"colleague@example.com"`
+	Role  string `json:"role" validate:"required,oneof=owner admin member guest" example:"member"`
+}
+
+type ChangeWorkspaceMemberRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=owner admin member guest" example:"admin"`
+}
+
+// CreateWorkspace creates a workspace
+// @Summary Create workspace
+// @Description creates a workspace, the creator becomes its owner
+// @Tags Workspaces
+// @Accept  json
+// @Produce json
+// @Param   body  body      WorkspaceRequest  true  "Workspace"
+// @Success 200 {object} presenter.WorkspacePresenter
+// @Failure 400
+// @Failure 500
+// @Router /workspaces [post]
+// @Security ApiKeyAuth
+func CreateWorkspace(workspaceService *services.WorkspaceService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		var input WorkspaceRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing workspace request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing workspace request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating workspace request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		workspace := domains.Workspace{Name: input.Name, CreatedBy: userID}
+		if err = workspaceService.CreateWorkspace(c.UserContext(), &workspace); err != nil {
+			log.ErrorLog.Printf("Error creating workspace: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspace created successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewWorkspacePresenter(workspace))
+	}
+}
+
+// GetMyWorkspaces lists the workspaces of the user
+// @Summary Get my workspaces
+// @Description lists the workspaces the user is a member of
+// @Tags Workspaces
+// @Produce json
+// @Success 200 {array} presenter.WorkspacePresenter
+// @Failure 500
+// @Router /workspaces [get]
+// @Security ApiKeyAuth
+func GetMyWorkspaces(workspaceService *services.WorkspaceService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		workspaces, err := workspaceService.GetUserWorkspaces(c.UserContext(), userID)
+		if err != nil {
+			log.ErrorLog.Printf("Error getting workspaces: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspaces loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewWorkspacePresenters(workspaces))
+	}
+}
+
+// GetWorkspace gets a workspace
+// @Summary Get workspace
+// @Description gets a workspace of the user
+// @Tags Workspaces
+// @Produce json
+// @Param   id  path  string  true  "Workspace ID"
+// @Success 200 {object} presenter.WorkspacePresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /workspaces/{id} [get]
+// @Security ApiKeyAuth
+func GetWorkspace(workspaceService *services.WorkspaceService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		workspace, err := workspaceService.GetWorkspace(c.UserContext(), userID, uint(id))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting workspace: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspace loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewWorkspacePresenter(*workspace))
+	}
+}
+
+// UpdateWorkspace renames a workspace
+// @Summary Update workspace
+// @Description renames a workspace, only its admins and owners can
+// @Tags Workspaces
+// @Accept  json
+// @Produce json
+// @Param   id    path      string            true  "Workspace ID"
+// @Param   body  body      WorkspaceRequest  true  "Workspace"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /workspaces/{id} [put]
+// @Security ApiKeyAuth
+func UpdateWorkspace(workspaceService *services.WorkspaceService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		var input WorkspaceRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing workspace request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing workspace request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating workspace request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		workspace := domains.Workspace{ID: uint(id), Name: input.Name}
+		if err = workspaceService.UpdateWorkspace(c.UserContext(), userID, &workspace); err != nil {
+			log.ErrorLog.Printf("Error updating workspace: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspace updated successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, id)
+	}
+}
+
+// DeleteWorkspace deletes a workspace
+// @Summary Delete workspace
+// @Description deletes a workspace without boards, deleted boards count until they are purged, only its owners can
+// @Tags Workspaces
+// @Produce json
+// @Param   id  path  string  true  "Workspace ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /workspaces/{id} [delete]
+// @Security ApiKeyAuth
+func DeleteWorkspace(workspaceService *services.WorkspaceService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = workspaceService.DeleteWorkspace(c.UserContext(), userID, uint(id)); err != nil {
+			log.ErrorLog.Printf("Error deleting workspace: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspace deleted successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, id)
+	}
+}
+
+// GetWorkspaceMembers lists the member directory of a workspace
+// @Summary Get workspace members
+// @Description lists the members of a workspace to pick from when adding board members or assignees, guests can not see the directory
+// @Tags Workspaces
+// @Produce json
+// @Param   id      path   string  true   "Workspace ID"
+// @Param   search  query  string  false  "Search in member names and emails"
+// @Success 200 {array} presenter.WorkspaceMemberPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 500
+// @Router /workspaces/{id}/members [get]
+// @Security ApiKeyAuth
+func GetWorkspaceMembers(workspaceService *services.WorkspaceService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		members, err := workspaceService.GetMembers(c.UserContext(), userID, uint(id), c.Query("search"))
+		if err != nil {
+			log.ErrorLog.Printf("Error getting workspace members: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspace members loaded successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewWorkspaceMemberPresenters(members))
+	}
+}
+
+// AddWorkspaceMember adds a user to a workspace
+// @Summary Add workspace member
+// @Description adds a registered user to the workspace, admins add members and only owners can add owners
+// @Tags Workspaces
+// @Accept  json
+// @Produce json
+// @Param   id    path      string                     true  "Workspace ID"
+// @Param   body  body      AddWorkspaceMemberRequest  true  "Member"
+// @Success 200 {object} presenter.WorkspaceMemberPresenter
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /workspaces/{id}/members [post]
+// @Security ApiKeyAuth
+func AddWorkspaceMember(workspaceService *services.WorkspaceService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		var input AddWorkspaceMemberRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing workspace member request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing workspace member request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating workspace member request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		member, err := workspaceService.AddMember(c.UserContext(), actorID, uint(id), input.Email, domains.WorkspaceRole(input.Role))
+		if err != nil {
+			log.ErrorLog.Printf("Error adding workspace member: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspace member added successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, presenter.NewWorkspaceMemberPresenter(*member))
+	}
+}
+
+// ChangeWorkspaceMemberRole changes the role of a workspace member
+// @Summary Change workspace member role
+// @Description changes the role of a member, admins manage roles and only owners can grant or take away the owner role. The last owner can not step down.
+// @Tags Workspaces
+// @Accept  json
+// @Produce json
+// @Param   id      path      string                            true  "Workspace ID"
+// @Param   userID  path      string                            true  "User ID"
+// @Param   body    body      ChangeWorkspaceMemberRoleRequest  true  "Role"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /workspaces/{id}/members/{userID} [put]
+// @Security ApiKeyAuth
+func ChangeWorkspaceMemberRole(workspaceService *services.WorkspaceService) fiber.Handler {
+	validate := validation.NewValidator()
+
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		userID, errParam := c.ParamsInt("userID")
+		if errParam != nil || userID <= 0 {
+			log.ErrorLog.Printf("Error parsing user id: %v\n", errParam)
+			return SendError(c, ErrInvalidUserIDParam)
+		}
+
+		var input ChangeWorkspaceMemberRoleRequest
+		if err := c.BodyParser(&input); err != nil {
+			log.ErrorLog.Printf("Error parsing workspace member request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: "Error parsing workspace member request body"})
+		}
+
+		if err := validate.Struct(input); err != nil {
+			log.ErrorLog.Printf("Error validating workspace member request body: %v\n", err)
+			return SendError(c, &fiber.Error{Code: fiber.StatusBadRequest, Message: err.Error()})
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		err = workspaceService.ChangeMemberRole(c.UserContext(), actorID, uint(id), uint(userID), domains.WorkspaceRole(input.Role))
+		if err != nil {
+			log.ErrorLog.Printf("Error changing workspace member role: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspace member role changed successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, userID)
+	}
+}
+
+// RemoveWorkspaceMember removes a member from a workspace
+// @Summary Remove workspace member
+// @Description removes a member from the workspace, who loses access to its boards. Members can leave by removing themselves.
+// @Tags Workspaces
+// @Produce json
+// @Param   id      path  string  true  "Workspace ID"
+// @Param   userID  path  string  true  "User ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /workspaces/{id}/members/{userID} [delete]
+// @Security ApiKeyAuth
+func RemoveWorkspaceMember(workspaceService *services.WorkspaceService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		userID, errParam := c.ParamsInt("userID")
+		if errParam != nil || userID <= 0 {
+			log.ErrorLog.Printf("Error parsing user id: %v\n", errParam)
+			return SendError(c, ErrInvalidUserIDParam)
+		}
+
+		actorID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = workspaceService.RemoveMember(c.UserContext(), actorID, uint(id), uint(userID)); err != nil {
+			log.ErrorLog.Printf("Error removing workspace member: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Workspace member removed successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, userID)
+	}
+}
+
+// MoveBoardToWorkspace moves a board into a workspace
+// @Summary Move board to workspace
+// @Description moves a board without a workspace into the workspace, the board owner has to be a workspace member. Board members outside of the workspace join it as guests.
+// @Tags Workspaces
+// @Produce json
+// @Param   id       path  string  true  "Workspace ID"
+// @Param   boardID  path  string  true  "Board ID"
+// @Success 200
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 500
+// @Router /workspaces/{id}/boards/{boardID} [post]
+// @Security ApiKeyAuth
+func MoveBoardToWorkspace(workspaceService *services.WorkspaceService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, errParam := c.ParamsInt("id")
+		if errParam != nil || id <= 0 {
+			log.ErrorLog.Printf("Error parsing workspace id: %v\n", errParam)
+			return SendError(c, ErrInvalidWorkspaceIDParam)
+		}
+
+		boardID, errParam := c.ParamsInt("boardID")
+		if errParam != nil || boardID <= 0 {
+			log.ErrorLog.Printf("Error parsing board id: %v\n", errParam)
+			return SendError(c, ErrInvalidBoardIDParam)
+		}
+
+		userID, err := utils.GetUserID(c)
+		if err != nil {
+			log.ErrorLog.Printf("Error loading user: %v\n", err)
+			return SendError(c, err)
+		}
+
+		if err = workspaceService.MoveBoard(c.UserContext(), userID, uint(id), uint(boardID)); err != nil {
+			log.ErrorLog.Printf("Error moving board to workspace: %v\n", err)
+			return SendError(c, err)
+		}
+
+		msg := "Board moved to workspace successfully"
+		log.InfoLog.Println(msg)
+		return SendSuccessResponse(c, msg, boardID)
+	}
+}
@@ -1,10 +1,13 @@
 package middlerwares
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/jwt"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
 
@@ -15,9 +18,16 @@ var (
 	ErrNoAuthToken        = fiber.NewError(fiber.StatusUnauthorized, "authorization token not specified")
 	ErrMalformedAuthToken = fiber.NewError(fiber.StatusUnauthorized, "authorization token is malformed")
 	ErrTokenExpired       = fiber.NewError(fiber.StatusUnauthorized, "token expired")
+
+	ErrTokenNotAllowed      = fiber.NewError(fiber.StatusForbidden, "personal access tokens cannot be used for this endpoint")
+	ErrInsufficientScope    = fiber.NewError(fiber.StatusForbidden, "personal access token does not have the required scope")
+	ErrTokenBoardRestricted = fiber.NewError(fiber.StatusForbidden, "personal access token is restricted to another board")
 )
 
-func Auth(secret []byte) fiber.Handler {
+// Auth accepts Bearer JWTs and personal access tokens. Personal access tokens
+// are only accepted on routes that name the resources they belong to, and
+// only with a scope granting the access the HTTP method needs.
+func Auth(authService *services.AuthService, resources ...domains.TokenResource) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		h := c.GetReqHeaders()["Authorization"]
 		if len(h) == 0 {
@@ -34,10 +44,26 @@ func Auth(secret []byte) fiber.Handler {
 		// Extract the token part
 		tokenString := strings.TrimPrefix(h[0], "Bearer ")
 
-		claims, err := jwt.ParseToken(tokenString, secret)
+		if strings.HasPrefix(tokenString, services.PersonalAccessTokenPrefix) {
+			claims, err := authService.ValidatePersonalAccessToken(c.UserContext(), tokenString)
+			if err != nil {
+				log.ErrorLog.Printf("Error unathorized: %v\n", err)
+				return handlers.SendError(c, err)
+			}
+
+			if err := checkTokenScopes(c, claims, resources); err != nil {
+				log.ErrorLog.Printf("Error personal access token not allowed: %v\n", err)
+				return handlers.SendError(c, err)
+			}
+
+			c.Locals(jwt.UserClaimKey, claims)
+			return c.Next()
+		}
+
+		claims, err := authService.ValidateAccessToken(c.UserContext(), tokenString)
 		if err != nil {
 			log.ErrorLog.Printf("Error unathorized: %v\n", err)
-			return handlers.SendError(c, ErrNoAuthToken)
+			return handlers.SendError(c, err)
 		}
 
 		c.Locals(jwt.UserClaimKey, claims)
@@ -51,6 +77,48 @@ func Auth(secret []byte) fiber.Handler {
 	}
 }
 
+func checkTokenScopes(c *fiber.Ctx, claims *jwt.UserClaims, resources []domains.TokenResource) error {
+	if len(resources) == 0 {
+		return ErrTokenNotAllowed
+	}
+
+	write := c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead
+	allowed := false
+	for _, resource := range resources {
+		if domains.ScopesAllow(claims.Scopes, resource, write) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ErrInsufficientScope
+	}
+
+	if claims.BoardID != 0 {
+		boardID, ok := boardIDFromPath(c.Path())
+		if !ok || boardID != claims.BoardID {
+			return ErrTokenBoardRestricted
+		}
+	}
+	return nil
+}
+
+// boardIDFromPath finds the board of the request, all board routes start with /boards/:id.
+func boardIDFromPath(path string) (uint, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] != "boards" {
+			continue
+		}
+		id, err := strconv.ParseUint(segments[i+1], 10, 32)
+		if err != nil {
+			return 0, false
+		}
+		return uint(id), true
+	}
+	return 0, false
+}
+
 func RoleChecker(roles ...string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		claims := c.Locals(jwt.UserClaimKey).(*jwt.UserClaims)
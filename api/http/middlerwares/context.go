@@ -11,7 +11,9 @@ import (
 func SetUserContext() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		ctxValue := &valuecontext.ContextValue{
-			Logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			Logger:    slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			ClientIP:  c.IP(),
+			UserAgent: c.Get(fiber.HeaderUserAgent),
 		}
 
 		c.SetUserContext(valuecontext.NewValueContext(c.UserContext(), ctxValue))
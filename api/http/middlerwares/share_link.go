@@ -0,0 +1,24 @@
+package middlerwares
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ShareLink lets requests through with the board the share link token in the
+// route resolves to, instead of an authenticated user.
+func ShareLink(shareService *services.BoardShareService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		boardID, err := shareService.ResolveLink(c.UserContext(), c.Params("token"))
+		if err != nil {
+			log.ErrorLog.Printf("Error resolving share link: %v\n", err)
+			return handlers.SendError(c, err)
+		}
+
+		c.Locals(utils.SharedBoardIDKey, boardID)
+		return c.Next()
+	}
+}
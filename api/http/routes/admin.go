@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitAdminRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	adminGroup := (*router).Group("/admin",
+		middlerwares.Auth(container.AuthService()),
+		middlerwares.RoleChecker(domains.UserRoleAdmin.String()),
+	)
+	tx := middlerwares.SetTransaction(adapters.NewGormCommitter(container.RawRBConnection()))
+
+	adminGroup.Get("/users", handlers.AdminGetUsers(container.AdminService()))
+	adminGroup.Get("/users/:id", handlers.AdminGetUser(container.AdminService()))
+	adminGroup.Post("/users/:id/disable", handlers.AdminDisableUser(container.AdminService()))
+	adminGroup.Post("/users/:id/enable", handlers.AdminEnableUser(container.AdminService()))
+	adminGroup.Post("/users/:id/password-reset", handlers.AdminForcePasswordReset(container.AdminService()))
+	adminGroup.Post("/users/:id/mfa/reset", handlers.ResetUserMFA(container.MFAService()))
+
+	adminGroup.Get("/boards", handlers.AdminGetBoards(container.AdminService()))
+	adminGroup.Get("/stats", handlers.AdminGetStats(container.AdminService()))
+
+	adminGroup.Get("/audit-logs", handlers.GetAuditLogs(container.AuditService()))
+	adminGroup.Get("/audit-logs/export", handlers.ExportAuditLogs(container.AuditService()))
+
+	adminGroup.Get("/roles", handlers.AdminGetRoles(container.RoleService()))
+	adminGroup.Post("/roles", handlers.AdminCreateRole(container.RoleService()))
+	adminGroup.Put("/roles/:id", handlers.AdminUpdateRole(container.RoleService()))
+	adminGroup.Delete("/roles/:id", tx, handlers.AdminDeleteRole(container.RoleService()))
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitAnalyticsRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	analyticsGroup := (*router).Group("/boards/:id")
+	auth := middlerwares.Auth(container.AuthService(), domains.ResourceAnalytics)
+
+	analyticsGroup.Get("/analytics", auth, handlers.GetBoardAnalytics(container.AnalyticsService()))
+	analyticsGroup.Get("/workload", auth, handlers.GetBoardWorkload(container.AnalyticsService()))
+}
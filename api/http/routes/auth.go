@@ -2,12 +2,26 @@ package routes
 
 import (
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
 	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters"
 	"github.com/gofiber/fiber/v2"
 )
 
 func InitAuthRoutes(router *fiber.Router, app *app.Container) {
-	(*router).Post("/signup", handlers.SignUpUser(app.UserService()))
+	tx := middlerwares.SetTransaction(adapters.NewGormCommitter(app.RawRBConnection()))
+
+	(*router).Post("/signup", handlers.SignUpUser(app.UserService(), app.AccountService()))
 	(*router).Post("/login", handlers.LoginUser(app.AuthService()))
 	(*router).Get("/refresh", handlers.RefreshCreds(app.AuthService()))
+	(*router).Post("/logout", middlerwares.Auth(app.AuthService()), handlers.Logout(app.AuthService()))
+	(*router).Post("/password/forgot", handlers.ForgotPassword(app.AccountService()))
+	(*router).Post("/password/reset", handlers.ResetPassword(app.AccountService()))
+	(*router).Post("/email/verify", tx, handlers.VerifyEmail(app.AccountService(), app.BoardInvitationService()))
+	(*router).Post("/email/verify/resend", handlers.ResendVerificationEmail(app.AccountService()))
+	(*router).Post("/logout/all", middlerwares.Auth(app.AuthService()), handlers.LogoutAll(app.AuthService()))
+
+	(*router).Get("/auth/oidc/providers", handlers.GetSSOProviders(app.SSOService()))
+	(*router).Get("/auth/oidc/:provider/login", handlers.SSOLogin(app.SSOService()))
+	(*router).Get("/auth/oidc/:provider/callback", tx, handlers.SSOCallback(app.SSOService()))
 }
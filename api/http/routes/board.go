@@ -4,6 +4,8 @@ import (
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
 	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 
 	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
 	"github.com/gofiber/fiber/v2"
@@ -11,14 +13,22 @@ import (
 
 func InitBoardRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
 
-	boardGroup := (*router).Group("/boards", middlerwares.Auth([]byte(cfg.TokenSecret)))
+	// board routes are parents of the other board scoped routes, so auth is set per route
+	boardGroup := (*router).Group("/boards")
+	auth := middlerwares.Auth(container.AuthService(), domains.ResourceBoards)
+	tx := middlerwares.SetTransaction(adapters.NewGormCommitter(container.RawRBConnection()))
 
-	boardGroup.Post("", handlers.CreateBoard(container.BoardService()))
-	boardGroup.Put("/:id", handlers.UpdateBoard(container.BoardService()))
-	boardGroup.Get("/:id", handlers.GetBoardByID(container.BoardService()))
-	boardGroup.Delete("/:id", handlers.DeleteBoard(container.BoardService()))
+	boardGroup.Post("", auth, tx, handlers.CreateBoard(container.BoardService()))
+	boardGroup.Get("", auth, handlers.GetBoards(container.BoardService()))
+	boardGroup.Put("/:id", auth, handlers.UpdateBoard(container.BoardService()))
+	boardGroup.Get("/:id", auth, handlers.GetBoardByID(container.BoardService()))
+	boardGroup.Delete("/:id", auth, handlers.DeleteBoard(container.BoardService()))
+	boardGroup.Post("/:id/archive", auth, handlers.ArchiveBoard(container.BoardService()))
+	boardGroup.Post("/:id/unarchive", auth, handlers.UnarchiveBoard(container.BoardService()))
+	boardGroup.Post("/:id/transfer-ownership", auth, tx, handlers.TransferBoardOwnership(container.BoardService()))
 
-	boardGroup.Post("/:id/add-user", handlers.InviteUserToBoard(container.BoardService()))
-	boardGroup.Delete("/:board_id/users/:user_id", handlers.RemoveUserFromBoard(container.BoardService()))
-	boardGroup.Put("/:board_id/users/:user_id", handlers.ChangeUserRoleInBoard(container.BoardService()))
+	boardGroup.Post("/:id/add-user", auth, tx, handlers.InviteUserToBoard(container.BoardService()))
+	boardGroup.Delete("/:board_id/users/:user_id", auth, tx, handlers.RemoveUserFromBoard(container.BoardService()))
+	boardGroup.Put("/:board_id/users/:user_id", auth, tx, handlers.ChangeUserRoleInBoard(container.BoardService()))
+	boardGroup.Get("/:id/roles", auth, handlers.GetBoardRoles(container.RoleService()))
 }
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitBoardInvitationRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	tx := middlerwares.SetTransaction(adapters.NewGormCommitter(container.RawRBConnection()))
+
+	boardGroup := (*router).Group("/boards/:id/invitations", middlerwares.Auth(container.AuthService(), domains.ResourceBoards))
+	boardGroup.Post("", tx, handlers.InviteByEmail(container.BoardInvitationService()))
+	boardGroup.Get("", handlers.GetBoardInvitations(container.BoardInvitationService()))
+
+	// declining from the email link works without an account, so auth is set per route
+	invitationGroup := (*router).Group("/invitations")
+	auth := middlerwares.Auth(container.AuthService())
+	invitationGroup.Get("", auth, handlers.GetMyInvitations(container.BoardInvitationService()))
+	invitationGroup.Post("/accept", auth, tx, handlers.AcceptInvitationByToken(container.BoardInvitationService()))
+	invitationGroup.Post("/decline", handlers.DeclineInvitationByToken(container.BoardInvitationService()))
+	invitationGroup.Post("/:id/accept", auth, tx, handlers.AcceptInvitation(container.BoardInvitationService()))
+	invitationGroup.Post("/:id/decline", auth, handlers.DeclineInvitation(container.BoardInvitationService()))
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitBoardShareRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	linkGroup := (*router).Group("/boards/:id/share-links", middlerwares.Auth(container.AuthService(), domains.ResourceBoards))
+	linkGroup.Post("", handlers.CreateShareLink(container.BoardShareService()))
+	linkGroup.Get("", handlers.GetShareLinks(container.BoardShareService()))
+	linkGroup.Delete("/:linkID", handlers.RevokeShareLink(container.BoardShareService()))
+
+	// anyone holding the token can read the board, so these routes skip auth
+	sharedGroup := (*router).Group("/shared/:token", middlerwares.ShareLink(container.BoardShareService()))
+	sharedGroup.Get("", handlers.GetSharedBoard(container.BoardShareService()))
+	sharedGroup.Get("/tasks", handlers.GetSharedTasks(container.BoardShareService()))
+}
@@ -5,11 +5,12 @@ import (
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
 	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
 	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 	"github.com/gofiber/fiber/v2"
 )
 
 func InitColumnRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
-	columnGroup := (*router).Group("/boards/:boardId/columns", middlerwares.Auth([]byte(cfg.TokenSecret)))
+	columnGroup := (*router).Group("/boards/:boardId/columns", middlerwares.Auth(container.AuthService(), domains.ResourceColumns))
 
 	columnGroup.Post("", handlers.CreateColumn(container.ColumnService()))
 	columnGroup.Get("", handlers.GetAllColumns(container.ColumnService()))
@@ -18,5 +19,7 @@ func InitColumnRoutes(router *fiber.Router, container *app.Container, cfg config
 	columnGroup.Put("/:id/move", handlers.MoveColumn(container.ColumnService()))
 	columnGroup.Put("/:id/final", handlers.ChangeFinalColumn(container.ColumnService()))
 	columnGroup.Delete("/:id", handlers.DeleteColumn(container.ColumnService()))
+	columnGroup.Post("/:id/archive", handlers.ArchiveColumn(container.ColumnService()))
+	columnGroup.Post("/:id/unarchive", handlers.UnarchiveColumn(container.ColumnService()))
 
 }
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitMFARoutes(router *fiber.Router, app *app.Container, cfg config.Server) {
+
+	(*router).Post("/login/mfa", handlers.LoginMFA(app.AuthService()))
+
+	mfaGroup := (*router).Group("/mfa", middlerwares.Auth(app.AuthService()))
+
+	mfaGroup.Post("/enroll", handlers.EnrollMFA(app.MFAService()))
+	mfaGroup.Post("/enroll/verify", handlers.VerifyMFAEnrollment(app.MFAService()))
+	mfaGroup.Get("/recovery-codes", handlers.GetRecoveryCodesStatus(app.MFAService()))
+	mfaGroup.Post("/recovery-codes", handlers.RegenerateRecoveryCodes(app.MFAService()))
+	mfaGroup.Post("/disable", handlers.DisableMFA(app.MFAService()))
+}
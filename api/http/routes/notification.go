@@ -4,6 +4,7 @@ import (
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
 	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 
 	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
 	"github.com/gofiber/fiber/v2"
@@ -11,7 +12,7 @@ import (
 
 func InitNotificationRoutes(router *fiber.Router, app *app.Container, cfg config.Server) {
 
-	notificationGroup := (*router).Group("/notifications", middlerwares.Auth([]byte(cfg.TokenSecret)))
+	notificationGroup := (*router).Group("/notifications", middlerwares.Auth(app.AuthService(), domains.ResourceNotifications))
 
 	notificationGroup.Get("/", handlers.GetAllNotifications(app.NotificationService()))
 	notificationGroup.Get("/unread", handlers.GetUnreadNotifications(app.NotificationService()))
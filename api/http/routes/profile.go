@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters"
+
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitProfileRoutes(router *fiber.Router, app *app.Container, cfg config.Server) {
+
+	profileGroup := (*router).Group("/me", middlerwares.Auth(app.AuthService()))
+
+	profileGroup.Get("", handlers.GetProfile(app.UserService()))
+	profileGroup.Put("", handlers.UpdateProfile(app.UserService()))
+	profileGroup.Put("/password", handlers.ChangePassword(app.UserService()))
+	profileGroup.Delete("",
+		middlerwares.SetTransaction(adapters.NewGormCommitter(app.RawRBConnection())),
+		handlers.DeleteAccount(app.UserService()),
+	)
+
+	profileGroup.Post("/tokens", handlers.CreatePersonalAccessToken(app.PersonalAccessTokenService()))
+	profileGroup.Get("/tokens", handlers.GetPersonalAccessTokens(app.PersonalAccessTokenService()))
+	profileGroup.Delete("/tokens/:id", handlers.RevokePersonalAccessToken(app.PersonalAccessTokenService()))
+}
@@ -5,13 +5,17 @@ import (
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
 	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
 	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters"
 	"github.com/gofiber/fiber/v2"
 )
 
 func InitRoleRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
-	roleGroup := (*router).Group("/roles", middlerwares.Auth([]byte(cfg.TokenSecret)))
+	roleGroup := (*router).Group("/roles", middlerwares.Auth(container.AuthService()))
+	tx := middlerwares.SetTransaction(adapters.NewGormCommitter(container.RawRBConnection()))
+
+	roleGroup.Get("", handlers.GetRoles(container.RoleService()))
 	roleGroup.Post("", handlers.CreateRole(container.RoleService()))
 	roleGroup.Put("/:id", handlers.UpdateRole(container.RoleService()))
 	roleGroup.Get("/:id", handlers.GetRoleByID(container.RoleService()))
-	roleGroup.Delete("/:id", handlers.DeleteRole(container.RoleService()))
+	roleGroup.Delete("/:id", tx, handlers.DeleteRole(container.RoleService()))
 }
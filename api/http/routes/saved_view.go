@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitSavedViewRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	viewGroup := (*router).Group("/boards/:boardID/views", middlerwares.Auth(container.AuthService(), domains.ResourceViews))
+
+	viewGroup.Post("", handlers.CreateSavedView(container.SavedViewService()))
+	viewGroup.Get("", handlers.GetSavedViews(container.SavedViewService()))
+	viewGroup.Get("/:id", handlers.GetSavedViewByID(container.SavedViewService()))
+	viewGroup.Put("/:id", handlers.UpdateSavedView(container.SavedViewService()))
+	viewGroup.Delete("/:id", handlers.DeleteSavedView(container.SavedViewService()))
+	viewGroup.Get("/:id/tasks", handlers.ExecuteSavedView(container.SavedViewService()))
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitSprintRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	sprintGroup := (*router).Group("/boards/:id/sprints", middlerwares.Auth(container.AuthService(), domains.ResourceSprints))
+
+	sprintGroup.Post("", handlers.CreateSprint(container.SprintService()))
+	sprintGroup.Get("", handlers.GetSprints(container.SprintService()))
+	sprintGroup.Get("/:sprintId", handlers.GetSprintByID(container.SprintService()))
+	sprintGroup.Put("/:sprintId", handlers.UpdateSprint(container.SprintService()))
+	sprintGroup.Delete("/:sprintId", handlers.DeleteSprint(container.SprintService()))
+
+	sprintGroup.Post("/:sprintId/tasks", handlers.AssignTasksToSprint(container.SprintService()))
+	sprintGroup.Delete("/:sprintId/tasks/:taskId", handlers.RemoveTaskFromSprint(container.SprintService()))
+
+	sprintGroup.Post("/:sprintId/start", handlers.StartSprint(container.SprintService()))
+	sprintGroup.Post("/:sprintId/close", handlers.CloseSprint(container.SprintService()))
+	sprintGroup.Get("/:sprintId/burndown", handlers.GetSprintBurndown(container.SprintService()))
+}
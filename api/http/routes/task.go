@@ -4,6 +4,8 @@ import (
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
 	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
 	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 
 	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
 	"github.com/gofiber/fiber/v2"
@@ -11,14 +13,20 @@ import (
 
 func InitTaskRoutes(router *fiber.Router, app *app.Container, cfg config.Server) {
 
-	taskGroup := (*router).Group("/boards/:boardID/tasks", middlerwares.Auth([]byte(cfg.TokenSecret)))
+	taskGroup := (*router).Group("/boards/:boardID/tasks", middlerwares.Auth(app.AuthService(), domains.ResourceTasks))
 
 	taskGroup.Post("/", handlers.CreateTask(app.TaskService()))
+	taskGroup.Post("/bulk",
+		middlerwares.SetTransaction(adapters.NewGormCommitter(app.RawRBConnection())),
+		handlers.BulkTaskOperation(app.TaskService()),
+	)
 	taskGroup.Put("/:id", handlers.UpdateTask(app.TaskService()))
 	taskGroup.Get("/", handlers.GetTasksByBoardID(app.TaskService()))
 	taskGroup.Get("/:id", handlers.GetTaskByID(app.TaskService()))
 	taskGroup.Get("/:id/children", handlers.GetTaskChildren(app.TaskService()))
 	taskGroup.Delete("/:id", handlers.DeleteTask(app.TaskService()))
+	taskGroup.Post("/:id/archive", handlers.ArchiveTask(app.TaskService()))
+	taskGroup.Post("/:id/unarchive", handlers.UnarchiveTask(app.TaskService()))
 
 	taskGroup.Patch("/:id/column", handlers.ChangeTaskColumn(app.TaskService()))
 
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitTeamRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	workspaceTeamGroup := (*router).Group("/workspaces/:id/teams", middlerwares.Auth(container.AuthService()))
+	workspaceTeamGroup.Post("", handlers.CreateTeam(container.TeamService()))
+	workspaceTeamGroup.Get("", handlers.GetWorkspaceTeams(container.TeamService()))
+
+	teamGroup := (*router).Group("/teams", middlerwares.Auth(container.AuthService()))
+	teamGroup.Get("/:id", handlers.GetTeam(container.TeamService()))
+	teamGroup.Put("/:id", handlers.UpdateTeam(container.TeamService()))
+	teamGroup.Delete("/:id", handlers.DeleteTeam(container.TeamService()))
+	teamGroup.Get("/:id/members", handlers.GetTeamMembers(container.TeamService()))
+	teamGroup.Post("/:id/members", handlers.AddTeamMember(container.TeamService()))
+	teamGroup.Delete("/:id/members/:userID", handlers.RemoveTeamMember(container.TeamService()))
+	teamGroup.Post("/:id/notifications", handlers.NotifyTeam(container.TeamService()))
+
+	boardTeamGroup := (*router).Group("/boards/:id/teams", middlerwares.Auth(container.AuthService(), domains.ResourceBoards))
+	boardTeamGroup.Get("", handlers.GetBoardTeams(container.TeamService()))
+	boardTeamGroup.Post("", handlers.AddTeamToBoard(container.TeamService()))
+	boardTeamGroup.Put("/:teamID", handlers.ChangeBoardTeamRole(container.TeamService()))
+	boardTeamGroup.Delete("/:teamID", handlers.RemoveTeamFromBoard(container.TeamService()))
+}
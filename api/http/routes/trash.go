@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitTrashRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	boardGroup := (*router).Group("/boards/:boardID")
+	auth := middlerwares.Auth(container.AuthService(), domains.ResourceTrash)
+
+	boardGroup.Get("/trash", auth, handlers.GetBoardTrash(container.TrashService()))
+	boardGroup.Post("/trash/tasks/:id/restore", auth, handlers.RestoreTask(container.TrashService()))
+	boardGroup.Post("/trash/columns/:id/restore", auth, handlers.RestoreColumn(container.TrashService()))
+	boardGroup.Post("/restore", auth, handlers.RestoreBoard(container.TrashService()))
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/handlers"
+	"github.com/GoBootCamp-Group1/Task-Management/api/http/middlerwares"
+	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
+	"github.com/GoBootCamp-Group1/Task-Management/config"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters"
+	"github.com/gofiber/fiber/v2"
+)
+
+func InitWorkspaceRoutes(router *fiber.Router, container *app.Container, cfg config.Server) {
+	tx := middlerwares.SetTransaction(adapters.NewGormCommitter(container.RawRBConnection()))
+
+	workspaceGroup := (*router).Group("/workspaces", middlerwares.Auth(container.AuthService()))
+	workspaceGroup.Post("", tx, handlers.CreateWorkspace(container.WorkspaceService()))
+	workspaceGroup.Get("", handlers.GetMyWorkspaces(container.WorkspaceService()))
+	workspaceGroup.Get("/:id", handlers.GetWorkspace(container.WorkspaceService()))
+	workspaceGroup.Put("/:id", handlers.UpdateWorkspace(container.WorkspaceService()))
+	workspaceGroup.Delete("/:id", handlers.DeleteWorkspace(container.WorkspaceService()))
+
+	workspaceGroup.Get("/:id/members", handlers.GetWorkspaceMembers(container.WorkspaceService()))
+	workspaceGroup.Post("/:id/members", tx, handlers.AddWorkspaceMember(container.WorkspaceService()))
+	workspaceGroup.Put("/:id/members/:userID", tx, handlers.ChangeWorkspaceMemberRole(container.WorkspaceService()))
+	workspaceGroup.Delete("/:id/members/:userID", tx, handlers.RemoveWorkspaceMember(container.WorkspaceService()))
+
+	workspaceGroup.Post("/:id/boards/:boardID", tx, handlers.MoveBoardToWorkspace(container.WorkspaceService()))
+}
@@ -21,6 +21,7 @@ import (
 func Run(cfg config.Server, app *app.Container) {
 	fiberApp := fiber.New()
 	fiberApp.Get("/swagger/*", swagger.HandlerDefault)
+	fiberApp.Get("/.well-known/jwks.json", handlers.GetJWKS(app.KeySet()))
 
 	rateLimit(cfg, fiberApp)
 	corsLimit(cfg, fiberApp)
@@ -32,13 +33,29 @@ func Run(cfg config.Server, app *app.Container) {
 		log.ErrorLog.Fatal(err)
 	}
 
+	// boards created before creators were enrolled as owners get their creator as owner
+	if err := app.BoardService().BackfillOwners(context.Background()); err != nil {
+		log.ErrorLog.Fatal(err)
+	}
+
 	// register global routes
 	routes.InitAuthRoutes(&api, app)
+	routes.InitWorkspaceRoutes(&api, app, cfg)
+	routes.InitTeamRoutes(&api, app, cfg)
 	routes.InitBoardRoutes(&api, app, cfg)
+	routes.InitBoardInvitationRoutes(&api, app, cfg)
+	routes.InitBoardShareRoutes(&api, app, cfg)
 	routes.InitTaskRoutes(&api, app, cfg)
 	routes.InitColumnRoutes(&api, app, cfg)
 	routes.InitNotificationRoutes(&api, app, cfg)
 	routes.InitRoleRoutes(&api, app, cfg)
+	routes.InitSprintRoutes(&api, app, cfg)
+	routes.InitAnalyticsRoutes(&api, app, cfg)
+	routes.InitSavedViewRoutes(&api, app, cfg)
+	routes.InitTrashRoutes(&api, app, cfg)
+	routes.InitProfileRoutes(&api, app, cfg)
+	routes.InitMFARoutes(&api, app, cfg)
+	routes.InitAdminRoutes(&api, app, cfg)
 
 	// run server
 	err := fiberApp.Listen(fmt.Sprintf("%s:%d", cfg.Host, cfg.HttpPort))
@@ -2,13 +2,20 @@ package app
 
 import (
 	"log"
+	"os"
+	"time"
 
 	"github.com/GoBootCamp-Group1/Task-Management/config"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/cache"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/notifier"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/services"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/jwt"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/notification"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/oidc"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
@@ -17,7 +24,9 @@ type Container struct {
 	cfg                 config.Config
 	dbConn              *gorm.DB
 	cacheClient         *redis.Client
+	keySet              *jwt.KeySet
 	notifier            *notification.Notifier
+	authorizer          ports.Authorizer
 	userService         *services.UserService
 	authService         *services.AuthService
 	boardService        *services.BoardService
@@ -25,6 +34,21 @@ type Container struct {
 	columnService       *services.ColumnService
 	notificationService *services.NotificationService
 	roleService         *services.RoleService
+	sprintService       *services.SprintService
+	analyticsService    *services.AnalyticsService
+	savedViewService    *services.SavedViewService
+	trashService        *services.TrashService
+	retentionService    *services.RetentionService
+	accountService      *services.AccountService
+	mfaService          *services.MFAService
+	ssoService          *services.SSOService
+	tokenService        *services.PersonalAccessTokenService
+	invitationService   *services.BoardInvitationService
+	shareService        *services.BoardShareService
+	workspaceService    *services.WorkspaceService
+	teamService         *services.TeamService
+	adminService        *services.AdminService
+	auditService        *services.AuditService
 }
 
 func NewAppContainer(cfg config.Config) (*Container, error) {
@@ -34,15 +58,32 @@ func NewAppContainer(cfg config.Config) (*Container, error) {
 
 	app.mustInitCache()
 	app.mustInitDB()
+	app.mustInitKeySet()
 	storage.Migrate(app.dbConn)
 
 	app.initNotifier()
 
-	app.setUserService()
+	app.setMFAService()
+	app.setAuditService()
 	app.setAuthService()
+	app.setAccountService()
+	app.setAuthorizer()
 	app.setBoardService()
+	app.setBoardInvitationService()
+	app.setBoardShareService()
+	app.setWorkspaceService()
+	app.setTeamService()
+	app.setAdminService()
+	app.setSSOService()
+	app.setPersonalAccessTokenService()
+	app.setUserService()
 	app.setColumnService()
 	app.setTaskService()
+	app.setSprintService()
+	app.setAnalyticsService()
+	app.setSavedViewService()
+	app.setTrashService()
+	app.setRetentionService()
 	app.setNotificationService()
 	app.setRoleService()
 	return app, nil
@@ -52,6 +93,10 @@ func (a *Container) RawRBConnection() *gorm.DB {
 	return a.dbConn
 }
 
+func (a *Container) KeySet() *jwt.KeySet {
+	return a.keySet
+}
+
 func (a *Container) UserService() *services.UserService {
 	return a.userService
 }
@@ -79,11 +124,86 @@ func (a *Container) NotificationService() *services.NotificationService {
 	return a.notificationService
 }
 
+func (a *Container) SprintService() *services.SprintService {
+	return a.sprintService
+}
+
+func (a *Container) AnalyticsService() *services.AnalyticsService {
+	return a.analyticsService
+}
+
+func (a *Container) SavedViewService() *services.SavedViewService {
+	return a.savedViewService
+}
+
+func (a *Container) TrashService() *services.TrashService {
+	return a.trashService
+}
+
+func (a *Container) RetentionService() *services.RetentionService {
+	return a.retentionService
+}
+
+func (a *Container) AccountService() *services.AccountService {
+	return a.accountService
+}
+
+func (a *Container) MFAService() *services.MFAService {
+	return a.mfaService
+}
+
+func (a *Container) SSOService() *services.SSOService {
+	return a.ssoService
+}
+
+func (a *Container) PersonalAccessTokenService() *services.PersonalAccessTokenService {
+	return a.tokenService
+}
+
+func (a *Container) BoardInvitationService() *services.BoardInvitationService {
+	return a.invitationService
+}
+
+func (a *Container) BoardShareService() *services.BoardShareService {
+	return a.shareService
+}
+
+func (a *Container) WorkspaceService() *services.WorkspaceService {
+	return a.workspaceService
+}
+
+func (a *Container) TeamService() *services.TeamService {
+	return a.teamService
+}
+
+func (a *Container) AuditService() *services.AuditService {
+	return a.auditService
+}
+
+func (a *Container) AdminService() *services.AdminService {
+	return a.adminService
+}
+
 func (a *Container) setUserService() {
 	if a.userService != nil {
 		return
 	}
-	a.userService = services.NewUserService(storage.NewUserRepo(a.dbConn))
+	a.userService = services.NewUserService(storage.NewUserRepo(a.dbConn), storage.NewTaskRepo(a.dbConn), a.boardService, a.authService,
+		a.accountService, a.passwordHasher(), a.passwordPolicy())
+}
+
+func (a *Container) passwordHasher() *password.Hasher {
+	return password.NewHasher(a.cfg.Password.BcryptCost)
+}
+
+func (a *Container) passwordPolicy() domains.PasswordPolicy {
+	return domains.PasswordPolicy{
+		MinLength:     a.cfg.Password.MinLength,
+		RequireUpper:  a.cfg.Password.RequireUpper,
+		RequireLower:  a.cfg.Password.RequireLower,
+		RequireDigit:  a.cfg.Password.RequireDigit,
+		RequireSymbol: a.cfg.Password.RequireSymbol,
+	}
 }
 
 func (a *Container) mustInitDB() {
@@ -112,6 +232,54 @@ func (a *Container) mustInitCache() {
 	a.cacheClient = redisClient
 }
 
+// mustInitKeySet loads the token signing keys. The token secret stays as the
+// first key so tokens issued before the configured keys keep working until
+// their grace period ends.
+func (a *Container) mustInitKeySet() {
+	if a.keySet != nil {
+		return
+	}
+
+	var keys []*jwt.Key
+	if a.cfg.Server.TokenSecret != "" {
+		keys = append(keys, jwt.NewHMACKey(jwt.LegacyKeyID, []byte(a.cfg.Server.TokenSecret), time.Time{}))
+	}
+
+	startedAt := time.Now()
+	for _, k := range a.cfg.JWT.Keys {
+		activeFrom := startedAt
+		if k.ActiveFrom != "" {
+			t, err := time.Parse(time.RFC3339, k.ActiveFrom)
+			if err != nil {
+				log.Fatalf("invalid active_from of jwt key %s: %v", k.ID, err)
+			}
+			activeFrom = t
+		}
+
+		pemData, err := os.ReadFile(k.PrivateKeyFile)
+		if err != nil {
+			log.Fatalf("reading private key of jwt key %s: %v (run `make jwt-keys` or remove the key from jwt.keys)", k.ID, err)
+		}
+		key, err := jwt.NewKeyFromPEM(k.ID, k.Algorithm, pemData, activeFrom)
+		if err != nil {
+			log.Fatal(err)
+		}
+		keys = append(keys, key)
+	}
+
+	gracePeriod := time.Minute * time.Duration(a.cfg.JWT.GracePeriodMinutes)
+	if gracePeriod == 0 {
+		// refresh tokens signed with a replaced key must last until they expire
+		gracePeriod = time.Minute * time.Duration(max(a.cfg.Server.TokenExpMinutes, a.cfg.Server.RefreshTokenExpMinutes))
+	}
+
+	keySet, err := jwt.NewKeySet(keys, gracePeriod)
+	if err != nil {
+		log.Fatal(err)
+	}
+	a.keySet = keySet
+}
+
 func (a *Container) initNotifier() {
 	if a.notifier != nil {
 		return
@@ -150,16 +318,34 @@ func (a *Container) setAuthService() {
 		return
 	}
 
-	a.authService = services.NewAuthService(storage.NewUserRepo(a.dbConn), []byte(a.cfg.Server.TokenSecret),
+	a.authService = services.NewAuthService(storage.NewUserRepo(a.dbConn), storage.NewPersonalAccessTokenRepo(a.dbConn), cache.NewCacheRepository(a.cacheClient), a.passwordHasher(), a.mfaService,
+		a.auditService, a.keySet,
 		a.cfg.Server.TokenExpMinutes,
-		a.cfg.Server.RefreshTokenExpMinutes)
+		a.cfg.Server.RefreshTokenExpMinutes,
+		time.Minute*time.Duration(a.cfg.MFA.ChallengeExpMinutes))
+}
+
+func (a *Container) setMFAService() {
+	if a.mfaService != nil {
+		return
+	}
+	a.mfaService = services.NewMFAService(storage.NewUserRepo(a.dbConn), storage.NewRecoveryCodeRepo(a.dbConn), cache.NewCacheRepository(a.cacheClient), a.passwordHasher(), a.cfg.MFA.Issuer)
+}
+
+func (a *Container) setAuthorizer() {
+	if a.authorizer != nil {
+		return
+	}
+	a.authorizer = services.NewBoardAuthorizer(storage.NewBoardRepo(a.dbConn), storage.NewBoardMemberRepo(a.dbConn), storage.NewRoleRepo(a.dbConn),
+		storage.NewWorkspaceMemberRepo(a.dbConn), storage.NewBoardTeamRepo(a.dbConn))
 }
 
 func (a *Container) setBoardService() {
 	if a.boardService != nil {
 		return
 	}
-	a.boardService = services.NewBoardService(storage.NewBoardRepo(a.dbConn), storage.NewBoardMemberRepo(a.dbConn), storage.NewUserRepo(a.dbConn), storage.NewRoleRepo(a.dbConn))
+	a.boardService = services.NewBoardService(storage.NewBoardRepo(a.dbConn), storage.NewBoardMemberRepo(a.dbConn), storage.NewUserRepo(a.dbConn), storage.NewRoleRepo(a.dbConn),
+		storage.NewWorkspaceMemberRepo(a.dbConn), a.authorizer, a.auditService)
 }
 
 func (a *Container) setTaskService() {
@@ -168,15 +354,15 @@ func (a *Container) setTaskService() {
 	}
 	taskRepository := storage.NewTaskRepo(a.dbConn)
 	taskCommentRepository := storage.NewTaskCommentRepo(a.dbConn)
-	notifierAdapter := notifier.NewNotifierAdapter(a.notifier)
-	a.taskService = services.NewTaskService(taskRepository, notifierAdapter, a.boardService, a.columnService, taskCommentRepository)
+	notifierAdapter := notifier.NewNotifierAdapter(a.notifier, a.cfg.Account.EmailTemplatesDir)
+	a.taskService = services.NewTaskService(taskRepository, notifierAdapter, a.boardService, a.columnService, taskCommentRepository, storage.NewLabelRepo(a.dbConn), a.authorizer)
 }
 
 func (a *Container) setColumnService() {
 	if a.columnService != nil {
 		return
 	}
-	a.columnService = services.NewColumnService(storage.NewColumnRepo(a.dbConn), a.boardService)
+	a.columnService = services.NewColumnService(storage.NewColumnRepo(a.dbConn), a.authorizer)
 }
 
 func (a *Container) setNotificationService() {
@@ -190,5 +376,158 @@ func (a *Container) setRoleService() {
 	if a.roleService != nil {
 		return
 	}
-	a.roleService = services.NewRoleService(storage.NewRoleRepo(a.dbConn))
+	a.roleService = services.NewRoleService(storage.NewRoleRepo(a.dbConn), storage.NewUserRepo(a.dbConn), a.authorizer)
+}
+
+func (a *Container) setSprintService() {
+	if a.sprintService != nil {
+		return
+	}
+	a.sprintService = services.NewSprintService(storage.NewSprintRepo(a.dbConn), storage.NewTaskRepo(a.dbConn), storage.NewColumnRepo(a.dbConn), a.authorizer)
+}
+
+func (a *Container) setAnalyticsService() {
+	if a.analyticsService != nil {
+		return
+	}
+	a.analyticsService = services.NewAnalyticsService(storage.NewTaskRepo(a.dbConn), storage.NewColumnRepo(a.dbConn), a.boardService, a.authorizer)
+}
+
+func (a *Container) setSavedViewService() {
+	if a.savedViewService != nil {
+		return
+	}
+	a.savedViewService = services.NewSavedViewService(storage.NewSavedViewRepo(a.dbConn), a.taskService, a.authorizer)
+}
+
+func (a *Container) setTrashService() {
+	if a.trashService != nil {
+		return
+	}
+	a.trashService = services.NewTrashService(storage.NewTaskRepo(a.dbConn), storage.NewColumnRepo(a.dbConn), storage.NewBoardRepo(a.dbConn), a.authorizer)
+}
+
+func (a *Container) setRetentionService() {
+	if a.retentionService != nil {
+		return
+	}
+	a.retentionService = services.NewRetentionService(storage.NewRetentionRepo(a.dbConn), a.cfg.Retention.PurgeAfterDays, a.cfg.Retention.IntervalMinutes)
+}
+
+func (a *Container) setAccountService() {
+	if a.accountService != nil {
+		return
+	}
+	a.accountService = services.NewAccountService(
+		storage.NewUserRepo(a.dbConn),
+		cache.NewCacheRepository(a.cacheClient),
+		notifier.NewNotifierAdapter(a.notifier, a.cfg.Account.EmailTemplatesDir),
+		a.authService,
+		a.passwordHasher(),
+		a.passwordPolicy(),
+		services.AccountSettings{
+			LinkBaseURL:          a.cfg.Account.LinkBaseURL,
+			ResetTokenExp:        time.Minute * time.Duration(a.cfg.Account.ResetTokenExpMinutes),
+			VerificationTokenExp: time.Hour * time.Duration(a.cfg.Account.VerificationTokenExpHours),
+			EmailCooldown:        time.Second * time.Duration(a.cfg.Account.EmailCooldownSeconds),
+		},
+	)
+}
+
+func (a *Container) setSSOService() {
+	if a.ssoService != nil {
+		return
+	}
+
+	providers := make([]oidc.Config, 0, len(a.cfg.OIDC.Providers))
+	for _, p := range a.cfg.OIDC.Providers {
+		providers = append(providers, oidc.Config{
+			Name:         p.Name,
+			IssuerURL:    p.IssuerURL,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+		})
+	}
+
+	a.ssoService = services.NewSSOService(
+		storage.NewUserRepo(a.dbConn),
+		storage.NewUserIdentityRepo(a.dbConn),
+		cache.NewCacheRepository(a.cacheClient),
+		a.authService,
+		a.invitationService,
+		providers,
+		time.Minute*time.Duration(a.cfg.OIDC.StateExpMinutes),
+	)
+}
+
+func (a *Container) setPersonalAccessTokenService() {
+	if a.tokenService != nil {
+		return
+	}
+	a.tokenService = services.NewPersonalAccessTokenService(storage.NewPersonalAccessTokenRepo(a.dbConn), a.boardService)
+}
+
+func (a *Container) setBoardInvitationService() {
+	if a.invitationService != nil {
+		return
+	}
+	a.invitationService = services.NewBoardInvitationService(
+		storage.NewBoardInvitationRepo(a.dbConn),
+		storage.NewBoardRepo(a.dbConn),
+		storage.NewBoardMemberRepo(a.dbConn),
+		storage.NewUserRepo(a.dbConn),
+		storage.NewRoleRepo(a.dbConn),
+		storage.NewWorkspaceMemberRepo(a.dbConn),
+		a.authorizer,
+		a.auditService,
+		notifier.NewNotifierAdapter(a.notifier, a.cfg.Account.EmailTemplatesDir),
+		services.InvitationSettings{
+			LinkBaseURL: a.cfg.Account.LinkBaseURL,
+			TokenExp:    time.Hour * time.Duration(a.cfg.Account.InvitationExpHours),
+		},
+	)
+}
+
+func (a *Container) setBoardShareService() {
+	if a.shareService != nil {
+		return
+	}
+	a.shareService = services.NewBoardShareService(storage.NewBoardShareLinkRepo(a.dbConn), storage.NewBoardRepo(a.dbConn),
+		storage.NewColumnRepo(a.dbConn), storage.NewTaskRepo(a.dbConn), a.authorizer)
+}
+
+func (a *Container) setWorkspaceService() {
+	if a.workspaceService != nil {
+		return
+	}
+	a.workspaceService = services.NewWorkspaceService(storage.NewWorkspaceRepo(a.dbConn), storage.NewWorkspaceMemberRepo(a.dbConn),
+		storage.NewTeamMemberRepo(a.dbConn), storage.NewBoardRepo(a.dbConn), storage.NewBoardMemberRepo(a.dbConn), storage.NewUserRepo(a.dbConn),
+		a.authorizer, a.auditService)
+}
+
+func (a *Container) setTeamService() {
+	if a.teamService != nil {
+		return
+	}
+	a.teamService = services.NewTeamService(storage.NewTeamRepo(a.dbConn), storage.NewTeamMemberRepo(a.dbConn),
+		storage.NewWorkspaceMemberRepo(a.dbConn), storage.NewBoardRepo(a.dbConn), storage.NewBoardTeamRepo(a.dbConn),
+		storage.NewRoleRepo(a.dbConn), storage.NewUserRepo(a.dbConn), a.authorizer, a.auditService,
+		notifier.NewNotifierAdapter(a.notifier, a.cfg.Account.EmailTemplatesDir))
+}
+
+func (a *Container) setAuditService() {
+	if a.auditService != nil {
+		return
+	}
+	a.auditService = services.NewAuditService(storage.NewAuditLogRepo(a.dbConn))
+}
+
+func (a *Container) setAdminService() {
+	if a.adminService != nil {
+		return
+	}
+	a.adminService = services.NewAdminService(storage.NewUserRepo(a.dbConn), storage.NewBoardRepo(a.dbConn), storage.NewStatsRepo(a.dbConn),
+		a.authService, a.accountService)
 }
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+
 	http_server "github.com/GoBootCamp-Group1/Task-Management/api/http"
 	"github.com/GoBootCamp-Group1/Task-Management/cmd/api/app"
 	"github.com/GoBootCamp-Group1/Task-Management/config"
@@ -32,6 +34,8 @@ func main() {
 		log.Fatal(err)
 	}
 
+	go appContainer.RetentionService().Run(context.Background())
+
 	http_server.Run(cfg.Server, appContainer)
 }
 
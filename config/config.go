@@ -1,10 +1,16 @@
 package config
 
 type Config struct {
-	Server Server `mapstructure:"server"`
-	DB     DB     `mapstructure:"db"`
-	Redis  Redis  `mapstructure:"redis"`
-	Email  Email  `mapstructure:"email"`
+	Server    Server    `mapstructure:"server"`
+	DB        DB        `mapstructure:"db"`
+	Redis     Redis     `mapstructure:"redis"`
+	Email     Email     `mapstructure:"email"`
+	Retention Retention `mapstructure:"retention"`
+	Password  Password  `mapstructure:"password"`
+	Account   Account   `mapstructure:"account"`
+	MFA       MFA       `mapstructure:"mfa"`
+	OIDC      OIDC      `mapstructure:"oidc"`
+	JWT       JWT       `mapstructure:"jwt"`
 }
 
 type Server struct {
@@ -43,3 +49,57 @@ type Email struct {
 	SmtpEncryption  string `mapstructure:"smtp_encryption"`
 	SmtpFromName    string `mapstructure:"smtp_from_name"`
 }
+
+type Retention struct {
+	PurgeAfterDays  uint `mapstructure:"purge_after_days"`
+	IntervalMinutes uint `mapstructure:"interval_minutes"`
+}
+
+type Password struct {
+	BcryptCost    int  `mapstructure:"bcrypt_cost"`
+	MinLength     int  `mapstructure:"min_length"`
+	RequireUpper  bool `mapstructure:"require_upper"`
+	RequireLower  bool `mapstructure:"require_lower"`
+	RequireDigit  bool `mapstructure:"require_digit"`
+	RequireSymbol bool `mapstructure:"require_symbol"`
+}
+
+type Account struct {
+	LinkBaseURL               string `mapstructure:"link_base_url"`
+	EmailTemplatesDir         string `mapstructure:"email_templates_dir"`
+	ResetTokenExpMinutes      uint   `mapstructure:"reset_token_exp_minutes"`
+	VerificationTokenExpHours uint   `mapstructure:"verification_token_exp_hours"`
+	EmailCooldownSeconds      uint   `mapstructure:"email_cooldown_seconds"`
+	InvitationExpHours        uint   `mapstructure:"invitation_exp_hours"`
+}
+
+type MFA struct {
+	Issuer              string `mapstructure:"issuer"`
+	ChallengeExpMinutes uint   `mapstructure:"challenge_exp_minutes"`
+}
+
+type OIDC struct {
+	StateExpMinutes uint           `mapstructure:"state_exp_minutes"`
+	Providers       []OIDCProvider `mapstructure:"providers"`
+}
+
+type OIDCProvider struct {
+	Name         string   `mapstructure:"name"`
+	IssuerURL    string   `mapstructure:"issuer_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+type JWT struct {
+	GracePeriodMinutes uint     `mapstructure:"grace_period_minutes"`
+	Keys               []JWTKey `mapstructure:"keys"`
+}
+
+type JWTKey struct {
+	ID             string `mapstructure:"kid"`
+	Algorithm      string `mapstructure:"algorithm"`
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+	ActiveFrom     string `mapstructure:"active_from"`
+}
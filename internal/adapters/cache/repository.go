@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
 	"github.com/redis/go-redis/v9"
 	"sync"
@@ -11,6 +12,14 @@ import (
 var (
 	instance *CacheRepository
 	once     sync.Once
+
+	// incrExisting increments a key only if it exists, so an expired or
+	// deleted key is not recreated without its expiration
+	incrExisting = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return false
+end
+return redis.call("INCR", KEYS[1])`)
 )
 
 type CacheRepository struct {
@@ -31,9 +40,36 @@ func (c *CacheRepository) Set(ctx context.Context, key string, value string, ttl
 	return c.client.Set(ctx, key, value, ttl).Err()
 }
 
+// SetNX stores the value in the redis database unless the key exists
+func (c *CacheRepository) SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, key, value, ttl).Result()
+}
+
 // Get retrieves the value from the redis database
 func (c *CacheRepository) Get(ctx context.Context, key string) (string, error) {
-	return c.client.Get(ctx, key).Result()
+	value, err := c.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ports.ErrCacheMiss
+	}
+	return value, err
+}
+
+// GetDel retrieves and removes the value from the redis database atomically
+func (c *CacheRepository) GetDel(ctx context.Context, key string) (string, error) {
+	value, err := c.client.GetDel(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ports.ErrCacheMiss
+	}
+	return value, err
+}
+
+// Incr increments an existing integer value in the redis database atomically
+func (c *CacheRepository) Incr(ctx context.Context, key string) (int64, error) {
+	value, err := incrExisting.Run(ctx, c.client, []string{key}).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, ports.ErrCacheMiss
+	}
+	return value, err
 }
 
 // Delete removes the value from the redis database
@@ -48,7 +84,7 @@ func (c *CacheRepository) DeleteByPrefix(ctx context.Context, prefix string) err
 
 	for {
 		var err error
-		keys, cursor, err = c.client.Scan(ctx, cursor, prefix, 100).Result()
+		keys, cursor, err = c.client.Scan(ctx, cursor, prefix+"*", 100).Result()
 		if err != nil {
 			return err
 		}
@@ -19,10 +19,13 @@ func (c *GormCommitter) Tx() any {
 	return c.tx
 }
 
+// Begin starts a new transaction. The returned committer owns it, so a
+// committer shared by a route can serve concurrent requests.
 func (c *GormCommitter) Begin() valuecontext.Committer {
-	tx := c.db.Begin()
-	c.tx = tx
-	return c
+	return &GormCommitter{
+		db: c.db,
+		tx: c.db.Begin(),
+	}
 }
 
 func (c *GormCommitter) Commit() error {
@@ -38,3 +41,17 @@ func (c *GormCommitter) Rollback() error {
 	}
 	return c.tx.Rollback().Error
 }
+
+func (c *GormCommitter) SavePoint(name string) error {
+	if c.tx == nil {
+		return nil
+	}
+	return c.tx.SavePoint(name).Error
+}
+
+func (c *GormCommitter) RollbackTo(name string) error {
+	if c.tx == nil {
+		return nil
+	}
+	return c.tx.RollbackTo(name).Error
+}
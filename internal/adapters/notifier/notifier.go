@@ -6,15 +6,17 @@ import (
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/notification"
 	notification2 "github.com/GoBootCamp-Group1/Task-Management/pkg/notification/notification"
+	"path/filepath"
 )
 
 type Adapter struct {
 	ports.Notifier
-	notifier *notification.Notifier
+	notifier     *notification.Notifier
+	templatesDir string
 }
 
-func NewNotifierAdapter(notifier *notification.Notifier) *Adapter {
-	return &Adapter{notifier: notifier}
+func NewNotifierAdapter(notifier *notification.Notifier, templatesDir string) *Adapter {
+	return &Adapter{notifier: notifier, templatesDir: templatesDir}
 }
 
 func (a *Adapter) SendInAppNotification(ctx context.Context, userID uint, input ports.NotificationInput) error {
@@ -36,3 +38,14 @@ func (a *Adapter) SendEmailNotification(to string, subject string, body string)
 	}
 	return a.notifier.Email.Send(to, subject, body)
 }
+
+func (a *Adapter) SendTemplatedEmail(to string, subject string, templateName string, data any) error {
+	if a.notifier.Email == nil {
+		return errors.New("email notifier is not configured")
+	}
+	body, err := a.notifier.Email.RenderTemplateToString(filepath.Join(a.templatesDir, templateName), data)
+	if err != nil {
+		return err
+	}
+	return a.notifier.Email.Send(to, subject, body)
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type auditLogRepo struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepo(db *gorm.DB) ports.AuditLogRepo {
+	return &auditLogRepo{
+		db: db,
+	}
+}
+
+// Create stores the entry in the transaction of the context, so entries of
+// changes that get rolled back are rolled back with them.
+func (r *auditLogRepo) Create(ctx context.Context, entry *domains.AuditLog) error {
+	entity := mappers.AuditLogDomainToEntity(entry)
+	if err := withTx(ctx, r.db).Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	entry.ID = entity.ID
+	entry.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *auditLogRepo) GetAll(ctx context.Context, filter domains.AuditLogFilter, limit, offset uint) ([]domains.AuditLog, uint, error) {
+	query := r.filter(withTx(ctx, r.db).Model(&entities.AuditLog{}), filter)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	var auditLogEntities []entities.AuditLog
+	if err := query.Order("id DESC").Offset(int(offset)).Limit(int(limit)).Find(&auditLogEntities).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.AuditLogEntitiesToDomain(auditLogEntities), uint(total), nil
+}
+
+func (r *auditLogRepo) GetAfter(ctx context.Context, filter domains.AuditLogFilter, afterID uint, limit uint) ([]domains.AuditLog, error) {
+	var auditLogEntities []entities.AuditLog
+	err := r.filter(withTx(ctx, r.db).Model(&entities.AuditLog{}), filter).
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(int(limit)).
+		Find(&auditLogEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.AuditLogEntitiesToDomain(auditLogEntities), nil
+}
+
+func (r *auditLogRepo) filter(query *gorm.DB, filter domains.AuditLogFilter) *gorm.DB {
+	if filter.Action != "" {
+		query = query.Where("action = ?", string(filter.Action))
+	}
+	if filter.ActorID != nil {
+		query = query.Where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.TargetUserID != nil {
+		query = query.Where("target_user_id = ?", *filter.TargetUserID)
+	}
+	if filter.BoardID != nil {
+		query = query.Where("board_id = ?", *filter.BoardID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+	return query
+}
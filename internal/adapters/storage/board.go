@@ -3,6 +3,8 @@ package storage
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
@@ -23,22 +25,26 @@ func NewBoardRepo(db *gorm.DB) ports.BoardRepo {
 }
 
 var (
-	ErrBoardAlreadyExists = "Board already exists"
+	ErrBoardAlreadyExists   = "Board already exists"
+	ErrBoardNotFound        = "Board not found"
+	ErrBoardAlreadyArchived = "Board not found or already archived"
+	ErrBoardNotArchived     = "Board not found or not archived"
+	ErrBoardNotInTrash      = "Board not found in trash"
 )
 
 func (r *boardRepo) Create(ctx context.Context, board *domains.Board) error {
 	var existingBoard entities.Board
-	err := r.db.WithContext(ctx).Model(&entities.Board{}).Where("name = ? AND created_by = ?", board.Name, board.CreatedBy).First(&existingBoard).Error
+	err := withTx(ctx, r.db).Model(&entities.Board{}).Where("name = ? AND created_by = ?", board.Name, board.CreatedBy).First(&existingBoard).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	if existingBoard.ID != 0 {
 		return fiber.NewError(fiber.StatusBadRequest, ErrBoardAlreadyExists)
 	}
-	return r.db.Transaction(func(tx *gorm.DB) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		entity := mappers.DomainToBoardEntity(board)
 
-		if err := tx.WithContext(ctx).Create(&entity).Error; err != nil {
+		if err := tx.Create(&entity).Error; err != nil {
 			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 		}
 		board.ID = entity.ID // set the ID to the domain object after creation
@@ -48,8 +54,11 @@ func (r *boardRepo) Create(ctx context.Context, board *domains.Board) error {
 
 func (r *boardRepo) GetByID(ctx context.Context, id uint) (*domains.Board, error) {
 	var b entities.Board
-	err := r.db.WithContext(ctx).Model(&entities.Board{}).Where("id = ?", id).First(&b).Error
+	err := withTx(ctx, r.db).Model(&entities.Board{}).Where("id = ?", id).First(&b).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrBoardNotFound)
+		}
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return mappers.BoardEntityToDomain(&b), nil
@@ -57,32 +66,241 @@ func (r *boardRepo) GetByID(ctx context.Context, id uint) (*domains.Board, error
 
 func (r *boardRepo) Update(ctx context.Context, board *domains.Board) error {
 	var existingBoard *entities.Board
-	if err := r.db.WithContext(ctx).Model(&entities.Board{}).Where("id = ?", board.ID).First(&existingBoard).Error; err != nil {
+	if err := withTx(ctx, r.db).Model(&entities.Board{}).Where("id = ?", board.ID).First(&existingBoard).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
 	existingBoard.Name = board.Name
 	existingBoard.IsPrivate = board.IsPrivate
 
-	if err := r.db.WithContext(ctx).Save(&existingBoard).Error; err != nil {
+	if err := withTx(ctx, r.db).Save(&existingBoard).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
 	return nil
 }
 
-func (r *boardRepo) Delete(ctx context.Context, id uint) error {
-	if err := r.db.WithContext(ctx).Delete(&entities.Board{}, id).Error; err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
-	}
-	return nil
+// Delete soft-deletes a board with its columns, tasks, comments and
+// memberships in one transaction, all stamped with the same deletion time so
+// Restore can bring back exactly what went with the board. Task dependencies
+// are removed for good.
+func (r *boardRepo) Delete(ctx context.Context, id uint, deletedBy uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var b entities.Board
+		if err := tx.Where("id = ?", id).First(&b).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fiber.NewError(fiber.StatusNotFound, ErrBoardNotFound)
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		deleted := map[string]interface{}{
+			"deleted_at": time.Now(),
+			"deleted_by": deletedBy,
+		}
+		boardTasks := tx.Unscoped().Model(&entities.Task{}).Select("id").Where("board_id = ?", id)
+
+		if err := tx.Where("task_id IN (?) OR dependent_task_id IN (?)", boardTasks, boardTasks).
+			Delete(&entities.TaskDependency{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Model(&entities.TaskComment{}).Where("task_id IN (?)", boardTasks).
+			Update("deleted_at", deleted["deleted_at"]).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		updates := []struct {
+			model  interface{}
+			column string
+			values map[string]interface{}
+		}{
+			{&entities.Task{}, "board_id", deleted},
+			{&entities.Column{}, "board_id", deleted},
+			{&entities.BoardMember{}, "board_id", map[string]interface{}{"deleted_at": deleted["deleted_at"]}},
+			{&entities.Board{}, "id", deleted},
+		}
+		for _, u := range updates {
+			if err := tx.Model(u.model).Where(u.column+" = ?", id).Updates(u.values).Error; err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+		}
+		return nil
+	})
 }
 
 func (r *boardRepo) GetAll(ctx context.Context) ([]domains.Board, error) {
 	var boards []entities.Board
-	err := r.db.WithContext(ctx).Find(&boards).Error
+	err := withTx(ctx, r.db).Where("archived_at IS NULL").Find(&boards).Error
 	if err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return mappers.BoardEntitiesToDomain(boards), nil
 }
+
+func (r *boardRepo) Search(ctx context.Context, filter domains.BoardFilter, limit uint, offset uint) ([]domains.Board, uint, error) {
+	query := withTx(ctx, r.db).Model(&entities.Board{})
+	if filter.WorkspaceID != nil {
+		query = query.Where("workspace_id = ?", *filter.WorkspaceID)
+	}
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		query = query.Where("name ILIKE ?", "%"+escapeLike(search)+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	if offset > 0 {
+		query = query.Offset(int(offset))
+	}
+	if limit > 0 {
+		query = query.Limit(int(limit))
+	}
+
+	var boards []entities.Board
+	if err := query.Order("id").Find(&boards).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.BoardEntitiesToDomain(boards), uint(total), nil
+}
+
+func (r *boardRepo) GetVisible(ctx context.Context, userID uint, filter domains.BoardFilter) ([]domains.Board, error) {
+	db := withTx(ctx, r.db)
+	memberBoards := db.Model(&entities.BoardMember{}).Select("board_id").Where("user_id = ?", userID)
+	teamBoards := db.Model(&entities.BoardTeam{}).Select("board_id").
+		Where("team_id IN (?)", db.Model(&entities.TeamMember{}).Select("team_id").Where("user_id = ?", userID))
+	// board roles only count in workspaces the user still belongs to
+	joinedWorkspaces := db.Model(&entities.WorkspaceMember{}).Select("workspace_id").Where("user_id = ?", userID)
+	workspaces := db.Model(&entities.WorkspaceMember{}).Select("workspace_id").
+		Where("user_id = ? AND role <> ?", userID, string(domains.WorkspaceRoleGuest))
+
+	query := db.Model(&entities.Board{}).
+		Where("archived_at IS NULL").
+		Where("((id IN (?) OR id IN (?)) AND (workspace_id IS NULL OR workspace_id IN (?))) OR (is_private = ? AND (workspace_id IS NULL OR workspace_id IN (?)))",
+			memberBoards, teamBoards, joinedWorkspaces, false, workspaces)
+	if filter.WorkspaceID != nil {
+		query = query.Where("workspace_id = ?", *filter.WorkspaceID)
+	}
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		query = query.Where("name ILIKE ?", "%"+escapeLike(search)+"%")
+	}
+
+	var boards []entities.Board
+	if err := query.Order("name").Find(&boards).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.BoardEntitiesToDomain(boards), nil
+}
+
+func (r *boardRepo) CountByWorkspace(ctx context.Context, workspaceID uint) (int64, error) {
+	var count int64
+	if err := withTx(ctx, r.db).Unscoped().Model(&entities.Board{}).Where("workspace_id = ?", workspaceID).Count(&count).Error; err != nil {
+		return 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return count, nil
+}
+
+func (r *boardRepo) SetWorkspace(ctx context.Context, id uint, workspaceID uint) error {
+	result := withTx(ctx, r.db).Model(&entities.Board{}).Where("id = ?", id).Update("workspace_id", workspaceID)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrBoardNotFound)
+	}
+	return nil
+}
+
+func (r *boardRepo) Archive(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.Board{}).
+		Where("id = ? AND archived_at IS NULL", id).
+		Update("archived_at", time.Now())
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, ErrBoardAlreadyArchived)
+	}
+	return nil
+}
+
+func (r *boardRepo) Unarchive(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.Board{}).
+		Where("id = ? AND archived_at IS NOT NULL", id).
+		Update("archived_at", nil)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, ErrBoardNotArchived)
+	}
+	return nil
+}
+
+func (r *boardRepo) GetDeletedByID(ctx context.Context, id uint) (*domains.Board, error) {
+	var b entities.Board
+	err := withTx(ctx, r.db).Unscoped().Model(&entities.Board{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&b).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrBoardNotInTrash)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.BoardEntityToDomain(&b), nil
+}
+
+// Restore brings a deleted board back together with the columns, tasks,
+// comments and memberships deleted along with it, unless its creator owns a
+// live board with the same name by now.
+func (r *boardRepo) Restore(ctx context.Context, id uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var b entities.Board
+		if err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&b).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fiber.NewError(fiber.StatusNotFound, ErrBoardNotInTrash)
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		var count int64
+		if err := tx.Model(&entities.Board{}).
+			Where("name = ? AND created_by = ?", b.Name, b.CreatedBy).
+			Count(&count).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if count > 0 {
+			return fiber.NewError(fiber.StatusBadRequest, ErrBoardAlreadyExists)
+		}
+
+		deletedAt := b.DeletedAt.Time
+		restored := map[string]interface{}{"deleted_at": nil, "deleted_by": nil}
+		boardTasks := tx.Unscoped().Model(&entities.Task{}).Select("id").Where("board_id = ?", id)
+
+		if err := tx.Unscoped().Model(&entities.TaskComment{}).
+			Where("task_id IN (?) AND deleted_at = ?", boardTasks, deletedAt).
+			Update("deleted_at", nil).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		updates := []struct {
+			model  interface{}
+			column string
+			values map[string]interface{}
+		}{
+			{&entities.Task{}, "board_id", restored},
+			{&entities.Column{}, "board_id", restored},
+			{&entities.BoardMember{}, "board_id", map[string]interface{}{"deleted_at": nil}},
+			{&entities.Board{}, "id", restored},
+		}
+		for _, u := range updates {
+			if err := tx.Unscoped().Model(u.model).
+				Where(u.column+" = ? AND deleted_at = ?", id, deletedAt).
+				Updates(u.values).Error; err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+		}
+		return nil
+	})
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
@@ -27,7 +28,7 @@ var (
 )
 
 func (r *boardMemberRepo) Create(ctx context.Context, member *domains.BoardMember) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		entity := mappers.DomainToBoardMemberEntity(member)
 		if err := tx.WithContext(ctx).Table(entity.TableName()).Create(&entity).Error; err != nil {
 			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
@@ -39,7 +40,7 @@ func (r *boardMemberRepo) Create(ctx context.Context, member *domains.BoardMembe
 
 func (r *boardMemberRepo) GetByID(ctx context.Context, id uint) (*domains.BoardMember, error) {
 	var m entities.BoardMember
-	err := r.db.WithContext(ctx).Table(m.TableName()).Where("id = ?", id).First(&m).Error
+	err := withTx(ctx, r.db).Table(m.TableName()).Where("id = ?", id).First(&m).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, ErrBoardMemberNotFound)
@@ -51,13 +52,13 @@ func (r *boardMemberRepo) GetByID(ctx context.Context, id uint) (*domains.BoardM
 
 func (r *boardMemberRepo) Update(ctx context.Context, member *domains.BoardMember) error {
 	var existingBoardMember entities.BoardMember
-	if err := r.db.WithContext(ctx).Table(existingBoardMember.TableName()).Model(&entities.Board{}).Where("id = ?", member.ID).First(&existingBoardMember).Error; err != nil {
+	if err := withTx(ctx, r.db).Table(existingBoardMember.TableName()).Model(&entities.Board{}).Where("id = ?", member.ID).First(&existingBoardMember).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	existingBoardMember.RoleID = member.RoleID
 	existingBoardMember.UserID = member.UserID
 	existingBoardMember.BoardID = member.BoardID
-	if err := r.db.WithContext(ctx).Save(&existingBoardMember).Error; err != nil {
+	if err := withTx(ctx, r.db).Save(&existingBoardMember).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
@@ -65,7 +66,7 @@ func (r *boardMemberRepo) Update(ctx context.Context, member *domains.BoardMembe
 
 func (r *boardMemberRepo) Delete(ctx context.Context, id uint) error {
 	var entity entities.BoardMember
-	if err := r.db.WithContext(ctx).Table(entity.TableName()).Where("id = ?", id).Delete(&entity).Error; err != nil {
+	if err := withTx(ctx, r.db).Table(entity.TableName()).Where("id = ?", id).Delete(&entity).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
@@ -73,7 +74,7 @@ func (r *boardMemberRepo) Delete(ctx context.Context, id uint) error {
 
 func (r *boardMemberRepo) GetBoardMembers(ctx context.Context, boardID uint) ([]domains.BoardMember, error) {
 	var boardMemberEntities []entities.BoardMember
-	err := r.db.WithContext(ctx).Table(boardMemberEntities[0].TableName()).Where("board_id = ?", boardID).Find(&boardMemberEntities).Error
+	err := withTx(ctx, r.db).Table(entities.BoardMember{}.TableName()).Where("board_id = ?", boardID).Order("id ASC").Find(&boardMemberEntities).Error
 	if err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -83,10 +84,13 @@ func (r *boardMemberRepo) GetBoardMembers(ctx context.Context, boardID uint) ([]
 }
 func (r *boardMemberRepo) GetBoardMember(ctx context.Context, boardID, userID uint) (*domains.BoardMember, error) {
 	var boardMember entities.BoardMember
-	if err := r.db.WithContext(ctx).
+	if err := withTx(ctx, r.db).
 		Table(boardMember.TableName()).
 		Where("board_id = ? AND user_id = ?", boardID, userID).
 		First(&boardMember).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrBoardMemberNotFound)
+		}
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
@@ -96,3 +100,41 @@ func (r *boardMemberRepo) GetBoardMember(ctx context.Context, boardID, userID ui
 
 	return mappers.BoardMemberEntityToDomain(&boardMember), nil
 }
+
+func (r *boardMemberRepo) GetUserMemberships(ctx context.Context, userID uint) ([]domains.BoardMember, error) {
+	var boardMemberEntities []entities.BoardMember
+	err := withTx(ctx, r.db).Table(entities.BoardMember{}.TableName()).Where("user_id = ?", userID).Order("id ASC").Find(&boardMemberEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return mappers.BoardMemberEntitiesToDomain(boardMemberEntities), nil
+}
+
+// BackfillOwners makes the creator the owner of every board without an owner,
+// promoting the creator's membership or adding one. Boards created before
+// creators were enrolled on creation have no members at all.
+func (r *boardMemberRepo) BackfillOwners(ctx context.Context, ownerRoleID uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		if err := tx.Exec(`UPDATE board_users m SET role_id = ?, updated_at = ?
+			FROM boards b
+			WHERE m.board_id = b.id AND m.user_id = b.created_by
+			AND m.deleted_at IS NULL AND b.deleted_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM board_users o WHERE o.board_id = b.id AND o.role_id = ? AND o.deleted_at IS NULL)`,
+			ownerRoleID, now, ownerRoleID).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		if err := tx.Exec(`INSERT INTO board_users (board_id, user_id, role_id, created_at, updated_at)
+			SELECT b.id, b.created_by, ?, ?, ? FROM boards b
+			WHERE b.deleted_at IS NULL
+			AND EXISTS (SELECT 1 FROM users u WHERE u.id = b.created_by AND u.deleted_at IS NULL)
+			AND NOT EXISTS (SELECT 1 FROM board_users o WHERE o.board_id = b.id AND o.role_id = ? AND o.deleted_at IS NULL)`,
+			ownerRoleID, now, now, ownerRoleID).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
+}
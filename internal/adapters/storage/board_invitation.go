@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrBoardInvitationNotFound = "invitation not found"
+)
+
+type boardInvitationRepo struct {
+	db *gorm.DB
+}
+
+func NewBoardInvitationRepo(db *gorm.DB) ports.BoardInvitationRepo {
+	return &boardInvitationRepo{
+		db: db,
+	}
+}
+
+func (r *boardInvitationRepo) Create(ctx context.Context, invitation *domains.BoardInvitation) error {
+	entity := mappers.BoardInvitationDomainToEntity(invitation)
+	if err := withTx(ctx, r.db).Omit("Board", "Role", "Inviter").Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	invitation.ID = entity.ID
+	invitation.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *boardInvitationRepo) GetByID(ctx context.Context, id uint) (*domains.BoardInvitation, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+func (r *boardInvitationRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*domains.BoardInvitation, error) {
+	return r.first(ctx, "token_hash = ?", tokenHash)
+}
+
+func (r *boardInvitationRepo) first(ctx context.Context, query string, args ...any) (*domains.BoardInvitation, error) {
+	var entity entities.BoardInvitation
+	err := withTx(ctx, r.db).Model(&entities.BoardInvitation{}).
+		Preload("Board").
+		Preload("Role").
+		Where(query, args...).
+		First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrBoardInvitationNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	invitation := mappers.BoardInvitationEntityToDomain(entity)
+	return &invitation, nil
+}
+
+func (r *boardInvitationRepo) GetByBoardID(ctx context.Context, boardID uint) ([]domains.BoardInvitation, error) {
+	var invitationEntities []entities.BoardInvitation
+	err := withTx(ctx, r.db).Model(&entities.BoardInvitation{}).
+		Preload("Role").
+		Where("board_id = ?", boardID).
+		Order("id DESC").
+		Find(&invitationEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.BoardInvitationEntitiesToDomain(invitationEntities), nil
+}
+
+func (r *boardInvitationRepo) GetPending(ctx context.Context, email string, boardID uint) ([]domains.BoardInvitation, error) {
+	query := withTx(ctx, r.db).Model(&entities.BoardInvitation{}).
+		Preload("Board").
+		Preload("Role").
+		Where("email = ? AND status = ? AND expires_at > ?", email, string(domains.InvitationPending), time.Now())
+	if boardID != 0 {
+		query = query.Where("board_id = ?", boardID)
+	}
+
+	var invitationEntities []entities.BoardInvitation
+	if err := query.Order("id").Find(&invitationEntities).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.BoardInvitationEntitiesToDomain(invitationEntities), nil
+}
+
+func (r *boardInvitationRepo) Respond(ctx context.Context, id uint, status domains.InvitationStatus, respondedAt time.Time) error {
+	result := withTx(ctx, r.db).Model(&entities.BoardInvitation{}).
+		Where("id = ? AND status = ?", id, string(domains.InvitationPending)).
+		Updates(map[string]any{"status": string(status), "responded_at": respondedAt})
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrBoardInvitationNotFound)
+	}
+	return nil
+}
+
+func (r *boardInvitationRepo) ExpireOverdue(ctx context.Context, now time.Time) error {
+	err := withTx(ctx, r.db).Model(&entities.BoardInvitation{}).
+		Where("status = ? AND expires_at <= ?", string(domains.InvitationPending), now).
+		Update("status", string(domains.InvitationExpired)).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
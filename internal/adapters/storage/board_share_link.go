@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrBoardShareLinkNotFound = "share link not found"
+)
+
+type boardShareLinkRepo struct {
+	db *gorm.DB
+}
+
+func NewBoardShareLinkRepo(db *gorm.DB) ports.BoardShareLinkRepo {
+	return &boardShareLinkRepo{
+		db: db,
+	}
+}
+
+func (r *boardShareLinkRepo) Create(ctx context.Context, link *domains.BoardShareLink) error {
+	entity := mappers.BoardShareLinkDomainToEntity(link)
+	if err := withTx(ctx, r.db).Omit("Board", "Creator").Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	link.ID = entity.ID
+	link.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *boardShareLinkRepo) GetByHash(ctx context.Context, tokenHash string) (*domains.BoardShareLink, error) {
+	var entity entities.BoardShareLink
+	err := withTx(ctx, r.db).Model(&entities.BoardShareLink{}).Where("token_hash = ?", tokenHash).First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrBoardShareLinkNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	link := mappers.BoardShareLinkEntityToDomain(entity)
+	return &link, nil
+}
+
+func (r *boardShareLinkRepo) GetByBoardID(ctx context.Context, boardID uint) ([]domains.BoardShareLink, error) {
+	var linkEntities []entities.BoardShareLink
+	err := withTx(ctx, r.db).Model(&entities.BoardShareLink{}).
+		Where("board_id = ?", boardID).
+		Order("id DESC").
+		Find(&linkEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.BoardShareLinkEntitiesToDomain(linkEntities), nil
+}
+
+func (r *boardShareLinkRepo) Revoke(ctx context.Context, boardID uint, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.BoardShareLink{}).
+		Where("id = ? AND board_id = ? AND revoked_at IS NULL", id, boardID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrBoardShareLinkNotFound)
+	}
+	return nil
+}
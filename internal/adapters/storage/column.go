@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
@@ -20,6 +21,10 @@ type columnRepo struct {
 var (
 	ErrColumnAlreadyExists     = "column already exists"
 	ErrOrderPositionOutOfRange = "order position is out of range"
+	ErrColumnAlreadyArchived   = "column not found or already archived"
+	ErrColumnNotArchived       = "column not found or not archived"
+	ErrColumnNotInTrash        = "column not found in trash"
+	ErrColumnNotEmpty          = "column has tasks, provide a target column to move them to"
 )
 
 func NewColumnRepo(db *gorm.DB) ports.ColumnRepo {
@@ -33,7 +38,7 @@ func (r *columnRepo) Create(ctx context.Context, column *domains.Column) error {
 	var lastColumn entities.Column
 	var lastPosition int = 1
 
-	err := r.db.WithContext(ctx).Model(&entities.Column{}).Where(&entities.Column{BoardID: column.BoardID, Name: column.Name}).First(&existingColumn).Error
+	err := withTx(ctx, r.db).Model(&entities.Column{}).Where(&entities.Column{BoardID: column.BoardID, Name: column.Name}).First(&existingColumn).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -42,7 +47,7 @@ func (r *columnRepo) Create(ctx context.Context, column *domains.Column) error {
 		return fiber.NewError(fiber.StatusBadRequest, ErrColumnAlreadyExists)
 	}
 
-	err = r.db.WithContext(ctx).Model(&entities.Column{}).Order("order_position DESC").First(&lastColumn).Error
+	err = withTx(ctx, r.db).Model(&entities.Column{}).Order("order_position DESC").First(&lastColumn).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -51,13 +56,13 @@ func (r *columnRepo) Create(ctx context.Context, column *domains.Column) error {
 	}
 
 	if column.IsFinal {
-		err = r.db.Model(&entities.Column{}).Where(&entities.Column{BoardID: column.BoardID}).Update("is_final", false).Error
+		err = withTx(ctx, r.db).Model(&entities.Column{}).Where(&entities.Column{BoardID: column.BoardID}).Update("is_final", false).Error
 		if err != nil {
 			return nil
 		}
 	}
 
-	return r.db.Transaction(func(tx *gorm.DB) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		entity := mappers.DomainToColumnEntity(column)
 		entity.OrderPosition = lastPosition
 
@@ -73,7 +78,7 @@ func (r *columnRepo) Create(ctx context.Context, column *domains.Column) error {
 
 func (r *columnRepo) GetByID(ctx context.Context, id uint) (*domains.Column, error) {
 	var column entities.Column
-	err := r.db.WithContext(ctx).Model(&entities.Column{}).Where(&entities.Column{Model: gorm.Model{ID: id}}).Preload("Board").First(&column).Error
+	err := withTx(ctx, r.db).Model(&entities.Column{}).Where(&entities.Column{Model: gorm.Model{ID: id}}).Preload("Board").First(&column).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, "Column not found")
@@ -87,7 +92,7 @@ func (r *columnRepo) GetByID(ctx context.Context, id uint) (*domains.Column, err
 func (r *columnRepo) GetAll(ctx context.Context, boardId uint, page int, pageSize int) (response.PaginateResponseFromService[[]*domains.Column], error) {
 	var columnEntities []entities.Column
 
-	query := r.db.WithContext(ctx).Model(&entities.Column{}).Where(&entities.Column{BoardID: boardId}).Order("order_position ASC")
+	query := withTx(ctx, r.db).Model(&entities.Column{}).Where(&entities.Column{BoardID: boardId}).Where("archived_at IS NULL").Order("order_position ASC")
 
 	var total int64
 	if err := query.Count(&total).Error; err != nil {
@@ -124,7 +129,7 @@ func (r *columnRepo) GetAll(ctx context.Context, boardId uint, page int, pageSiz
 // only name can update
 func (r *columnRepo) Update(ctx context.Context, updateColumn *domains.ColumnUpdate) error {
 	var foundColumn *entities.Column
-	err := r.db.WithContext(ctx).Model(&entities.Column{}).Where(&entities.Column{Model: gorm.Model{ID: updateColumn.ID}}).First(&foundColumn).Error
+	err := withTx(ctx, r.db).Model(&entities.Column{}).Where(&entities.Column{Model: gorm.Model{ID: updateColumn.ID}}).First(&foundColumn).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusNotFound, "Column not found")
@@ -134,13 +139,13 @@ func (r *columnRepo) Update(ctx context.Context, updateColumn *domains.ColumnUpd
 
 	foundColumn.Name = updateColumn.Name
 
-	return r.db.WithContext(ctx).Save(&foundColumn).Error
+	return withTx(ctx, r.db).Save(&foundColumn).Error
 }
 
 func (r *columnRepo) Move(ctx context.Context, moveColumn *domains.ColumnMove) error {
 	var foundColumn *entities.Column
 	var lastColumn entities.Column
-	err := r.db.WithContext(ctx).Model(&entities.Column{}).Where("id = ?", moveColumn.ID).First(&foundColumn).Error
+	err := withTx(ctx, r.db).Model(&entities.Column{}).Where("id = ?", moveColumn.ID).First(&foundColumn).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusNotFound, "Column not found")
@@ -152,7 +157,7 @@ func (r *columnRepo) Move(ctx context.Context, moveColumn *domains.ColumnMove) e
 		return nil
 	}
 
-	err = r.db.WithContext(ctx).Model(&entities.Column{}).Order("order_position DESC").First(&lastColumn).Error
+	err = withTx(ctx, r.db).Model(&entities.Column{}).Order("order_position DESC").First(&lastColumn).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -172,13 +177,13 @@ func (r *columnRepo) Move(ctx context.Context, moveColumn *domains.ColumnMove) e
 		unit = -1
 	}
 
-	err = r.db.WithContext(ctx).Model(&entities.Column{}).Where(condition, foundColumn.BoardID, moveColumn.OrderPosition, foundColumn.OrderPosition).Updates(map[string]interface{}{"order_position": gorm.Expr("order_position + ?", unit)}).Error
+	err = withTx(ctx, r.db).Model(&entities.Column{}).Where(condition, foundColumn.BoardID, moveColumn.OrderPosition, foundColumn.OrderPosition).Updates(map[string]interface{}{"order_position": gorm.Expr("order_position + ?", unit)}).Error
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
 	foundColumn.OrderPosition = moveColumn.OrderPosition
-	err = r.db.WithContext(ctx).Save(&foundColumn).Error
+	err = withTx(ctx, r.db).Save(&foundColumn).Error
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -187,7 +192,7 @@ func (r *columnRepo) Move(ctx context.Context, moveColumn *domains.ColumnMove) e
 
 func (r *columnRepo) Final(ctx context.Context, id uint) error {
 	var foundColumn *entities.Column
-	err := r.db.WithContext(ctx).Model(&entities.Column{}).Where(&entities.Column{Model: gorm.Model{ID: id}}).First(&foundColumn).Error
+	err := withTx(ctx, r.db).Model(&entities.Column{}).Where(&entities.Column{Model: gorm.Model{ID: id}}).First(&foundColumn).Error
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -196,24 +201,198 @@ func (r *columnRepo) Final(ctx context.Context, id uint) error {
 		return nil
 	}
 
-	err = r.db.Model(&entities.Column{}).Where(&entities.Column{BoardID: foundColumn.BoardID}).Update("is_final", false).Error
+	err = withTx(ctx, r.db).Model(&entities.Column{}).Where(&entities.Column{BoardID: foundColumn.BoardID}).Update("is_final", false).Error
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
 	foundColumn.IsFinal = true
 
-	err = r.db.WithContext(ctx).Save(&foundColumn).Error
+	err = withTx(ctx, r.db).Save(&foundColumn).Error
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
 }
 
-func (r *columnRepo) Delete(ctx context.Context, id uint) error {
-	err := r.db.WithContext(ctx).Delete(&entities.Column{}, id).Error
-	if err != nil {
+// Delete soft-deletes a column. Its tasks are moved to the end of moveTo;
+// a column that still has tasks cannot be deleted without a target.
+func (r *columnRepo) Delete(ctx context.Context, id uint, deletedBy uint, moveTo *uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var taskEntities []entities.Task
+		if err := tx.Model(&entities.Task{}).
+			Where("column_id = ?", id).
+			Order("order_position ASC").
+			Find(&taskEntities).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		if len(taskEntities) > 0 {
+			if moveTo == nil {
+				return fiber.NewError(fiber.StatusBadRequest, ErrColumnNotEmpty)
+			}
+			if err := moveColumnTasks(tx, taskEntities, id, *moveTo, deletedBy); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&entities.Column{}).Where("id = ?", id).Update("deleted_by", deletedBy).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Delete(&entities.Column{}, id).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
+}
+
+// moveColumnTasks appends the tasks to the target column keeping their order
+// and records the moves for sprint burndown and board analytics.
+func moveColumnTasks(tx *gorm.DB, taskEntities []entities.Task, fromColumnID uint, toColumnID uint, userID uint) error {
+	var lastPosition int
+	if err := tx.Model(&entities.Task{}).
+		Where("column_id = ?", toColumnID).
+		Select("COALESCE(MAX(order_position), 0)").
+		Scan(&lastPosition).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	transitions := make([]entities.TaskColumnTransition, len(taskEntities))
+	for i, task := range taskEntities {
+		if err := tx.Model(&entities.Task{}).Where("id = ?", task.ID).Updates(map[string]interface{}{
+			"column_id":      toColumnID,
+			"order_position": lastPosition + i + 1,
+		}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		from := fromColumnID
+		transitions[i] = entities.TaskColumnTransition{
+			TaskID:       task.ID,
+			BoardID:      task.BoardID,
+			UserID:       userID,
+			FromColumnID: &from,
+			ToColumnID:   toColumnID,
+		}
+	}
+
+	if err := tx.Create(&transitions).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
 }
+
+func (r *columnRepo) Archive(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.Column{}).
+		Where("id = ? AND archived_at IS NULL", id).
+		Update("archived_at", time.Now())
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, ErrColumnAlreadyArchived)
+	}
+	return nil
+}
+
+func (r *columnRepo) Unarchive(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.Column{}).
+		Where("id = ? AND archived_at IS NOT NULL", id).
+		Update("archived_at", nil)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, ErrColumnNotArchived)
+	}
+	return nil
+}
+
+func (r *columnRepo) GetDeletedListByBoardID(ctx context.Context, boardID uint) ([]domains.TrashItem, error) {
+	var columnEntities []entities.Column
+	err := withTx(ctx, r.db).Unscoped().Model(&entities.Column{}).
+		Preload("Deleter").
+		Where("board_id = ? AND deleted_at IS NOT NULL", boardID).
+		Order("deleted_at DESC").
+		Find(&columnEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.ColumnEntitiesToTrashItems(columnEntities), nil
+}
+
+func (r *columnRepo) GetDeletedByID(ctx context.Context, id uint) (*domains.Column, error) {
+	var column entities.Column
+	err := withTx(ctx, r.db).Unscoped().Model(&entities.Column{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&column).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrColumnNotInTrash)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.ColumnEntityToDomain(&column), nil
+}
+
+// Restore brings a deleted column back. It fails when a live column already
+// uses the name, moves the column to the end when its position is taken and
+// drops the final flag when the board got another final column meanwhile.
+func (r *columnRepo) Restore(ctx context.Context, id uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var column entities.Column
+		if err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&column).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fiber.NewError(fiber.StatusNotFound, ErrColumnNotInTrash)
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		var count int64
+		if err := tx.Model(&entities.Column{}).
+			Where("board_id = ? AND name = ?", column.BoardID, column.Name).
+			Count(&count).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if count > 0 {
+			return fiber.NewError(fiber.StatusBadRequest, ErrColumnAlreadyExists)
+		}
+
+		updates := map[string]interface{}{
+			"deleted_at": nil,
+			"deleted_by": nil,
+		}
+
+		if err := tx.Model(&entities.Column{}).
+			Where("board_id = ? AND order_position = ?", column.BoardID, column.OrderPosition).
+			Count(&count).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if count > 0 {
+			var lastPosition int
+			if err := tx.Model(&entities.Column{}).
+				Where("board_id = ?", column.BoardID).
+				Select("COALESCE(MAX(order_position), 0)").
+				Scan(&lastPosition).Error; err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+			updates["order_position"] = lastPosition + 1
+		}
+
+		if column.IsFinal {
+			if err := tx.Model(&entities.Column{}).
+				Where("board_id = ? AND is_final = ?", column.BoardID, true).
+				Count(&count).Error; err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+			if count > 0 {
+				updates["is_final"] = false
+			}
+		}
+
+		if err := tx.Unscoped().Model(&entities.Column{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
+}
@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+// AuditLog has no update or delete timestamps, entries are never changed.
+type AuditLog struct {
+	ID           uint              `gorm:"primarykey"`
+	Action       string            `gorm:"type:varchar(64);index"`
+	ActorID      *uint             `gorm:"index"`
+	TargetUserID *uint             `gorm:"index"`
+	BoardID      *uint             `gorm:"index"`
+	Details      map[string]string `gorm:"type:jsonb;serializer:json"`
+	IP           string            `gorm:"type:varchar(45)"`
+	UserAgent    string            `gorm:"type:text"`
+	CreatedAt    time.Time         `gorm:"index"`
+}
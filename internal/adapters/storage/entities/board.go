@@ -1,12 +1,19 @@
 package entities
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
 type Board struct {
 	gorm.Model
-	CreatedBy uint
-	Name      string
-	IsPrivate bool
+	CreatedBy   uint
+	Name        string
+	IsPrivate   bool
+	WorkspaceID *uint      `gorm:"index"`
+	ArchivedAt  *time.Time `gorm:"index"`
+	DeletedBy   *uint
+
+	Deleter *User `gorm:"foreignKey:DeletedBy"`
 }
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type BoardInvitation struct {
+	gorm.Model
+	BoardID     uint   `gorm:"index"`
+	Email       string `gorm:"type:varchar(255);index"`
+	RoleID      uint
+	InvitedBy   uint
+	Status      string `gorm:"type:varchar(20);index"`
+	TokenHash   string `gorm:"type:varchar(64);uniqueIndex"`
+	ExpiresAt   time.Time
+	RespondedAt *time.Time
+
+	Board   Board `gorm:"foreignKey:BoardID"`
+	Role    Role  `gorm:"foreignKey:RoleID"`
+	Inviter User  `gorm:"foreignKey:InvitedBy"`
+}
@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type BoardShareLink struct {
+	gorm.Model
+	BoardID   uint `gorm:"index"`
+	CreatedBy uint
+	Prefix    string `gorm:"type:varchar(20)"`
+	TokenHash string `gorm:"type:varchar(64);uniqueIndex"`
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+
+	Board   Board `gorm:"foreignKey:BoardID"`
+	Creator User  `gorm:"foreignKey:CreatedBy"`
+}
@@ -1,6 +1,10 @@
 package entities
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Column struct {
 	gorm.Model
@@ -9,6 +13,9 @@ type Column struct {
 	OrderPosition int
 	IsFinal       bool
 	CreatedBy     uint
+	ArchivedAt    *time.Time `gorm:"index"`
+	DeletedBy     *uint
 
-	Board *Board
+	Board   *Board
+	Deleter *User `gorm:"foreignKey:DeletedBy"`
 }
@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Label struct {
+	gorm.Model
+	BoardID uint   `gorm:"uniqueIndex:idx_labels_board_name"`
+	Name    string `gorm:"type:varchar(50);uniqueIndex:idx_labels_board_name"`
+	Color   string `gorm:"type:varchar(20)"`
+
+	Board Board `gorm:"foreignKey:BoardID"`
+}
+
+type TaskLabel struct {
+	TaskID    uint `gorm:"primaryKey"`
+	LabelID   uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+}
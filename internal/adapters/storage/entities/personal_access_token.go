@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PersonalAccessToken struct {
+	gorm.Model
+	UserID     uint   `gorm:"index"`
+	Name       string `gorm:"type:varchar(100)"`
+	Prefix     string `gorm:"type:varchar(20)"`
+	TokenHash  string `gorm:"type:varchar(64);uniqueIndex"`
+	Scopes     string
+	BoardID    *uint
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+
+	User  User   `gorm:"foreignKey:UserID"`
+	Board *Board `gorm:"foreignKey:BoardID"`
+}
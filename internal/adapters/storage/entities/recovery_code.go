@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RecoveryCode is a single-use MFA recovery code, only its bcrypt hash is stored.
+// Codes created before bcrypt was used hold a SHA-256 digest.
+type RecoveryCode struct {
+	gorm.Model
+	UserID   uint   `gorm:"index"`
+	CodeHash string `gorm:"type:varchar(64)"`
+	UsedAt   *time.Time
+
+	User User `gorm:"foreignKey:UserID"`
+}
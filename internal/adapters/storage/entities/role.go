@@ -6,7 +6,8 @@ import (
 
 type Role struct {
 	gorm.Model
-	Name        string `gorm:"type:varchar(255);not null;unique"`
-	Description string `gorm:"type:text"`
-	Weight      int    `gorm:"type:int"`
+	Name        string   `gorm:"type:varchar(255);not null"`
+	Description string   `gorm:"type:text"`
+	BoardID     *uint    `gorm:"index"`
+	Permissions []string `gorm:"type:jsonb;serializer:json"`
 }
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SavedView struct {
+	gorm.Model
+	BoardID        uint `gorm:"index"`
+	UserID         uint `gorm:"index"`
+	Name           string
+	Filter         SavedViewFilter `gorm:"type:jsonb;serializer:json"`
+	VisibleColumns []uint          `gorm:"type:jsonb;serializer:json"`
+	IsShared       bool            `gorm:"default:false"`
+
+	Board Board `gorm:"foreignKey:BoardID"`
+	User  User  `gorm:"foreignKey:UserID"`
+}
+
+// SavedViewFilter is the json document stored in saved_views.filter
+type SavedViewFilter struct {
+	ColumnIDs   []uint     `json:"column_ids,omitempty"`
+	AssigneeIDs []uint     `json:"assignee_ids,omitempty"`
+	Unassigned  bool       `json:"unassigned,omitempty"`
+	SprintID    *uint      `json:"sprint_id,omitempty"`
+	Backlog     bool       `json:"backlog,omitempty"`
+	Search      string     `json:"search,omitempty"`
+	DueFrom     *time.Time `json:"due_from,omitempty"`
+	DueTo       *time.Time `json:"due_to,omitempty"`
+	GroupBy     string     `json:"group_by,omitempty"`
+	SortField   string     `json:"sort_field,omitempty"`
+	SortDesc    bool       `json:"sort_desc,omitempty"`
+}
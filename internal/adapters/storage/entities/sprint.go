@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Sprint struct {
+	gorm.Model
+	BoardID   uint `gorm:"index"`
+	CreatedBy uint
+	Name      string
+	Goal      string `gorm:"type:text"`
+	StartDate time.Time
+	EndDate   time.Time
+	State     string `gorm:"type:varchar(20);default:planned"`
+	StartedAt *time.Time
+	ClosedAt  *time.Time
+
+	Board Board `gorm:"foreignKey:BoardID"`
+}
+
+type SprintTask struct {
+	SprintID  uint `gorm:"primaryKey"`
+	TaskID    uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	RemovedAt *time.Time
+}
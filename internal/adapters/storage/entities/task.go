@@ -15,18 +15,22 @@ type Task struct {
 	ParentID      *uint
 	AssigneeID    *uint
 	ColumnID      uint
+	SprintID      *uint
 	OrderPosition int
 	Name          string
 	Description   string
 	StartDateTime *time.Time `gorm:"column:start_datetime"`
 	EndDateTime   *time.Time `gorm:"column:end_datetime"`
 	StoryPoint    int
+	ArchivedAt    *time.Time `gorm:"index"`
+	DeletedBy     *uint
 
 	Board    Board  `gorm:"foreignKey:BoardID"`
 	Creator  User   `gorm:"foreignKey:CreatedBy"`
 	Column   Column `gorm:"foreignKey:ColumnID"`
 	Parent   *Task  `gorm:"foreignKey:ParentID"`
 	Assignee *User  `gorm:"foreignKey:AssigneeID"`
+	Deleter  *User  `gorm:"foreignKey:DeletedBy"`
 }
 
 type TaskChild struct {
@@ -50,3 +54,13 @@ type TaskComment struct {
 	Task      Task `gorm:"foreignKey:TaskID"`
 	User      User `gorm:"foreignKey:UserID"`
 }
+
+type TaskColumnTransition struct {
+	ID           uint `gorm:"primarykey"`
+	CreatedAt    time.Time
+	TaskID       uint `gorm:"index"`
+	BoardID      uint `gorm:"index"`
+	UserID       uint
+	FromColumnID *uint
+	ToColumnID   uint
+}
@@ -0,0 +1,32 @@
+package entities
+
+import "gorm.io/gorm"
+
+type Team struct {
+	gorm.Model
+	WorkspaceID uint   `gorm:"index"`
+	Name        string `gorm:"type:varchar(100)"`
+	CreatedBy   uint
+
+	Workspace Workspace `gorm:"foreignKey:WorkspaceID"`
+}
+
+type TeamMember struct {
+	gorm.Model
+	TeamID uint `gorm:"uniqueIndex:idx_team_member"`
+	UserID uint `gorm:"uniqueIndex:idx_team_member;index"`
+
+	Team Team `gorm:"foreignKey:TeamID"`
+	User User `gorm:"foreignKey:UserID"`
+}
+
+type BoardTeam struct {
+	gorm.Model
+	BoardID uint `gorm:"uniqueIndex:idx_board_team"`
+	TeamID  uint `gorm:"uniqueIndex:idx_board_team;index"`
+	RoleID  uint
+
+	Board Board `gorm:"foreignKey:BoardID"`
+	Team  Team  `gorm:"foreignKey:TeamID"`
+	Role  Role  `gorm:"foreignKey:RoleID"`
+}
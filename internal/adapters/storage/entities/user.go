@@ -1,13 +1,23 @@
 package entities
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
 type User struct {
 	gorm.Model
-	Name     string
-	Email    string
-	Password string
-	Role     uint8
+	Name                  string
+	Email                 string
+	Password              string
+	Role                  uint8
+	EmailVerifiedAt       *time.Time
+	AvatarURL             string
+	Timezone              string
+	Locale                string
+	MFASecret             string
+	MFAEnabledAt          *time.Time
+	DisabledAt            *time.Time
+	PasswordResetRequired bool
 }
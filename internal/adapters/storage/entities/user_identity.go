@@ -0,0 +1,14 @@
+package entities
+
+import "gorm.io/gorm"
+
+// UserIdentity links a user to their account at an external identity provider.
+type UserIdentity struct {
+	gorm.Model
+	UserID   uint   `gorm:"index"`
+	Provider string `gorm:"type:varchar(100);uniqueIndex:idx_user_identities_provider_subject"`
+	Subject  string `gorm:"type:varchar(255);uniqueIndex:idx_user_identities_provider_subject"`
+	Email    string
+
+	User User `gorm:"foreignKey:UserID"`
+}
@@ -0,0 +1,19 @@
+package entities
+
+import "gorm.io/gorm"
+
+type Workspace struct {
+	gorm.Model
+	Name      string `gorm:"type:varchar(100)"`
+	CreatedBy uint
+}
+
+type WorkspaceMember struct {
+	gorm.Model
+	WorkspaceID uint   `gorm:"uniqueIndex:idx_workspace_member"`
+	UserID      uint   `gorm:"uniqueIndex:idx_workspace_member;index"`
+	Role        string `gorm:"type:varchar(20)"`
+
+	Workspace Workspace `gorm:"foreignKey:WorkspaceID"`
+	User      User      `gorm:"foreignKey:UserID"`
+}
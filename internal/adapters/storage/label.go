@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type labelRepo struct {
+	db *gorm.DB
+}
+
+func NewLabelRepo(db *gorm.DB) ports.LabelRepo {
+	return &labelRepo{
+		db: db,
+	}
+}
+
+func (r *labelRepo) GetOrCreate(ctx context.Context, boardID uint, name string) (*domains.Label, error) {
+	label := entities.Label{BoardID: boardID, Name: name}
+	err := withTx(ctx, r.db).
+		Where(entities.Label{BoardID: boardID, Name: name}).
+		FirstOrCreate(&label).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.LabelEntityToDomain(&label), nil
+}
+
+func (r *labelRepo) AddToTask(ctx context.Context, taskID uint, labelID uint) error {
+	err := withTx(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entities.TaskLabel{TaskID: taskID, LabelID: labelID}).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+func AuditLogEntityToDomain(entity entities.AuditLog) domains.AuditLog {
+	return domains.AuditLog{
+		ID:           entity.ID,
+		Action:       domains.AuditAction(entity.Action),
+		ActorID:      entity.ActorID,
+		TargetUserID: entity.TargetUserID,
+		BoardID:      entity.BoardID,
+		Details:      entity.Details,
+		IP:           entity.IP,
+		UserAgent:    entity.UserAgent,
+		CreatedAt:    entity.CreatedAt,
+	}
+}
+
+func AuditLogEntitiesToDomain(entities []entities.AuditLog) []domains.AuditLog {
+	return fp.Map(entities, AuditLogEntityToDomain)
+}
+
+func AuditLogDomainToEntity(model *domains.AuditLog) *entities.AuditLog {
+	return &entities.AuditLog{
+		Action:       string(model.Action),
+		ActorID:      model.ActorID,
+		TargetUserID: model.TargetUserID,
+		BoardID:      model.BoardID,
+		Details:      model.Details,
+		IP:           model.IP,
+		UserAgent:    model.UserAgent,
+	}
+}
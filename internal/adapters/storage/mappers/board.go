@@ -9,19 +9,24 @@ import (
 
 func DomainToBoardEntity(board *domains.Board) *entities.Board {
 	return &entities.Board{
-		Model:     gorm.Model{ID: board.ID},
-		CreatedBy: board.CreatedBy,
-		Name:      board.Name,
-		IsPrivate: board.IsPrivate,
+		Model:       gorm.Model{ID: board.ID},
+		CreatedBy:   board.CreatedBy,
+		Name:        board.Name,
+		IsPrivate:   board.IsPrivate,
+		WorkspaceID: board.WorkspaceID,
+		ArchivedAt:  board.ArchivedAt,
 	}
 }
 
 func BoardEntityToDomain(entity *entities.Board) *domains.Board {
 	return &domains.Board{
-		ID:        entity.ID,
-		CreatedBy: entity.CreatedBy,
-		Name:      entity.Name,
-		IsPrivate: entity.IsPrivate,
+		ID:          entity.ID,
+		CreatedBy:   entity.CreatedBy,
+		Name:        entity.Name,
+		IsPrivate:   entity.IsPrivate,
+		WorkspaceID: entity.WorkspaceID,
+		ArchivedAt:  entity.ArchivedAt,
+		DeletedBy:   entity.DeletedBy,
 	}
 }
 
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+	"gorm.io/gorm"
+)
+
+func BoardInvitationEntityToDomain(entity entities.BoardInvitation) domains.BoardInvitation {
+	invitation := domains.BoardInvitation{
+		ID:          entity.ID,
+		BoardID:     entity.BoardID,
+		Email:       entity.Email,
+		RoleID:      entity.RoleID,
+		InvitedBy:   entity.InvitedBy,
+		Status:      domains.InvitationStatus(entity.Status),
+		TokenHash:   entity.TokenHash,
+		ExpiresAt:   entity.ExpiresAt,
+		RespondedAt: entity.RespondedAt,
+		CreatedAt:   entity.CreatedAt,
+	}
+	if entity.Board.ID != 0 {
+		invitation.Board = BoardEntityToDomain(&entity.Board)
+	}
+	if entity.Role.ID != 0 {
+		invitation.Role = RoleEntityToDomain(&entity.Role)
+	}
+	return invitation
+}
+
+func BoardInvitationEntitiesToDomain(entities []entities.BoardInvitation) []domains.BoardInvitation {
+	return fp.Map(entities, BoardInvitationEntityToDomain)
+}
+
+func BoardInvitationDomainToEntity(model *domains.BoardInvitation) *entities.BoardInvitation {
+	return &entities.BoardInvitation{
+		Model:       gorm.Model{ID: model.ID},
+		BoardID:     model.BoardID,
+		Email:       model.Email,
+		RoleID:      model.RoleID,
+		InvitedBy:   model.InvitedBy,
+		Status:      string(model.Status),
+		TokenHash:   model.TokenHash,
+		ExpiresAt:   model.ExpiresAt,
+		RespondedAt: model.RespondedAt,
+	}
+}
@@ -0,0 +1,34 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+func BoardShareLinkEntityToDomain(entity entities.BoardShareLink) domains.BoardShareLink {
+	return domains.BoardShareLink{
+		ID:        entity.ID,
+		BoardID:   entity.BoardID,
+		CreatedBy: entity.CreatedBy,
+		Prefix:    entity.Prefix,
+		TokenHash: entity.TokenHash,
+		ExpiresAt: entity.ExpiresAt,
+		RevokedAt: entity.RevokedAt,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func BoardShareLinkEntitiesToDomain(entities []entities.BoardShareLink) []domains.BoardShareLink {
+	return fp.Map(entities, BoardShareLinkEntityToDomain)
+}
+
+func BoardShareLinkDomainToEntity(model *domains.BoardShareLink) *entities.BoardShareLink {
+	return &entities.BoardShareLink{
+		BoardID:   model.BoardID,
+		CreatedBy: model.CreatedBy,
+		Prefix:    model.Prefix,
+		TokenHash: model.TokenHash,
+		ExpiresAt: model.ExpiresAt,
+	}
+}
@@ -14,10 +14,16 @@ func DomainToColumnEntity(column *domains.Column) *entities.Column {
 		IsFinal:       column.IsFinal,
 		OrderPosition: column.OrderPosition,
 		BoardID:       column.BoardID,
+		ArchivedAt:    column.ArchivedAt,
 	}
 }
 
 func ColumnEntityToDomain(entity *entities.Column) *domains.Column {
+	var board *domains.Board
+	if entity.Board != nil {
+		board = BoardEntityToDomain(entity.Board)
+	}
+
 	return &domains.Column{
 		ID:            entity.ID,
 		CreatedBy:     entity.CreatedBy,
@@ -25,6 +31,7 @@ func ColumnEntityToDomain(entity *entities.Column) *domains.Column {
 		IsFinal:       entity.IsFinal,
 		OrderPosition: entity.OrderPosition,
 		BoardID:       entity.BoardID,
-		Board:         BoardEntityToDomain(entity.Board),
+		ArchivedAt:    entity.ArchivedAt,
+		Board:         board,
 	}
 }
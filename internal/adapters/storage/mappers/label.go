@@ -0,0 +1,25 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"gorm.io/gorm"
+)
+
+func DomainToLabelEntity(model *domains.Label) *entities.Label {
+	return &entities.Label{
+		Model:   gorm.Model{ID: model.ID},
+		BoardID: model.BoardID,
+		Name:    model.Name,
+		Color:   model.Color,
+	}
+}
+
+func LabelEntityToDomain(entity *entities.Label) *domains.Label {
+	return &domains.Label{
+		ID:      entity.ID,
+		BoardID: entity.BoardID,
+		Name:    entity.Name,
+		Color:   entity.Color,
+	}
+}
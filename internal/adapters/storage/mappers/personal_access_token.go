@@ -0,0 +1,45 @@
+package mappers
+
+import (
+	"strings"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+func PersonalAccessTokenEntityToDomain(entity entities.PersonalAccessToken) domains.PersonalAccessToken {
+	var scopes []string
+	if entity.Scopes != "" {
+		scopes = strings.Split(entity.Scopes, ",")
+	}
+	return domains.PersonalAccessToken{
+		ID:         entity.ID,
+		UserID:     entity.UserID,
+		Name:       entity.Name,
+		Prefix:     entity.Prefix,
+		TokenHash:  entity.TokenHash,
+		Scopes:     scopes,
+		BoardID:    entity.BoardID,
+		ExpiresAt:  entity.ExpiresAt,
+		LastUsedAt: entity.LastUsedAt,
+		RevokedAt:  entity.RevokedAt,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
+
+func PersonalAccessTokenEntitiesToDomain(entities []entities.PersonalAccessToken) []domains.PersonalAccessToken {
+	return fp.Map(entities, PersonalAccessTokenEntityToDomain)
+}
+
+func PersonalAccessTokenDomainToEntity(model *domains.PersonalAccessToken) *entities.PersonalAccessToken {
+	return &entities.PersonalAccessToken{
+		UserID:    model.UserID,
+		Name:      model.Name,
+		Prefix:    model.Prefix,
+		TokenHash: model.TokenHash,
+		Scopes:    strings.Join(model.Scopes, ","),
+		BoardID:   model.BoardID,
+		ExpiresAt: model.ExpiresAt,
+	}
+}
@@ -0,0 +1,20 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+func RecoveryCodeEntityToDomain(entity entities.RecoveryCode) domains.RecoveryCode {
+	return domains.RecoveryCode{
+		ID:       entity.ID,
+		UserID:   entity.UserID,
+		CodeHash: entity.CodeHash,
+		UsedAt:   entity.UsedAt,
+	}
+}
+
+func RecoveryCodeEntitiesToDomain(entities []entities.RecoveryCode) []domains.RecoveryCode {
+	return fp.Map(entities, RecoveryCodeEntityToDomain)
+}
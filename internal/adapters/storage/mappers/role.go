@@ -14,7 +14,10 @@ func DomainToRoleEntity(role *domains.Role) *entities.Role {
 		},
 		Name:        role.Name,
 		Description: role.Description,
-		Weight:      role.Weight,
+		BoardID:     role.BoardID,
+		Permissions: fp.Map(role.Permissions, func(action domains.BoardAction) string {
+			return string(action)
+		}),
 	}
 }
 
@@ -23,7 +26,10 @@ func RoleEntityToDomain(entity *entities.Role) *domains.Role {
 		ID:          entity.ID,
 		Name:        entity.Name,
 		Description: entity.Description,
-		Weight:      entity.Weight,
+		BoardID:     entity.BoardID,
+		Permissions: fp.Map(entity.Permissions, func(permission string) domains.BoardAction {
+			return domains.BoardAction(permission)
+		}),
 	}
 }
 
@@ -0,0 +1,66 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+	"gorm.io/gorm"
+)
+
+func DomainToSavedViewEntity(model *domains.SavedView) *entities.SavedView {
+	return &entities.SavedView{
+		Model:   gorm.Model{ID: model.ID},
+		BoardID: model.BoardID,
+		UserID:  model.UserID,
+		Name:    model.Name,
+		Filter: entities.SavedViewFilter{
+			ColumnIDs:   model.Filter.ColumnIDs,
+			AssigneeIDs: model.Filter.AssigneeIDs,
+			Unassigned:  model.Filter.Unassigned,
+			SprintID:    model.Filter.SprintID,
+			Backlog:     model.Filter.Backlog,
+			Search:      model.Filter.Search,
+			DueFrom:     model.Filter.DueFrom,
+			DueTo:       model.Filter.DueTo,
+			GroupBy:     string(model.Filter.Sort.GroupBy),
+			SortField:   string(model.Filter.Sort.Field),
+			SortDesc:    model.Filter.Sort.Desc,
+		},
+		VisibleColumns: model.VisibleColumns,
+		IsShared:       model.IsShared,
+	}
+}
+
+func SavedViewEntityToDomain(entity *entities.SavedView) *domains.SavedView {
+	return &domains.SavedView{
+		ID:        entity.ID,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+		BoardID:   entity.BoardID,
+		UserID:    entity.UserID,
+		Name:      entity.Name,
+		Filter: domains.TaskFilter{
+			ColumnIDs:   entity.Filter.ColumnIDs,
+			AssigneeIDs: entity.Filter.AssigneeIDs,
+			Unassigned:  entity.Filter.Unassigned,
+			SprintID:    entity.Filter.SprintID,
+			Backlog:     entity.Filter.Backlog,
+			Search:      entity.Filter.Search,
+			DueFrom:     entity.Filter.DueFrom,
+			DueTo:       entity.Filter.DueTo,
+			Sort: domains.TaskSort{
+				GroupBy: domains.TaskGroupBy(entity.Filter.GroupBy),
+				Field:   domains.TaskSortField(entity.Filter.SortField),
+				Desc:    entity.Filter.SortDesc,
+			},
+		},
+		VisibleColumns: entity.VisibleColumns,
+		IsShared:       entity.IsShared,
+	}
+}
+
+func SavedViewEntitiesToDomain(viewEntities []entities.SavedView) []domains.SavedView {
+	return fp.Map(viewEntities, func(entity entities.SavedView) domains.SavedView {
+		return *SavedViewEntityToDomain(&entity)
+	})
+}
@@ -0,0 +1,61 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+	"gorm.io/gorm"
+)
+
+func DomainToSprintEntity(model *domains.Sprint) *entities.Sprint {
+	return &entities.Sprint{
+		Model:     gorm.Model{ID: model.ID},
+		BoardID:   model.BoardID,
+		CreatedBy: model.CreatedBy,
+		Name:      model.Name,
+		Goal:      model.Goal,
+		StartDate: model.StartDate,
+		EndDate:   model.EndDate,
+		State:     string(model.State),
+		StartedAt: model.StartedAt,
+		ClosedAt:  model.ClosedAt,
+	}
+}
+
+func SprintEntityToDomain(entity *entities.Sprint) *domains.Sprint {
+	return &domains.Sprint{
+		ID:        entity.ID,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+		BoardID:   entity.BoardID,
+		CreatedBy: entity.CreatedBy,
+		Name:      entity.Name,
+		Goal:      entity.Goal,
+		StartDate: entity.StartDate,
+		EndDate:   entity.EndDate,
+		State:     domains.SprintState(entity.State),
+		StartedAt: entity.StartedAt,
+		ClosedAt:  entity.ClosedAt,
+	}
+}
+
+func SprintEntitiesToDomain(sprintEntities []entities.Sprint) []domains.Sprint {
+	return fp.Map(sprintEntities, func(entity entities.Sprint) domains.Sprint {
+		return *SprintEntityToDomain(&entity)
+	})
+}
+
+func SprintTaskEntityToDomain(entity *entities.SprintTask) *domains.SprintTask {
+	return &domains.SprintTask{
+		SprintID:  entity.SprintID,
+		TaskID:    entity.TaskID,
+		CreatedAt: entity.CreatedAt,
+		RemovedAt: entity.RemovedAt,
+	}
+}
+
+func SprintTaskEntitiesToDomain(sprintTaskEntities []entities.SprintTask) []domains.SprintTask {
+	return fp.Map(sprintTaskEntities, func(entity entities.SprintTask) domains.SprintTask {
+		return *SprintTaskEntityToDomain(&entity)
+	})
+}
@@ -17,12 +17,14 @@ func DomainToTaskEntity(model *domains.Task) *entities.Task {
 		ParentID:      model.ParentID,
 		AssigneeID:    model.AssigneeID,
 		ColumnID:      model.ColumnID,
+		SprintID:      model.SprintID,
 		OrderPosition: model.OrderPosition,
 		Name:          model.Name,
 		Description:   model.Description,
 		StartDateTime: model.StartDateTime,
 		EndDateTime:   model.EndDateTime,
 		StoryPoint:    model.StoryPoint,
+		ArchivedAt:    model.ArchivedAt,
 	}
 }
 
@@ -48,12 +50,14 @@ func TaskEntityToDomain(entity *entities.Task) *domains.Task {
 		ParentID:      entity.ParentID,
 		AssigneeID:    entity.AssigneeID,
 		ColumnID:      entity.ColumnID,
+		SprintID:      entity.SprintID,
 		OrderPosition: entity.OrderPosition,
 		Name:          entity.Name,
 		Description:   entity.Description,
 		StartDateTime: entity.StartDateTime,
 		EndDateTime:   entity.EndDateTime,
 		StoryPoint:    entity.StoryPoint,
+		ArchivedAt:    entity.ArchivedAt,
 
 		Board:   BoardEntityToDomain(&entity.Board),
 		Creator: UserEntityToDomain(&entity.Creator),
@@ -118,6 +122,8 @@ func TaskCommentEntityToDomain(entity *entities.TaskComment) *domains.TaskCommen
 		ID:        entity.ID,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
+		UserID:    entity.UserID,
+		TaskID:    entity.TaskID,
 		Comment:   entity.Comment,
 		User:      UserEntityToDomain(&entity.User),
 	}
@@ -128,3 +134,33 @@ func TaskCommentEntitiesToDomain(taskEntities []entities.TaskComment) []domains.
 		return *TaskCommentEntityToDomain(&entity)
 	})
 }
+
+func DomainToTaskColumnTransitionEntity(model *domains.TaskColumnTransition) *entities.TaskColumnTransition {
+	return &entities.TaskColumnTransition{
+		ID:           model.ID,
+		CreatedAt:    model.CreatedAt,
+		TaskID:       model.TaskID,
+		BoardID:      model.BoardID,
+		UserID:       model.UserID,
+		FromColumnID: model.FromColumnID,
+		ToColumnID:   model.ToColumnID,
+	}
+}
+
+func TaskColumnTransitionEntityToDomain(entity *entities.TaskColumnTransition) *domains.TaskColumnTransition {
+	return &domains.TaskColumnTransition{
+		ID:           entity.ID,
+		CreatedAt:    entity.CreatedAt,
+		TaskID:       entity.TaskID,
+		BoardID:      entity.BoardID,
+		UserID:       entity.UserID,
+		FromColumnID: entity.FromColumnID,
+		ToColumnID:   entity.ToColumnID,
+	}
+}
+
+func TaskColumnTransitionEntitiesToDomain(transitionEntities []entities.TaskColumnTransition) []domains.TaskColumnTransition {
+	return fp.Map(transitionEntities, func(entity entities.TaskColumnTransition) domains.TaskColumnTransition {
+		return *TaskColumnTransitionEntityToDomain(&entity)
+	})
+}
@@ -0,0 +1,87 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+	"gorm.io/gorm"
+)
+
+func TeamEntityToDomain(entity entities.Team) domains.Team {
+	return domains.Team{
+		ID:          entity.ID,
+		WorkspaceID: entity.WorkspaceID,
+		Name:        entity.Name,
+		CreatedBy:   entity.CreatedBy,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+func TeamEntitiesToDomain(entities []entities.Team) []domains.Team {
+	return fp.Map(entities, TeamEntityToDomain)
+}
+
+func TeamDomainToEntity(model *domains.Team) *entities.Team {
+	return &entities.Team{
+		Model:       gorm.Model{ID: model.ID},
+		WorkspaceID: model.WorkspaceID,
+		Name:        model.Name,
+		CreatedBy:   model.CreatedBy,
+	}
+}
+
+func TeamMemberEntityToDomain(entity entities.TeamMember) domains.TeamMember {
+	member := domains.TeamMember{
+		ID:        entity.ID,
+		TeamID:    entity.TeamID,
+		UserID:    entity.UserID,
+		CreatedAt: entity.CreatedAt,
+	}
+	if entity.User.ID != 0 {
+		member.User = UserEntityToDomain(&entity.User)
+	}
+	return member
+}
+
+func TeamMemberEntitiesToDomain(entities []entities.TeamMember) []domains.TeamMember {
+	return fp.Map(entities, TeamMemberEntityToDomain)
+}
+
+func TeamMemberDomainToEntity(model *domains.TeamMember) *entities.TeamMember {
+	return &entities.TeamMember{
+		Model:  gorm.Model{ID: model.ID},
+		TeamID: model.TeamID,
+		UserID: model.UserID,
+	}
+}
+
+func BoardTeamEntityToDomain(entity entities.BoardTeam) domains.BoardTeam {
+	boardTeam := domains.BoardTeam{
+		ID:        entity.ID,
+		BoardID:   entity.BoardID,
+		TeamID:    entity.TeamID,
+		RoleID:    entity.RoleID,
+		CreatedAt: entity.CreatedAt,
+	}
+	if entity.Team.ID != 0 {
+		team := TeamEntityToDomain(entity.Team)
+		boardTeam.Team = &team
+	}
+	if entity.Role.ID != 0 {
+		boardTeam.Role = RoleEntityToDomain(&entity.Role)
+	}
+	return boardTeam
+}
+
+func BoardTeamEntitiesToDomain(entities []entities.BoardTeam) []domains.BoardTeam {
+	return fp.Map(entities, BoardTeamEntityToDomain)
+}
+
+func BoardTeamDomainToEntity(model *domains.BoardTeam) *entities.BoardTeam {
+	return &entities.BoardTeam{
+		Model:   gorm.Model{ID: model.ID},
+		BoardID: model.BoardID,
+		TeamID:  model.TeamID,
+		RoleID:  model.RoleID,
+	}
+}
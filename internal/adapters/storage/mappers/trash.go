@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+)
+
+func deleterToDomain(deleter *entities.User) *domains.User {
+	if deleter == nil {
+		return nil
+	}
+	return UserEntityToDomain(deleter)
+}
+
+func TaskEntityToTrashItem(entity *entities.Task) domains.TrashItem {
+	return domains.TrashItem{
+		ID:        entity.ID,
+		Type:      domains.TrashItemTask,
+		BoardID:   entity.BoardID,
+		Name:      entity.Name,
+		DeletedAt: entity.DeletedAt.Time,
+		DeletedBy: deleterToDomain(entity.Deleter),
+	}
+}
+
+func TaskEntitiesToTrashItems(taskEntities []entities.Task) []domains.TrashItem {
+	return fp.Map(taskEntities, func(entity entities.Task) domains.TrashItem {
+		return TaskEntityToTrashItem(&entity)
+	})
+}
+
+func ColumnEntityToTrashItem(entity *entities.Column) domains.TrashItem {
+	return domains.TrashItem{
+		ID:        entity.ID,
+		Type:      domains.TrashItemColumn,
+		BoardID:   entity.BoardID,
+		Name:      entity.Name,
+		DeletedAt: entity.DeletedAt.Time,
+		DeletedBy: deleterToDomain(entity.Deleter),
+	}
+}
+
+func ColumnEntitiesToTrashItems(columnEntities []entities.Column) []domains.TrashItem {
+	return fp.Map(columnEntities, func(entity entities.Column) domains.TrashItem {
+		return ColumnEntityToTrashItem(&entity)
+	})
+}
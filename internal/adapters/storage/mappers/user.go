@@ -3,25 +3,42 @@ package mappers
 import (
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
-	"github.com/GoBootCamp-Group1/Task-Management/pkg/utils"
 	"gorm.io/gorm"
 )
 
 func UserEntityToDomain(entity *entities.User) *domains.User {
 	return &domains.User{
-		ID:       entity.ID,
-		Name:     entity.Name,
-		Email:    entity.Email,
-		Password: entity.Password,
-		Role:     domains.UserRole(entity.Role),
+		ID:                    entity.ID,
+		Name:                  entity.Name,
+		Email:                 entity.Email,
+		Password:              entity.Password,
+		Role:                  domains.UserRole(entity.Role),
+		EmailVerifiedAt:       entity.EmailVerifiedAt,
+		AvatarURL:             entity.AvatarURL,
+		Timezone:              entity.Timezone,
+		Locale:                entity.Locale,
+		MFASecret:             entity.MFASecret,
+		MFAEnabledAt:          entity.MFAEnabledAt,
+		DisabledAt:            entity.DisabledAt,
+		PasswordResetRequired: entity.PasswordResetRequired,
+		CreatedAt:             entity.CreatedAt,
 	}
 }
 
 func DomainToUserEntity(model *domains.User) *entities.User {
 	return &entities.User{
-		Model:    gorm.Model{ID: model.ID},
-		Name:     model.Name,
-		Email:    model.Email,
-		Password: utils.HashPassword(model.Password),
+		Model:                 gorm.Model{ID: model.ID},
+		Name:                  model.Name,
+		Email:                 model.Email,
+		Password:              model.Password,
+		Role:                  uint8(model.Role),
+		EmailVerifiedAt:       model.EmailVerifiedAt,
+		AvatarURL:             model.AvatarURL,
+		Timezone:              model.Timezone,
+		Locale:                model.Locale,
+		MFASecret:             model.MFASecret,
+		MFAEnabledAt:          model.MFAEnabledAt,
+		DisabledAt:            model.DisabledAt,
+		PasswordResetRequired: model.PasswordResetRequired,
 	}
 }
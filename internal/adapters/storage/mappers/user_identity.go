@@ -0,0 +1,25 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+func UserIdentityEntityToDomain(entity *entities.UserIdentity) *domains.UserIdentity {
+	return &domains.UserIdentity{
+		ID:       entity.ID,
+		UserID:   entity.UserID,
+		Provider: entity.Provider,
+		Subject:  entity.Subject,
+		Email:    entity.Email,
+	}
+}
+
+func UserIdentityDomainToEntity(model *domains.UserIdentity) *entities.UserIdentity {
+	return &entities.UserIdentity{
+		UserID:   model.UserID,
+		Provider: model.Provider,
+		Subject:  model.Subject,
+		Email:    model.Email,
+	}
+}
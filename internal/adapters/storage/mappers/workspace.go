@@ -0,0 +1,56 @@
+package mappers
+
+import (
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/fp"
+	"gorm.io/gorm"
+)
+
+func WorkspaceEntityToDomain(entity entities.Workspace) domains.Workspace {
+	return domains.Workspace{
+		ID:        entity.ID,
+		Name:      entity.Name,
+		CreatedBy: entity.CreatedBy,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func WorkspaceEntitiesToDomain(entities []entities.Workspace) []domains.Workspace {
+	return fp.Map(entities, WorkspaceEntityToDomain)
+}
+
+func WorkspaceDomainToEntity(model *domains.Workspace) *entities.Workspace {
+	return &entities.Workspace{
+		Model:     gorm.Model{ID: model.ID},
+		Name:      model.Name,
+		CreatedBy: model.CreatedBy,
+	}
+}
+
+func WorkspaceMemberEntityToDomain(entity entities.WorkspaceMember) domains.WorkspaceMember {
+	member := domains.WorkspaceMember{
+		ID:          entity.ID,
+		WorkspaceID: entity.WorkspaceID,
+		UserID:      entity.UserID,
+		Role:        domains.WorkspaceRole(entity.Role),
+		CreatedAt:   entity.CreatedAt,
+	}
+	if entity.User.ID != 0 {
+		member.User = UserEntityToDomain(&entity.User)
+	}
+	return member
+}
+
+func WorkspaceMemberEntitiesToDomain(entities []entities.WorkspaceMember) []domains.WorkspaceMember {
+	return fp.Map(entities, WorkspaceMemberEntityToDomain)
+}
+
+func WorkspaceMemberDomainToEntity(model *domains.WorkspaceMember) *entities.WorkspaceMember {
+	return &entities.WorkspaceMember{
+		Model:       gorm.Model{ID: model.ID},
+		WorkspaceID: model.WorkspaceID,
+		UserID:      model.UserID,
+		Role:        string(model.Role),
+	}
+}
@@ -26,7 +26,7 @@ func NewNotificationRepo(db *gorm.DB) ports.NotificationRepo {
 
 func (r *notificationRepo) GetByID(ctx context.Context, id string) (*domains.Notification, error) {
 	var n entities.Notification
-	err := r.db.WithContext(ctx).Model(&entities.Notification{}).Where("id = ?", id).First(&n).Error
+	err := withTx(ctx, r.db).Model(&entities.Notification{}).Where("id = ?", id).First(&n).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, "Notification not found!")
@@ -42,7 +42,7 @@ func (r *notificationRepo) Read(ctx context.Context, notification *domains.Notif
 		Time:  time.Now(),
 		Valid: true,
 	}
-	err := r.db.WithContext(ctx).Save(&n).Error
+	err := withTx(ctx, r.db).Save(&n).Error
 
 	if err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
@@ -54,7 +54,7 @@ func (r *notificationRepo) Read(ctx context.Context, notification *domains.Notif
 func (r *notificationRepo) UnRead(ctx context.Context, notification *domains.Notification) (*domains.Notification, error) {
 	n := mappers.DomainToNotificationEntity(notification)
 	n.ReadAt = sql.NullTime{Valid: false}
-	err := r.db.WithContext(ctx).Save(&n).Error
+	err := withTx(ctx, r.db).Save(&n).Error
 
 	if err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
@@ -65,7 +65,7 @@ func (r *notificationRepo) UnRead(ctx context.Context, notification *domains.Not
 
 func (r *notificationRepo) Delete(ctx context.Context, notification *domains.Notification) error {
 	n := mappers.DomainToNotificationEntity(notification)
-	if err := r.db.WithContext(ctx).Delete(&n).Error; err != nil {
+	if err := withTx(ctx, r.db).Delete(&n).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
@@ -74,7 +74,7 @@ func (r *notificationRepo) Delete(ctx context.Context, notification *domains.Not
 func (r *notificationRepo) GetList(ctx context.Context, userID uint, limit uint, offset uint) ([]domains.Notification, uint, error) {
 	var notificationEntities []entities.Notification
 
-	query := r.db.WithContext(ctx).
+	query := withTx(ctx, r.db).
 		Model(&entities.Notification{}).
 		Where("user_id = ?", userID).
 		Preload("User")
@@ -109,7 +109,7 @@ func (r *notificationRepo) GetList(ctx context.Context, userID uint, limit uint,
 func (r *notificationRepo) GetUnreadList(ctx context.Context, userID uint, limit uint, offset uint) ([]domains.Notification, uint, error) {
 	var notificationEntities []entities.Notification
 
-	query := r.db.WithContext(ctx).
+	query := withTx(ctx, r.db).
 		Model(&entities.Notification{}).
 		Where("read_at IS NULL").
 		Where("user_id = ?", userID).
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPersonalAccessTokenNotFound = "personal access token not found"
+)
+
+type personalAccessTokenRepo struct {
+	db *gorm.DB
+}
+
+func NewPersonalAccessTokenRepo(db *gorm.DB) ports.PersonalAccessTokenRepo {
+	return &personalAccessTokenRepo{
+		db: db,
+	}
+}
+
+func (r *personalAccessTokenRepo) Create(ctx context.Context, token *domains.PersonalAccessToken) error {
+	entity := mappers.PersonalAccessTokenDomainToEntity(token)
+	if err := withTx(ctx, r.db).Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	token.ID = entity.ID
+	token.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *personalAccessTokenRepo) GetByHash(ctx context.Context, tokenHash string) (*domains.PersonalAccessToken, error) {
+	var entity entities.PersonalAccessToken
+	err := withTx(ctx, r.db).Model(&entities.PersonalAccessToken{}).Where("token_hash = ?", tokenHash).First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrPersonalAccessTokenNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	token := mappers.PersonalAccessTokenEntityToDomain(entity)
+	return &token, nil
+}
+
+func (r *personalAccessTokenRepo) GetByUserID(ctx context.Context, userID uint) ([]domains.PersonalAccessToken, error) {
+	var tokenEntities []entities.PersonalAccessToken
+	err := withTx(ctx, r.db).Model(&entities.PersonalAccessToken{}).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Find(&tokenEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.PersonalAccessTokenEntitiesToDomain(tokenEntities), nil
+}
+
+func (r *personalAccessTokenRepo) Revoke(ctx context.Context, userID uint, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.PersonalAccessToken{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrPersonalAccessTokenNotFound)
+	}
+	return nil
+}
+
+func (r *personalAccessTokenRepo) RevokeByUserID(ctx context.Context, userID uint) error {
+	err := withTx(ctx, r.db).Model(&entities.PersonalAccessToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+func (r *personalAccessTokenRepo) TouchLastUsed(ctx context.Context, id uint, usedAt time.Time) error {
+	err := withTx(ctx, r.db).Model(&entities.PersonalAccessToken{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
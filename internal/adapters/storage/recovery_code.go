@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type recoveryCodeRepo struct {
+	db *gorm.DB
+}
+
+func NewRecoveryCodeRepo(db *gorm.DB) ports.RecoveryCodeRepo {
+	return &recoveryCodeRepo{
+		db: db,
+	}
+}
+
+func (r *recoveryCodeRepo) Replace(ctx context.Context, userID uint, codeHashes []string) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("user_id = ?", userID).Delete(&entities.RecoveryCode{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		codes := make([]entities.RecoveryCode, 0, len(codeHashes))
+		for _, hash := range codeHashes {
+			codes = append(codes, entities.RecoveryCode{UserID: userID, CodeHash: hash})
+		}
+		if len(codes) == 0 {
+			return nil
+		}
+		if err := tx.Create(&codes).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
+}
+
+func (r *recoveryCodeRepo) GetUnused(ctx context.Context, userID uint) ([]domains.RecoveryCode, error) {
+	var codes []entities.RecoveryCode
+	err := withTx(ctx, r.db).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Find(&codes).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.RecoveryCodeEntitiesToDomain(codes), nil
+}
+
+func (r *recoveryCodeRepo) MarkUsed(ctx context.Context, id uint) (bool, error) {
+	// the used_at condition makes concurrent attempts with the same code use it only once
+	result := withTx(ctx, r.db).Model(&entities.RecoveryCode{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *recoveryCodeRepo) CountUnused(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := withTx(ctx, r.db).Model(&entities.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return count, nil
+}
+
+func (r *recoveryCodeRepo) DeleteByUserID(ctx context.Context, userID uint) error {
+	if err := withTx(ctx, r.db).Unscoped().Where("user_id = ?", userID).Delete(&entities.RecoveryCode{}).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"gorm.io/gorm"
+)
+
+type retentionRepo struct {
+	db *gorm.DB
+}
+
+func NewRetentionRepo(db *gorm.DB) ports.RetentionRepo {
+	return &retentionRepo{
+		db: db,
+	}
+}
+
+// PurgeDeletedBefore hard-deletes boards, columns and tasks soft-deleted before
+// the given time, together with everything that belongs to them. Columns and
+// tasks of a purged board go with it, as do tasks of a purged column.
+func (r *retentionRepo) PurgeDeletedBefore(ctx context.Context, before time.Time) (domains.PurgeResult, error) {
+	var result domains.PurgeResult
+
+	err := withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var boardIDs, columnIDs, taskIDs []uint
+
+		if err := tx.Unscoped().Model(&entities.Board{}).
+			Where("deleted_at < ?", before).
+			Pluck("id", &boardIDs).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&entities.Column{}).
+			Where("deleted_at < ? OR board_id IN ?", before, nullableIDs(boardIDs)).
+			Pluck("id", &columnIDs).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&entities.Task{}).
+			Where("deleted_at < ? OR board_id IN ? OR column_id IN ?", before, nullableIDs(boardIDs), nullableIDs(columnIDs)).
+			Pluck("id", &taskIDs).Error; err != nil {
+			return err
+		}
+
+		if len(taskIDs) > 0 {
+			if err := purgeTasks(tx, taskIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(columnIDs) > 0 {
+			if err := tx.Unscoped().Where("id IN ?", columnIDs).Delete(&entities.Column{}).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(boardIDs) > 0 {
+			if err := purgeBoards(tx, boardIDs); err != nil {
+				return err
+			}
+		}
+
+		result = domains.PurgeResult{
+			Boards:  int64(len(boardIDs)),
+			Columns: int64(len(columnIDs)),
+			Tasks:   int64(len(taskIDs)),
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+func purgeTasks(tx *gorm.DB, taskIDs []uint) error {
+	// children outlive their purged parent as top-level tasks
+	if err := tx.Unscoped().Model(&entities.Task{}).
+		Where("parent_id IN ?", taskIDs).
+		Update("parent_id", nil).Error; err != nil {
+		return err
+	}
+
+	deletes := []struct {
+		model interface{}
+		query string
+	}{
+		{&entities.TaskComment{}, "task_id IN @ids"},
+		{&entities.TaskDependency{}, "task_id IN @ids OR dependent_task_id IN @ids"},
+		{&entities.TaskColumnTransition{}, "task_id IN @ids"},
+		{&entities.SprintTask{}, "task_id IN @ids"},
+		{&entities.TaskLabel{}, "task_id IN @ids"},
+		{&entities.Task{}, "id IN @ids"},
+	}
+	for _, d := range deletes {
+		if err := tx.Unscoped().Where(d.query, sql.Named("ids", taskIDs)).Delete(d.model).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func purgeBoards(tx *gorm.DB, boardIDs []uint) error {
+	// tokens restricted to a purged board are revoked before the restriction
+	// goes, a token without a board would be valid for every board
+	if err := tx.Unscoped().Model(&entities.PersonalAccessToken{}).
+		Where("board_id IN ?", boardIDs).
+		Updates(map[string]interface{}{
+			"revoked_at": gorm.Expr("COALESCE(revoked_at, ?)", time.Now()),
+			"board_id":   nil,
+		}).Error; err != nil {
+		return err
+	}
+
+	models := []interface{}{
+		&entities.TaskColumnTransition{},
+		&entities.Sprint{},
+		&entities.SavedView{},
+		&entities.Label{},
+		&entities.BoardMember{},
+		&entities.BoardInvitation{},
+		&entities.BoardShareLink{},
+		&entities.BoardTeam{},
+	}
+	for _, model := range models {
+		if err := tx.Unscoped().Where("board_id IN ?", boardIDs).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Unscoped().Where("id IN ?", boardIDs).Delete(&entities.Board{}).Error
+}
+
+// nullableIDs keeps an IN clause valid when there is nothing to match.
+func nullableIDs(ids []uint) []uint {
+	if len(ids) == 0 {
+		return []uint{0}
+	}
+	return ids
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// recordingDriver answers every id lookup from ids and records the statements
+// that change data, so the purge can be checked without a database.
+type recordingDriver struct {
+	ids  map[string][]int64
+	exec *[]string
+}
+
+func (d recordingDriver) Open(string) (driver.Conn, error) { return recordingConn(d), nil }
+
+type recordingConn recordingDriver
+
+func (c recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return recordingStmt{conn: c, query: query}, nil
+}
+
+func (recordingConn) Close() error { return nil }
+
+func (c recordingConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (recordingConn) Commit() error { return nil }
+
+func (recordingConn) Rollback() error { return nil }
+
+type recordingStmt struct {
+	conn  recordingConn
+	query string
+}
+
+func (recordingStmt) Close() error { return nil }
+
+func (recordingStmt) NumInput() int { return -1 }
+
+func (s recordingStmt) Exec([]driver.Value) (driver.Result, error) {
+	*s.conn.exec = append(*s.conn.exec, s.query)
+	return driver.RowsAffected(0), nil
+}
+
+var selectTable = regexp.MustCompile(`FROM "(\w+)"`)
+
+func (s recordingStmt) Query([]driver.Value) (driver.Rows, error) {
+	var ids []int64
+	if match := selectTable.FindStringSubmatch(s.query); match != nil {
+		ids = s.conn.ids[match[1]]
+	}
+	return &idRows{ids: ids}, nil
+}
+
+type idRows struct {
+	ids []int64
+}
+
+func (*idRows) Columns() []string { return []string{"id"} }
+
+func (*idRows) Close() error { return nil }
+
+func (r *idRows) Next(dest []driver.Value) error {
+	if len(r.ids) == 0 {
+		return io.EOF
+	}
+	dest[0], r.ids = r.ids[0], r.ids[1:]
+	return nil
+}
+
+func TestPurgeDeletedBefore(t *testing.T) {
+	var exec []string
+	sql.Register("purge-recorder", recordingDriver{
+		ids: map[string][]int64{
+			"boards":  {1},
+			"columns": {10},
+			"tasks":   {100},
+		},
+		exec: &exec,
+	})
+	conn, err := sql.Open("purge-recorder", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewRetentionRepo(db).PurgeDeletedBefore(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Boards != 1 || result.Columns != 1 || result.Tasks != 1 {
+		t.Errorf("expected one board, column and task to be purged, got %+v", result)
+	}
+
+	statement := func(prefix string) int {
+		return slices.IndexFunc(exec, func(query string) bool {
+			return strings.HasPrefix(query, prefix)
+		})
+	}
+
+	boards := statement(`DELETE FROM "boards"`)
+	if boards != len(exec)-1 {
+		t.Fatalf("expected the boards to be deleted last, got %q", exec)
+	}
+
+	// every row that references a purged board has to go first, or its
+	// foreign key makes the delete fail
+	referencing := []string{
+		`DELETE FROM "task_comments"`,
+		`DELETE FROM "tasks"`,
+		`DELETE FROM "columns"`,
+		`DELETE FROM "task_column_transitions"`,
+		`DELETE FROM "sprints"`,
+		`DELETE FROM "saved_views"`,
+		`DELETE FROM "labels"`,
+		`DELETE FROM "board_users"`,
+		`DELETE FROM "board_invitations"`,
+		`DELETE FROM "board_share_links"`,
+		`DELETE FROM "board_teams"`,
+		`UPDATE "personal_access_tokens" SET "board_id"=$1,"revoked_at"=COALESCE(revoked_at, $2)`,
+	}
+	for _, prefix := range referencing {
+		if i := statement(prefix); i < 0 || i > boards {
+			t.Errorf("expected %s before the boards are deleted, got %q", prefix, exec)
+		}
+	}
+}
@@ -29,8 +29,15 @@ var (
 
 func (r *roleRepository) Create(ctx context.Context, role *domains.Role) error {
 	// Check if the role already exists
+	// custom roles can not shadow the roles available on every board
 	var existingRole entities.Role
-	err := r.db.WithContext(ctx).Model(&entities.Role{}).Where("name = ?", role.Name).First(&existingRole).Error
+	query := withTx(ctx, r.db).Model(&entities.Role{}).Where("name = ?", role.Name)
+	if role.BoardID != nil {
+		query = query.Where("board_id IS NULL OR board_id = ?", *role.BoardID)
+	} else {
+		query = query.Where("board_id IS NULL")
+	}
+	err := query.First(&existingRole).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -39,7 +46,7 @@ func (r *roleRepository) Create(ctx context.Context, role *domains.Role) error {
 	}
 
 	// Use transaction for creating the role
-	return r.db.Transaction(func(tx *gorm.DB) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		entity := mappers.DomainToRoleEntity(role)
 
 		if err := tx.WithContext(ctx).Create(entity).Error; err != nil {
@@ -52,7 +59,7 @@ func (r *roleRepository) Create(ctx context.Context, role *domains.Role) error {
 
 func (r *roleRepository) GetByID(ctx context.Context, id uint) (*domains.Role, error) {
 	var entity entities.Role
-	if err := r.db.WithContext(ctx).First(&entity, id).Error; err != nil {
+	if err := withTx(ctx, r.db).First(&entity, id).Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return mappers.RoleEntityToDomain(&entity), nil
@@ -60,7 +67,7 @@ func (r *roleRepository) GetByID(ctx context.Context, id uint) (*domains.Role, e
 
 func (r *roleRepository) GetAll(ctx context.Context) ([]domains.Role, error) {
 	var roleEntities []entities.Role
-	if err := r.db.WithContext(ctx).Find(&roleEntities).Error; err != nil {
+	if err := withTx(ctx, r.db).Find(&roleEntities).Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return mappers.RoleEntitiesToDomain(roleEntities), nil
@@ -69,23 +76,24 @@ func (r *roleRepository) GetAll(ctx context.Context) ([]domains.Role, error) {
 func (r *roleRepository) Update(ctx context.Context, role *domains.Role) error {
 	// Check if the role exists
 	var existingRole entities.Role
-	if err := r.db.WithContext(ctx).Model(&entities.Role{}).Where("id = ?", role.ID).First(&existingRole).Error; err != nil {
+	if err := withTx(ctx, r.db).Model(&entities.Role{}).Where("id = ?", role.ID).First(&existingRole).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
 	// Update fields
 	existingRole.Name = role.Name
 	existingRole.Description = role.Description
+	existingRole.Permissions = mappers.DomainToRoleEntity(role).Permissions
 
 	// Save updated role
-	if err := r.db.WithContext(ctx).Save(&existingRole).Error; err != nil {
+	if err := withTx(ctx, r.db).Save(&existingRole).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
 }
 
 func (r *roleRepository) Delete(ctx context.Context, id uint) error {
-	if err := r.db.WithContext(ctx).Delete(&entities.Role{}, id).Error; err != nil {
+	if err := withTx(ctx, r.db).Delete(&entities.Role{}, id).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
@@ -93,7 +101,7 @@ func (r *roleRepository) Delete(ctx context.Context, id uint) error {
 
 func (r *roleRepository) GetByName(ctx context.Context, name string) (*domains.Role, error) {
 	var entity entities.Role
-	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&entity).Error; err != nil {
+	if err := withTx(ctx, r.db).Where("name = ? AND board_id IS NULL", name).First(&entity).Error; err != nil {
 
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -104,3 +112,53 @@ func (r *roleRepository) GetByName(ctx context.Context, name string) (*domains.R
 
 	return mappers.RoleEntityToDomain(&entity), nil
 }
+
+// GetBoardRoleByName finds a role that can be granted on the board, custom
+// roles of the board come before the roles available on every board.
+func (r *roleRepository) GetBoardRoleByName(ctx context.Context, boardID uint, name string) (*domains.Role, error) {
+	var entity entities.Role
+	err := withTx(ctx, r.db).
+		Where("name = ? AND (board_id IS NULL OR board_id = ?)", name, boardID).
+		Order("board_id IS NULL").
+		First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrRoleNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.RoleEntityToDomain(&entity), nil
+}
+
+func (r *roleRepository) GetBoardRoles(ctx context.Context, boardID uint) ([]domains.Role, error) {
+	var roleEntities []entities.Role
+	err := withTx(ctx, r.db).
+		Where("board_id IS NULL OR board_id = ?", boardID).
+		Order("id").
+		Find(&roleEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.RoleEntitiesToDomain(roleEntities), nil
+}
+
+// InUse also counts soft-deleted grants, which come back when their board is
+// restored from the trash.
+func (r *roleRepository) InUse(ctx context.Context, id uint) (bool, error) {
+	queries := []*gorm.DB{
+		withTx(ctx, r.db).Unscoped().Model(&entities.BoardMember{}).Where("role_id = ?", id),
+		withTx(ctx, r.db).Unscoped().Model(&entities.BoardTeam{}).Where("role_id = ?", id),
+		withTx(ctx, r.db).Unscoped().Model(&entities.BoardInvitation{}).
+			Where("role_id = ? AND status = ?", id, string(domains.InvitationPending)),
+	}
+	for _, query := range queries {
+		var count int64
+		if err := query.Count(&count).Error; err != nil {
+			return false, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type savedViewRepo struct {
+	db *gorm.DB
+}
+
+func NewSavedViewRepo(db *gorm.DB) ports.SavedViewRepo {
+	return &savedViewRepo{
+		db: db,
+	}
+}
+
+var (
+	ErrSavedViewNotFound = "Saved view not found"
+)
+
+func (r *savedViewRepo) Create(ctx context.Context, view *domains.SavedView) error {
+	entity := mappers.DomainToSavedViewEntity(view)
+	if err := r.db.WithContext(ctx).Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	view.ID = entity.ID
+	view.CreatedAt = entity.CreatedAt
+	view.UpdatedAt = entity.UpdatedAt
+	return nil
+}
+
+func (r *savedViewRepo) GetByID(ctx context.Context, id uint) (*domains.SavedView, error) {
+	var view entities.SavedView
+	err := r.db.WithContext(ctx).Model(&entities.SavedView{}).Where("id = ?", id).First(&view).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrSavedViewNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.SavedViewEntityToDomain(&view), nil
+}
+
+func (r *savedViewRepo) Update(ctx context.Context, view *domains.SavedView) error {
+	var existingView *entities.SavedView
+	err := r.db.WithContext(ctx).Model(&entities.SavedView{}).Where("id = ?", view.ID).First(&existingView).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, ErrSavedViewNotFound)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	updated := mappers.DomainToSavedViewEntity(view)
+	existingView.Name = updated.Name
+	existingView.Filter = updated.Filter
+	existingView.VisibleColumns = updated.VisibleColumns
+	existingView.IsShared = updated.IsShared
+
+	if err = r.db.WithContext(ctx).Save(&existingView).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	view.UpdatedAt = existingView.UpdatedAt
+	return nil
+}
+
+func (r *savedViewRepo) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Delete(&entities.SavedView{}, id).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+func (r *savedViewRepo) GetListByBoardID(ctx context.Context, boardID uint, userID uint) ([]domains.SavedView, error) {
+	var viewEntities []entities.SavedView
+	err := r.db.WithContext(ctx).
+		Where("board_id = ? AND (user_id = ? OR is_shared = ?)", boardID, userID, true).
+		Order("name ASC").
+		Find(&viewEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.SavedViewEntitiesToDomain(viewEntities), nil
+}
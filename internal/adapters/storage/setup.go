@@ -17,8 +17,44 @@ func NewPostgresGormConnection(dbConfig config.DB) (*gorm.DB, error) {
 func Migrate(db *gorm.DB) {
 	migrator := db.Migrator()
 
-	err := migrator.AutoMigrate(&entities.User{})
+	err := migrator.AutoMigrate(
+		&entities.User{},
+		&entities.Sprint{},
+		&entities.SprintTask{},
+		&entities.TaskColumnTransition{},
+		&entities.SavedView{},
+		&entities.Label{},
+		&entities.TaskLabel{},
+		&entities.RecoveryCode{},
+		&entities.UserIdentity{},
+		&entities.PersonalAccessToken{},
+		&entities.BoardInvitation{},
+		&entities.BoardShareLink{},
+		&entities.Workspace{},
+		&entities.WorkspaceMember{},
+		&entities.Team{},
+		&entities.TeamMember{},
+		&entities.BoardTeam{},
+		&entities.AuditLog{},
+	)
 	if err != nil {
 		panic("migration failed")
 	}
+
+	// columns added to tables created by Task-manager.sql
+	addMissingColumns(migrator, &entities.Task{}, "SprintID", "ArchivedAt", "DeletedBy")
+	addMissingColumns(migrator, &entities.Column{}, "ArchivedAt", "DeletedBy")
+	addMissingColumns(migrator, &entities.Board{}, "ArchivedAt", "DeletedBy", "WorkspaceID")
+	addMissingColumns(migrator, &entities.Role{}, "BoardID", "Permissions")
+}
+
+func addMissingColumns(migrator gorm.Migrator, model any, fields ...string) {
+	for _, field := range fields {
+		if migrator.HasColumn(model, field) {
+			continue
+		}
+		if err := migrator.AddColumn(model, field); err != nil {
+			panic("migration failed")
+		}
+	}
 }
@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type sprintRepo struct {
+	db *gorm.DB
+}
+
+func NewSprintRepo(db *gorm.DB) ports.SprintRepo {
+	return &sprintRepo{
+		db: db,
+	}
+}
+
+var (
+	ErrSprintNotFound = "Sprint not found"
+)
+
+func (r *sprintRepo) Create(ctx context.Context, sprint *domains.Sprint) error {
+	entity := mappers.DomainToSprintEntity(sprint)
+	if err := r.db.WithContext(ctx).Create(&entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	sprint.ID = entity.ID
+	sprint.CreatedAt = entity.CreatedAt
+	sprint.UpdatedAt = entity.UpdatedAt
+	return nil
+}
+
+func (r *sprintRepo) GetByID(ctx context.Context, id uint) (*domains.Sprint, error) {
+	var sprint entities.Sprint
+	err := r.db.WithContext(ctx).Model(&entities.Sprint{}).Where("id = ?", id).First(&sprint).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrSprintNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.SprintEntityToDomain(&sprint), nil
+}
+
+func (r *sprintRepo) Update(ctx context.Context, sprint *domains.Sprint) error {
+	var existingSprint *entities.Sprint
+	err := r.db.WithContext(ctx).Model(&entities.Sprint{}).Where("id = ?", sprint.ID).First(&existingSprint).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, ErrSprintNotFound)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	existingSprint.Name = sprint.Name
+	existingSprint.Goal = sprint.Goal
+	existingSprint.StartDate = sprint.StartDate
+	existingSprint.EndDate = sprint.EndDate
+	existingSprint.State = string(sprint.State)
+	existingSprint.StartedAt = sprint.StartedAt
+	existingSprint.ClosedAt = sprint.ClosedAt
+
+	if err := r.db.WithContext(ctx).Save(&existingSprint).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+func (r *sprintRepo) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		//move tasks of the sprint back to the backlog
+		if err := tx.Model(&entities.Task{}).Where("sprint_id = ?", id).Update("sprint_id", nil).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Where("sprint_id = ?", id).Delete(&entities.SprintTask{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Delete(&entities.Sprint{}, id).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
+}
+
+func (r *sprintRepo) GetListByBoardID(ctx context.Context, boardID uint) ([]domains.Sprint, error) {
+	var sprintEntities []entities.Sprint
+	err := r.db.WithContext(ctx).
+		Model(&entities.Sprint{}).
+		Where("board_id = ?", boardID).
+		Order("start_date ASC").
+		Find(&sprintEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.SprintEntitiesToDomain(sprintEntities), nil
+}
+
+func (r *sprintRepo) GetActiveByBoardID(ctx context.Context, boardID uint) (*domains.Sprint, error) {
+	var sprint entities.Sprint
+	err := r.db.WithContext(ctx).
+		Model(&entities.Sprint{}).
+		Where("board_id = ? AND state = ?", boardID, string(domains.SprintActive)).
+		First(&sprint).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.SprintEntityToDomain(&sprint), nil
+}
+
+func (r *sprintRepo) AssignTasks(ctx context.Context, sprintID uint, taskIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return assignTasksToSprint(tx, sprintID, taskIDs, nil)
+	})
+}
+
+func (r *sprintRepo) RemoveTask(ctx context.Context, sprintID uint, taskID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entities.Task{}).
+			Where("id = ? AND sprint_id = ?", taskID, sprintID).
+			Update("sprint_id", nil).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Model(&entities.SprintTask{}).
+			Where("sprint_id = ? AND task_id = ? AND removed_at IS NULL", sprintID, taskID).
+			Update("removed_at", time.Now()).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
+}
+
+// GetSprintTasks returns every task committed to the sprint, tasks removed
+// from it included, so the burndown of earlier days keeps them.
+func (r *sprintRepo) GetSprintTasks(ctx context.Context, sprintID uint) ([]domains.SprintTask, error) {
+	var sprintTaskEntities []entities.SprintTask
+	err := r.db.WithContext(ctx).
+		Where("sprint_id = ?", sprintID).
+		Find(&sprintTaskEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.SprintTaskEntitiesToDomain(sprintTaskEntities), nil
+}
+
+// Close stores the closed sprint and carries the tasks over in one transaction.
+func (r *sprintRepo) Close(ctx context.Context, sprint *domains.Sprint, toSprintID *uint, taskIDs []uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if len(taskIDs) > 0 {
+			//the closed sprint keeps its sprint_tasks rows so its burndown stays intact
+			if toSprintID == nil {
+				if err := tx.Model(&entities.Task{}).
+					Where("id IN ? AND sprint_id = ?", taskIDs, sprint.ID).
+					Update("sprint_id", nil).Error; err != nil {
+					return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+				}
+			} else if err := assignTasksToSprint(tx, *toSprintID, taskIDs, &sprint.ID); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&entities.Sprint{}).Where("id = ?", sprint.ID).Updates(map[string]interface{}{
+			"state":     string(sprint.State),
+			"closed_at": sprint.ClosedAt,
+		})
+		if result.Error != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return fiber.NewError(fiber.StatusNotFound, ErrSprintNotFound)
+		}
+		return nil
+	})
+}
+
+// assignTasksToSprint moves the tasks into the sprint and ends their scope in
+// the sprints they were in before, except for keepSprintID whose scope stays.
+func assignTasksToSprint(tx *gorm.DB, sprintID uint, taskIDs []uint, keepSprintID *uint) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	if err := tx.Model(&entities.Task{}).Where("id IN ?", taskIDs).Update("sprint_id", sprintID).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	previous := tx.Model(&entities.SprintTask{}).
+		Where("task_id IN ? AND sprint_id <> ? AND removed_at IS NULL", taskIDs, sprintID)
+	if keepSprintID != nil {
+		previous = previous.Where("sprint_id <> ?", *keepSprintID)
+	}
+	if err := previous.Update("removed_at", time.Now()).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	rows := make([]entities.SprintTask, len(taskIDs))
+	for i, taskID := range taskIDs {
+		rows[i] = entities.SprintTask{SprintID: sprintID, TaskID: taskID}
+	}
+
+	//re-adding a previously removed task brings it back into the sprint scope
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "sprint_id"}, {Name: "task_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"removed_at": nil}),
+	}).Create(&rows).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type statsRepo struct {
+	db *gorm.DB
+}
+
+func NewStatsRepo(db *gorm.DB) ports.StatsRepo {
+	return &statsRepo{
+		db: db,
+	}
+}
+
+// GetSystemStats counts the records that are not deleted.
+func (r *statsRepo) GetSystemStats(ctx context.Context) (domains.SystemStats, error) {
+	var stats domains.SystemStats
+	db := withTx(ctx, r.db)
+
+	counts := []struct {
+		query *gorm.DB
+		count *int64
+	}{
+		{db.Model(&entities.User{}), &stats.Users},
+		{db.Model(&entities.User{}).Where("disabled_at IS NOT NULL"), &stats.DisabledUsers},
+		{db.Model(&entities.User{}).Where("email_verified_at IS NULL"), &stats.UnverifiedUsers},
+		{db.Model(&entities.Workspace{}), &stats.Workspaces},
+		{db.Model(&entities.Board{}), &stats.Boards},
+		{db.Model(&entities.Board{}).Where("archived_at IS NOT NULL"), &stats.ArchivedBoards},
+		{db.Model(&entities.Task{}), &stats.Tasks},
+	}
+	for _, c := range counts {
+		if err := c.query.Count(c.count).Error; err != nil {
+			return stats, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+	}
+	return stats, nil
+}
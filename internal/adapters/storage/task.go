@@ -3,6 +3,9 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
@@ -22,23 +25,29 @@ func NewTaskRepo(db *gorm.DB) ports.TaskRepo {
 	}
 }
 
-func (r *taskRepo) GetListByBoardID(ctx context.Context, boardID uint, limit uint, offset uint) ([]domains.Task, uint, error) {
+func (r *taskRepo) GetListByBoardID(ctx context.Context, boardID uint, filter domains.TaskFilter, limit uint, offset uint) ([]domains.Task, uint, error) {
 	var taskEntities []entities.Task
 
-	query := r.db.WithContext(ctx).
+	query := withTx(ctx, r.db).
 		Model(&entities.Task{}).
-		Where("board_id = ?", boardID).
+		Where("tasks.board_id = ? AND tasks.archived_at IS NULL", boardID).
 		Preload("Board").
 		Preload("Column").
 		Preload("Assignee").
 		Preload("Creator")
 
+	//apply filters
+	query = applyTaskFilter(query, filter)
+
 	//calculate total entities
 	var total int64
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
+	//apply sort
+	query = applyTaskSort(query, filter.Sort)
+
 	//apply offset
 	if offset > 0 {
 		query = query.Offset(int(offset))
@@ -63,7 +72,7 @@ func (r *taskRepo) GetListByBoardID(ctx context.Context, boardID uint, limit uin
 }
 
 func (r *taskRepo) Create(ctx context.Context, task *domains.Task) error {
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		newTask := mappers.DomainToTaskEntity(task)
 		if err := tx.Create(&newTask).Error; err != nil {
 			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
@@ -81,7 +90,7 @@ func (r *taskRepo) Create(ctx context.Context, task *domains.Task) error {
 
 func (r *taskRepo) GetByID(ctx context.Context, id uint) (*domains.Task, error) {
 	var task entities.Task
-	err := r.db.WithContext(ctx).Model(&entities.Task{}).
+	err := withTx(ctx, r.db).Model(&entities.Task{}).
 		Where("id = ?", id).
 		Preload("Board").
 		Preload("Creator").
@@ -100,7 +109,7 @@ func (r *taskRepo) GetByID(ctx context.Context, id uint) (*domains.Task, error)
 
 func (r *taskRepo) Update(ctx context.Context, task *domains.Task) error {
 	var existingTask *entities.Task
-	err := r.db.WithContext(ctx).Model(&entities.Task{}).Where("id = ?", task.ID).First(&existingTask).Error
+	err := withTx(ctx, r.db).Model(&entities.Task{}).Where("id = ?", task.ID).First(&existingTask).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusNotFound, "Task not found!")
@@ -120,16 +129,16 @@ func (r *taskRepo) Update(ctx context.Context, task *domains.Task) error {
 	existingTask.EndDateTime = task.EndDateTime
 	existingTask.StoryPoint = task.StoryPoint
 
-	if err := r.db.WithContext(ctx).Save(&existingTask).Error; err != nil {
+	if err := withTx(ctx, r.db).Save(&existingTask).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
 }
 
-func (r *taskRepo) Delete(ctx context.Context, id uint) error {
+func (r *taskRepo) Delete(ctx context.Context, id uint, deletedBy uint) error {
 
 	var existingTask *entities.Task
-	err := r.db.WithContext(ctx).Model(&entities.Task{}).Where("id = ?", id).First(&existingTask).Error
+	err := withTx(ctx, r.db).Model(&entities.Task{}).Where("id = ?", id).First(&existingTask).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusNotFound, "Task not found!")
@@ -137,10 +146,15 @@ func (r *taskRepo) Delete(ctx context.Context, id uint) error {
 		return err
 	}
 
-	if err := r.db.WithContext(ctx).Model(&entities.Task{}).Delete(&existingTask).Error; err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
-	}
-	return nil
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&existingTask).Update("deleted_by", deletedBy).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Delete(&existingTask).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
 }
 
 func (r *taskRepo) GetTaskChildren(ctx context.Context, taskID uint) ([]domains.TaskChild, error) {
@@ -157,7 +171,7 @@ func (r *taskRepo) GetTaskChildren(ctx context.Context, taskID uint) ([]domains.
 		FROM sub_tasks st2
 				 INNER JOIN columns on st2.column_id = columns.id
     `
-	if err := r.db.WithContext(ctx).Raw(query, taskID).Scan(&childEntities).Error; err != nil {
+	if err := withTx(ctx, r.db).Raw(query, taskID).Scan(&childEntities).Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
@@ -168,7 +182,7 @@ func (r *taskRepo) GetTaskChildren(ctx context.Context, taskID uint) ([]domains.
 
 func (r *taskRepo) GetTaskDependencies(ctx context.Context, taskID uint) ([]domains.TaskDependency, error) {
 	var dependencies []entities.TaskDependency
-	if err := r.db.WithContext(ctx).Where("task_id = ?", taskID).Find(&dependencies).Error; err != nil {
+	if err := withTx(ctx, r.db).Where("task_id = ?", taskID).Find(&dependencies).Error; err != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return mappers.TaskDependencyEntitiesToDomains(dependencies), nil
@@ -183,7 +197,7 @@ func (r *taskRepo) AddTaskDependency(ctx context.Context, taskID, dependentTaskI
 		return fiber.NewError(fiber.StatusBadRequest, "Dependency already exists!")
 	}
 	dependency := entities.TaskDependency{TaskID: taskID, DependentTaskID: dependentTaskID}
-	if err := r.db.WithContext(ctx).Create(&dependency).Error; err != nil {
+	if err := withTx(ctx, r.db).Create(&dependency).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
@@ -197,7 +211,7 @@ func (r *taskRepo) RemoveTaskDependency(ctx context.Context, taskID, dependentTa
 	if !exists {
 		return fiber.NewError(fiber.StatusBadRequest, "Dependency already exists!")
 	}
-	if err := r.db.WithContext(ctx).Where("task_id = ? AND dependent_task_id = ?", taskID, dependentTaskID).Delete(&entities.TaskDependency{}).Error; err != nil {
+	if err := withTx(ctx, r.db).Where("task_id = ? AND dependent_task_id = ?", taskID, dependentTaskID).Delete(&entities.TaskDependency{}).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return nil
@@ -205,7 +219,7 @@ func (r *taskRepo) RemoveTaskDependency(ctx context.Context, taskID, dependentTa
 
 func (r *taskRepo) DependencyExists(ctx context.Context, taskID, dependentTaskID uint) (bool, error) {
 	var dependencies []*entities.TaskDependency
-	if err := r.db.WithContext(ctx).Where("task_id = ? AND dependent_task_id = ?", taskID, dependentTaskID).Find(&dependencies).Error; err != nil {
+	if err := withTx(ctx, r.db).Where("task_id = ? AND dependent_task_id = ?", taskID, dependentTaskID).Find(&dependencies).Error; err != nil {
 		return false, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	return dependencies != nil, nil
@@ -213,7 +227,7 @@ func (r *taskRepo) DependencyExists(ctx context.Context, taskID, dependentTaskID
 
 func (r *taskRepo) GetAllTaskDependencies(ctx context.Context) ([]domains.TaskDependency, error) {
 	var dependencies []entities.TaskDependency
-	result := r.db.WithContext(ctx).Find(&dependencies).Error
+	result := withTx(ctx, r.db).Find(&dependencies).Error
 	if result != nil {
 		return nil, fiber.NewError(fiber.StatusInternalServerError, result.Error())
 	}
@@ -222,7 +236,7 @@ func (r *taskRepo) GetAllTaskDependencies(ctx context.Context) ([]domains.TaskDe
 
 func (r *taskRepo) AssignUserToTask(ctx context.Context, taskID uint, userID uint) error {
 	var task entities.Task
-	err := r.db.WithContext(ctx).Model(&entities.Task{}).Where("task_id = ?", taskID).First(&task).Error
+	err := withTx(ctx, r.db).Model(&entities.Task{}).Where("id = ?", taskID).First(&task).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusNotFound, "Task not found!")
@@ -230,5 +244,252 @@ func (r *taskRepo) AssignUserToTask(ctx context.Context, taskID uint, userID uin
 		return err
 	}
 	task.AssigneeID = &userID
-	return r.db.WithContext(ctx).Save(&task).Error
+	return withTx(ctx, r.db).Save(&task).Error
+}
+
+func (r *taskRepo) GetListByIDs(ctx context.Context, ids []uint) ([]domains.Task, error) {
+	var taskEntities []entities.Task
+	if len(ids) == 0 {
+		return []domains.Task{}, nil
+	}
+
+	err := withTx(ctx, r.db).
+		Model(&entities.Task{}).
+		Where("id IN ?", ids).
+		Preload("Board").
+		Preload("Column").
+		Preload("Assignee").
+		Preload("Creator").
+		Find(&taskEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return mappers.TaskEntitiesToDomain(taskEntities), nil
+}
+
+func (r *taskRepo) AddColumnTransition(ctx context.Context, transition *domains.TaskColumnTransition) error {
+	entity := mappers.DomainToTaskColumnTransitionEntity(transition)
+	if err := withTx(ctx, r.db).Create(&entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	transition.ID = entity.ID
+	transition.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *taskRepo) GetColumnTransitions(ctx context.Context, taskIDs []uint) ([]domains.TaskColumnTransition, error) {
+	var transitionEntities []entities.TaskColumnTransition
+	if len(taskIDs) == 0 {
+		return []domains.TaskColumnTransition{}, nil
+	}
+
+	err := withTx(ctx, r.db).
+		Where("task_id IN ?", taskIDs).
+		Order("created_at ASC, id ASC").
+		Find(&transitionEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return mappers.TaskColumnTransitionEntitiesToDomain(transitionEntities), nil
+}
+
+func (r *taskRepo) GetColumnTransitionsByBoardID(ctx context.Context, boardID uint) ([]domains.TaskColumnTransition, error) {
+	var transitionEntities []entities.TaskColumnTransition
+
+	err := withTx(ctx, r.db).
+		Where("board_id = ?", boardID).
+		Order("created_at ASC, id ASC").
+		Find(&transitionEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return mappers.TaskColumnTransitionEntitiesToDomain(transitionEntities), nil
+}
+
+func applyTaskFilter(query *gorm.DB, filter domains.TaskFilter) *gorm.DB {
+	if len(filter.ColumnIDs) > 0 {
+		query = query.Where("tasks.column_id IN ?", filter.ColumnIDs)
+	}
+
+	switch {
+	case filter.Unassigned && len(filter.AssigneeIDs) > 0:
+		query = query.Where("(tasks.assignee_id IS NULL OR tasks.assignee_id IN ?)", filter.AssigneeIDs)
+	case filter.Unassigned:
+		query = query.Where("tasks.assignee_id IS NULL")
+	case len(filter.AssigneeIDs) > 0:
+		query = query.Where("tasks.assignee_id IN ?", filter.AssigneeIDs)
+	}
+
+	if filter.SprintID != nil {
+		query = query.Where("tasks.sprint_id = ?", *filter.SprintID)
+	} else if filter.Backlog {
+		query = query.Where("tasks.sprint_id IS NULL")
+	}
+
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		pattern := "%" + escapeLike(search) + "%"
+		query = query.Where("(tasks.name ILIKE ? OR tasks.description ILIKE ?)", pattern, pattern)
+	}
+
+	if filter.DueFrom != nil {
+		query = query.Where("tasks.end_datetime >= ?", *filter.DueFrom)
+	}
+
+	if filter.DueTo != nil {
+		query = query.Where("tasks.end_datetime < ?", filter.DueTo.AddDate(0, 0, 1))
+	}
+
+	return query
+}
+
+func applyTaskSort(query *gorm.DB, sort domains.TaskSort) *gorm.DB {
+	switch sort.GroupBy {
+	case domains.TaskGroupByColumn:
+		query = query.Order("tasks.column_id ASC")
+	case domains.TaskGroupByAssignee:
+		query = query.Order("tasks.assignee_id ASC NULLS LAST")
+	case domains.TaskGroupBySprint:
+		query = query.Order("tasks.sprint_id ASC NULLS LAST")
+	}
+
+	field := domains.TaskSortOrderPosition
+	if sort.Field.IsValid() {
+		field = sort.Field
+	}
+
+	direction := "ASC"
+	if sort.Desc {
+		direction = "DESC"
+	}
+
+	// field is whitelisted by IsValid, so it is safe to interpolate
+	return query.Order(fmt.Sprintf("tasks.%s %s", field, direction)).Order("tasks.id ASC")
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+func (r *taskRepo) Archive(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.Task{}).
+		Where("id = ? AND archived_at IS NULL", id).
+		Update("archived_at", time.Now())
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "Task not found or already archived!")
+	}
+	return nil
+}
+
+func (r *taskRepo) Unarchive(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.Task{}).
+		Where("id = ? AND archived_at IS NOT NULL", id).
+		Update("archived_at", nil)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "Task not found or not archived!")
+	}
+	return nil
+}
+
+func (r *taskRepo) GetDeletedListByBoardID(ctx context.Context, boardID uint) ([]domains.TrashItem, error) {
+	var taskEntities []entities.Task
+	err := withTx(ctx, r.db).Unscoped().Model(&entities.Task{}).
+		Preload("Deleter").
+		Where("board_id = ? AND deleted_at IS NOT NULL", boardID).
+		Order("deleted_at DESC").
+		Find(&taskEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.TaskEntitiesToTrashItems(taskEntities), nil
+}
+
+func (r *taskRepo) GetDeletedByID(ctx context.Context, id uint) (*domains.Task, error) {
+	var task entities.Task
+	err := withTx(ctx, r.db).Unscoped().Model(&entities.Task{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&task).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "Task not found in trash!")
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.TaskEntityToDomain(&task), nil
+}
+
+// Restore brings a deleted task back. The caller decides whether the parent
+// link is still valid; a clashing order position moves the task to the end
+// of its column.
+func (r *taskRepo) Restore(ctx context.Context, id uint, clearParent bool) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var task entities.Task
+		if err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&task).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fiber.NewError(fiber.StatusNotFound, "Task not found in trash!")
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		updates := map[string]interface{}{
+			"deleted_at": nil,
+			"deleted_by": nil,
+		}
+		if clearParent {
+			updates["parent_id"] = nil
+		}
+
+		var clashes int64
+		if err := tx.Model(&entities.Task{}).
+			Where("column_id = ? AND order_position = ?", task.ColumnID, task.OrderPosition).
+			Count(&clashes).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if clashes > 0 {
+			var lastPosition int
+			if err := tx.Model(&entities.Task{}).
+				Where("column_id = ?", task.ColumnID).
+				Select("COALESCE(MAX(order_position), 0)").
+				Scan(&lastPosition).Error; err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+			updates["order_position"] = lastPosition + 1
+		}
+
+		if err := tx.Unscoped().Model(&entities.Task{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
+}
+
+// UnassignUser hands the tasks assigned to userID over to reassignTo on the
+// boards reassignTo is a member of, and leaves the rest unassigned.
+func (r *taskRepo) UnassignUser(ctx context.Context, userID uint, reassignTo *uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if reassignTo != nil {
+			memberBoards := tx.Table(entities.BoardMember{}.TableName()).Select("board_id").
+				Where("user_id = ? AND deleted_at IS NULL", *reassignTo)
+			if err := tx.Model(&entities.Task{}).
+				Where("assignee_id = ? AND board_id IN (?)", userID, memberBoards).
+				Update("assignee_id", *reassignTo).Error; err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+		}
+
+		if err := tx.Unscoped().Model(&entities.Task{}).
+			Where("assignee_id = ?", userID).
+			Update("assignee_id", nil).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
 }
@@ -28,7 +28,7 @@ func (r *taskCommentRepo) Create(ctx context.Context, comment *domains.TaskComme
 	comment.ID = uuid.New()
 
 	newComment := mappers.DomainToCommentEntity(comment)
-	if err := r.db.Debug().WithContext(ctx).Create(&newComment).Error; err != nil {
+	if err := withTx(ctx, r.db).Create(&newComment).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	comment.ID = newComment.ID
@@ -38,7 +38,7 @@ func (r *taskCommentRepo) Create(ctx context.Context, comment *domains.TaskComme
 
 func (r *taskCommentRepo) GetByID(ctx context.Context, id string) (*domains.TaskComment, error) {
 	var comment entities.TaskComment
-	err := r.db.WithContext(ctx).Model(&entities.TaskComment{}).
+	err := withTx(ctx, r.db).Model(&entities.TaskComment{}).
 		Where("id = ?", id).
 		Preload("User").
 		First(&comment).Error
@@ -58,7 +58,7 @@ func (r *taskCommentRepo) Update(ctx context.Context, comment *domains.TaskComme
 
 func (r *taskCommentRepo) Delete(ctx context.Context, id string) error {
 	var existingTaskComment *entities.TaskComment
-	err := r.db.WithContext(ctx).Model(&entities.TaskComment{}).Where("id = ?", id).First(&existingTaskComment).Error
+	err := withTx(ctx, r.db).Model(&entities.TaskComment{}).Where("id = ?", id).First(&existingTaskComment).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return fiber.NewError(fiber.StatusNotFound, "Comment not found!")
@@ -66,7 +66,7 @@ func (r *taskCommentRepo) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
-	if err := r.db.WithContext(ctx).Model(&entities.TaskComment{}).Delete(&existingTaskComment).Error; err != nil {
+	if err := withTx(ctx, r.db).Model(&entities.TaskComment{}).Delete(&existingTaskComment).Error; err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
@@ -76,7 +76,7 @@ func (r *taskCommentRepo) Delete(ctx context.Context, id string) error {
 func (r *taskCommentRepo) GetListByTaskID(ctx context.Context, taskID uint, limit uint, offset uint) ([]domains.TaskComment, uint, error) {
 	var commentEntities []entities.TaskComment
 
-	query := r.db.WithContext(ctx).
+	query := withTx(ctx, r.db).
 		Model(&entities.TaskComment{}).
 		Where("task_id = ?", taskID).
 		Preload("User")
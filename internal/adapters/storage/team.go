@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTeamNotFound       = "team not found"
+	ErrTeamMemberNotFound = "team member not found"
+	ErrBoardTeamNotFound  = "team is not on the board"
+)
+
+type teamRepo struct {
+	db *gorm.DB
+}
+
+func NewTeamRepo(db *gorm.DB) ports.TeamRepo {
+	return &teamRepo{
+		db: db,
+	}
+}
+
+func (r *teamRepo) Create(ctx context.Context, team *domains.Team) error {
+	entity := mappers.TeamDomainToEntity(team)
+	if err := withTx(ctx, r.db).Omit("Workspace").Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	team.ID = entity.ID
+	team.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *teamRepo) GetByID(ctx context.Context, id uint) (*domains.Team, error) {
+	var entity entities.Team
+	if err := withTx(ctx, r.db).Where("id = ?", id).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrTeamNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	team := mappers.TeamEntityToDomain(entity)
+	return &team, nil
+}
+
+func (r *teamRepo) Update(ctx context.Context, team *domains.Team) error {
+	result := withTx(ctx, r.db).Model(&entities.Team{}).Where("id = ?", team.ID).Update("name", team.Name)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrTeamNotFound)
+	}
+	return nil
+}
+
+func (r *teamRepo) Delete(ctx context.Context, id uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("team_id = ?", id).Delete(&entities.BoardTeam{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Unscoped().Where("team_id = ?", id).Delete(&entities.TeamMember{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		result := tx.Where("id = ?", id).Delete(&entities.Team{})
+		if result.Error != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return fiber.NewError(fiber.StatusNotFound, ErrTeamNotFound)
+		}
+		return nil
+	})
+}
+
+func (r *teamRepo) GetByWorkspaceID(ctx context.Context, workspaceID uint) ([]domains.Team, error) {
+	var teamEntities []entities.Team
+	if err := withTx(ctx, r.db).Where("workspace_id = ?", workspaceID).Order("name").Find(&teamEntities).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.TeamEntitiesToDomain(teamEntities), nil
+}
+
+type teamMemberRepo struct {
+	db *gorm.DB
+}
+
+func NewTeamMemberRepo(db *gorm.DB) ports.TeamMemberRepo {
+	return &teamMemberRepo{
+		db: db,
+	}
+}
+
+func (r *teamMemberRepo) Create(ctx context.Context, member *domains.TeamMember) error {
+	entity := mappers.TeamMemberDomainToEntity(member)
+	if err := withTx(ctx, r.db).Omit("Team", "User").Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	member.ID = entity.ID
+	member.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *teamMemberRepo) GetMember(ctx context.Context, teamID, userID uint) (*domains.TeamMember, error) {
+	var entity entities.TeamMember
+	err := withTx(ctx, r.db).Model(&entities.TeamMember{}).
+		Where("team_id = ? AND user_id = ?", teamID, userID).
+		First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrTeamMemberNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	member := mappers.TeamMemberEntityToDomain(entity)
+	return &member, nil
+}
+
+func (r *teamMemberRepo) GetMembers(ctx context.Context, teamID uint) ([]domains.TeamMember, error) {
+	var memberEntities []entities.TeamMember
+	err := withTx(ctx, r.db).Model(&entities.TeamMember{}).
+		Joins("User").
+		Where("team_members.team_id = ?", teamID).
+		Order(`"User".name`).
+		Find(&memberEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.TeamMemberEntitiesToDomain(memberEntities), nil
+}
+
+// Delete removes the membership for good, so the user can be added again.
+func (r *teamMemberRepo) Delete(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Unscoped().Where("id = ?", id).Delete(&entities.TeamMember{})
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrTeamMemberNotFound)
+	}
+	return nil
+}
+
+func (r *teamMemberRepo) DeleteByWorkspaceUser(ctx context.Context, workspaceID, userID uint) error {
+	db := withTx(ctx, r.db)
+	teams := db.Model(&entities.Team{}).Select("id").Where("workspace_id = ?", workspaceID)
+	err := db.Unscoped().Where("user_id = ? AND team_id IN (?)", userID, teams).Delete(&entities.TeamMember{}).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+type boardTeamRepo struct {
+	db *gorm.DB
+}
+
+func NewBoardTeamRepo(db *gorm.DB) ports.BoardTeamRepo {
+	return &boardTeamRepo{
+		db: db,
+	}
+}
+
+func (r *boardTeamRepo) Create(ctx context.Context, boardTeam *domains.BoardTeam) error {
+	entity := mappers.BoardTeamDomainToEntity(boardTeam)
+	if err := withTx(ctx, r.db).Omit("Board", "Team", "Role").Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	boardTeam.ID = entity.ID
+	boardTeam.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *boardTeamRepo) GetBoardTeam(ctx context.Context, boardID, teamID uint) (*domains.BoardTeam, error) {
+	var entity entities.BoardTeam
+	err := withTx(ctx, r.db).Model(&entities.BoardTeam{}).
+		Preload("Role").
+		Where("board_id = ? AND team_id = ?", boardID, teamID).
+		First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrBoardTeamNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	boardTeam := mappers.BoardTeamEntityToDomain(entity)
+	return &boardTeam, nil
+}
+
+func (r *boardTeamRepo) GetByBoardID(ctx context.Context, boardID uint) ([]domains.BoardTeam, error) {
+	var boardTeamEntities []entities.BoardTeam
+	err := withTx(ctx, r.db).Model(&entities.BoardTeam{}).
+		Preload("Team").
+		Preload("Role").
+		Where("board_id = ?", boardID).
+		Order("id").
+		Find(&boardTeamEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.BoardTeamEntitiesToDomain(boardTeamEntities), nil
+}
+
+func (r *boardTeamRepo) UpdateRole(ctx context.Context, id uint, roleID uint) error {
+	result := withTx(ctx, r.db).Model(&entities.BoardTeam{}).Where("id = ?", id).Update("role_id", roleID)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrBoardTeamNotFound)
+	}
+	return nil
+}
+
+func (r *boardTeamRepo) Delete(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Unscoped().Where("id = ?", id).Delete(&entities.BoardTeam{})
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrBoardTeamNotFound)
+	}
+	return nil
+}
+
+func (r *boardTeamRepo) GetUserRoleIDs(ctx context.Context, boardID, userID uint) ([]uint, error) {
+	db := withTx(ctx, r.db)
+	teams := db.Model(&entities.TeamMember{}).Select("team_id").Where("user_id = ?", userID)
+
+	var roleIDs []uint
+	err := db.Model(&entities.BoardTeam{}).
+		Where("board_id = ? AND team_id IN (?)", boardID, teams).
+		Distinct().
+		Pluck("role_id", &roleIDs).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return roleIDs, nil
+}
@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
+	"gorm.io/gorm"
+)
+
+// withTx returns the transaction started for the request by the SetTransaction
+// middleware, or db when the request runs without one.
+func withTx(ctx context.Context, db *gorm.DB) *gorm.DB {
+	committer, ok := valuecontext.TryGetTxFromContext(ctx)
+	if !ok || committer == nil {
+		return db.WithContext(ctx)
+	}
+
+	tx, ok := committer.Tx().(*gorm.DB)
+	if !ok || tx == nil {
+		return db.WithContext(ctx)
+	}
+
+	return tx.WithContext(ctx)
+}
@@ -3,6 +3,9 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
@@ -29,7 +32,7 @@ var (
 
 func (r *userRepo) Create(ctx context.Context, user *domains.User) error {
 	var existingUser *entities.User
-	err := r.db.WithContext(ctx).Model(&entities.User{}).Where("email = ?", user.Email).First(&existingUser).Error
+	err := withTx(ctx, r.db).Model(&entities.User{}).Where("email = ?", user.Email).First(&existingUser).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
@@ -37,13 +40,14 @@ func (r *userRepo) Create(ctx context.Context, user *domains.User) error {
 		return fiber.NewError(fiber.StatusBadRequest, ErrUserAlreadyExists)
 	}
 
-	if err := r.db.Transaction(func(tx *gorm.DB) error {
+	if err := withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		entity := mappers.DomainToUserEntity(user)
 		err := tx.WithContext(ctx).Create(&entity).Error
 		if err != nil {
 			return err
 		}
 
+		user.ID = entity.ID
 		return nil
 	}); err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
@@ -55,8 +59,11 @@ func (r *userRepo) Create(ctx context.Context, user *domains.User) error {
 func (r *userRepo) GetByID(ctx context.Context, id uint) (*domains.User, error) {
 	var u entities.User
 
-	err := r.db.WithContext(ctx).Model(&entities.User{}).Where("id = ?", id).First(&u).Error
+	err := withTx(ctx, r.db).Model(&entities.User{}).Where("id = ?", id).First(&u).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrUserNotFound)
+		}
 		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
@@ -69,7 +76,7 @@ func (r *userRepo) GetByID(ctx context.Context, id uint) (*domains.User, error)
 
 func (r *userRepo) GetByEmail(ctx context.Context, email string) (*domains.User, error) {
 	var user entities.User
-	err := r.db.WithContext(ctx).Model(&entities.User{}).Where("email = ?", email).First(&user).Error
+	err := withTx(ctx, r.db).Model(&entities.User{}).Where("email = ?", email).First(&user).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fiber.NewError(fiber.StatusNotFound, ErrUserNotFound)
@@ -78,3 +85,139 @@ func (r *userRepo) GetByEmail(ctx context.Context, email string) (*domains.User,
 	}
 	return mappers.UserEntityToDomain(&user), nil
 }
+
+func (r *userRepo) UpdatePassword(ctx context.Context, id uint, passwordHash string) error {
+	result := withTx(ctx, r.db).Model(&entities.User{}).Where("id = ?", id).Update("password", passwordHash)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrUserNotFound)
+	}
+	return nil
+}
+
+func (r *userRepo) MarkEmailVerified(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Model(&entities.User{}).
+		Where("id = ? AND email_verified_at IS NULL", id).
+		Update("email_verified_at", time.Now())
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	return nil
+}
+
+// Update saves the profile fields of the user.
+func (r *userRepo) Update(ctx context.Context, user *domains.User) error {
+	err := withTx(ctx, r.db).Model(&entities.User{}).Where("id = ?", user.ID).Updates(map[string]interface{}{
+		"name":              user.Name,
+		"email":             user.Email,
+		"email_verified_at": user.EmailVerifiedAt,
+		"avatar_url":        user.AvatarURL,
+		"timezone":          user.Timezone,
+		"locale":            user.Locale,
+	}).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+// UpdateMFA stores the TOTP secret of the user, an empty secret turns MFA off.
+func (r *userRepo) UpdateMFA(ctx context.Context, id uint, secret string, enabledAt *time.Time) error {
+	err := withTx(ctx, r.db).Model(&entities.User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"mfa_secret":     secret,
+		"mfa_enabled_at": enabledAt,
+	}).Error
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+func (r *userRepo) GetAll(ctx context.Context, filter domains.UserFilter, limit uint, offset uint) ([]domains.User, uint, error) {
+	query := withTx(ctx, r.db).Model(&entities.User{})
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		pattern := "%" + escapeLike(search) + "%"
+		query = query.Where("(name ILIKE ? OR email ILIKE ?)", pattern, pattern)
+	}
+	if filter.Disabled != nil {
+		if *filter.Disabled {
+			query = query.Where("disabled_at IS NOT NULL")
+		} else {
+			query = query.Where("disabled_at IS NULL")
+		}
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	if offset > 0 {
+		query = query.Offset(int(offset))
+	}
+	if limit > 0 {
+		query = query.Limit(int(limit))
+	}
+
+	var userEntities []entities.User
+	if err := query.Order("id").Find(&userEntities).Error; err != nil {
+		return nil, 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	users := make([]domains.User, len(userEntities))
+	for i := range userEntities {
+		users[i] = *mappers.UserEntityToDomain(&userEntities[i])
+	}
+	return users, uint(total), nil
+}
+
+func (r *userRepo) SetDisabledAt(ctx context.Context, id uint, disabledAt *time.Time) error {
+	result := withTx(ctx, r.db).Model(&entities.User{}).Where("id = ?", id).Update("disabled_at", disabledAt)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrUserNotFound)
+	}
+	return nil
+}
+
+func (r *userRepo) SetPasswordResetRequired(ctx context.Context, id uint, required bool) error {
+	result := withTx(ctx, r.db).Model(&entities.User{}).Where("id = ?", id).Update("password_reset_required", required)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrUserNotFound)
+	}
+	return nil
+}
+
+// Anonymize wipes the personal data of the user, unlinks their SSO identities
+// and soft-deletes the account. The row is kept so tasks, comments and history
+// still resolve their author.
+func (r *userRepo) Anonymize(ctx context.Context, id uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entities.User{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"name":              "Deleted user",
+			"email":             fmt.Sprintf("deleted-user-%d@users.invalid", id),
+			"password":          "",
+			"email_verified_at": nil,
+			"avatar_url":        "",
+			"timezone":          "",
+			"locale":            "",
+			"mfa_secret":        "",
+			"mfa_enabled_at":    nil,
+		}).Error
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Unscoped().Where("user_id = ?", id).Delete(&entities.UserIdentity{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Delete(&entities.User{}, id).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		return nil
+	})
+}
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUserIdentityNotFound = "user identity not found"
+)
+
+type userIdentityRepo struct {
+	db *gorm.DB
+}
+
+func NewUserIdentityRepo(db *gorm.DB) ports.UserIdentityRepo {
+	return &userIdentityRepo{
+		db: db,
+	}
+}
+
+func (r *userIdentityRepo) GetByProviderSubject(ctx context.Context, provider string, subject string) (*domains.UserIdentity, error) {
+	var identity entities.UserIdentity
+	err := withTx(ctx, r.db).Model(&entities.UserIdentity{}).
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrUserIdentityNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.UserIdentityEntityToDomain(&identity), nil
+}
+
+func (r *userIdentityRepo) Create(ctx context.Context, identity *domains.UserIdentity) error {
+	entity := mappers.UserIdentityDomainToEntity(identity)
+	if err := withTx(ctx, r.db).Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	identity.ID = entity.ID
+	return nil
+}
+
+func (r *userIdentityRepo) Delete(ctx context.Context, id uint) error {
+	if err := withTx(ctx, r.db).Unscoped().Delete(&entities.UserIdentity{}, id).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
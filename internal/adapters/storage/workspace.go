@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/entities"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage/mappers"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrWorkspaceNotFound       = "workspace not found"
+	ErrWorkspaceMemberNotFound = "workspace member not found"
+)
+
+type workspaceRepo struct {
+	db *gorm.DB
+}
+
+func NewWorkspaceRepo(db *gorm.DB) ports.WorkspaceRepo {
+	return &workspaceRepo{
+		db: db,
+	}
+}
+
+func (r *workspaceRepo) Create(ctx context.Context, workspace *domains.Workspace) error {
+	entity := mappers.WorkspaceDomainToEntity(workspace)
+	if err := withTx(ctx, r.db).Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	workspace.ID = entity.ID
+	workspace.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *workspaceRepo) GetByID(ctx context.Context, id uint) (*domains.Workspace, error) {
+	var entity entities.Workspace
+	if err := withTx(ctx, r.db).Where("id = ?", id).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrWorkspaceNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	workspace := mappers.WorkspaceEntityToDomain(entity)
+	return &workspace, nil
+}
+
+func (r *workspaceRepo) Update(ctx context.Context, workspace *domains.Workspace) error {
+	result := withTx(ctx, r.db).Model(&entities.Workspace{}).Where("id = ?", workspace.ID).Update("name", workspace.Name)
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrWorkspaceNotFound)
+	}
+	return nil
+}
+
+// Delete removes the workspace with its memberships and teams.
+func (r *workspaceRepo) Delete(ctx context.Context, id uint) error {
+	return withTx(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		teams := tx.Model(&entities.Team{}).Select("id").Where("workspace_id = ?", id)
+		if err := tx.Unscoped().Where("team_id IN (?)", teams).Delete(&entities.TeamMember{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Where("workspace_id = ?", id).Delete(&entities.Team{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		if err := tx.Unscoped().Where("workspace_id = ?", id).Delete(&entities.WorkspaceMember{}).Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+		result := tx.Where("id = ?", id).Delete(&entities.Workspace{})
+		if result.Error != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return fiber.NewError(fiber.StatusNotFound, ErrWorkspaceNotFound)
+		}
+		return nil
+	})
+}
+
+func (r *workspaceRepo) GetByUserID(ctx context.Context, userID uint) ([]domains.Workspace, error) {
+	var workspaceEntities []entities.Workspace
+	err := withTx(ctx, r.db).Model(&entities.Workspace{}).
+		Where("id IN (?)", withTx(ctx, r.db).Model(&entities.WorkspaceMember{}).Select("workspace_id").Where("user_id = ?", userID)).
+		Order("name").
+		Find(&workspaceEntities).Error
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.WorkspaceEntitiesToDomain(workspaceEntities), nil
+}
+
+type workspaceMemberRepo struct {
+	db *gorm.DB
+}
+
+func NewWorkspaceMemberRepo(db *gorm.DB) ports.WorkspaceMemberRepo {
+	return &workspaceMemberRepo{
+		db: db,
+	}
+}
+
+func (r *workspaceMemberRepo) Create(ctx context.Context, member *domains.WorkspaceMember) error {
+	entity := mappers.WorkspaceMemberDomainToEntity(member)
+	if err := withTx(ctx, r.db).Omit("Workspace", "User").Create(entity).Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	member.ID = entity.ID
+	member.CreatedAt = entity.CreatedAt
+	return nil
+}
+
+func (r *workspaceMemberRepo) GetMember(ctx context.Context, workspaceID, userID uint) (*domains.WorkspaceMember, error) {
+	var entity entities.WorkspaceMember
+	err := withTx(ctx, r.db).Model(&entities.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		First(&entity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(fiber.StatusNotFound, ErrWorkspaceMemberNotFound)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	member := mappers.WorkspaceMemberEntityToDomain(entity)
+	return &member, nil
+}
+
+func (r *workspaceMemberRepo) GetMembers(ctx context.Context, workspaceID uint, search string) ([]domains.WorkspaceMember, error) {
+	query := withTx(ctx, r.db).Model(&entities.WorkspaceMember{}).
+		Joins("User").
+		Where("workspace_members.workspace_id = ?", workspaceID)
+	if search = strings.TrimSpace(search); search != "" {
+		pattern := "%" + escapeLike(search) + "%"
+		query = query.Where(`("User".name ILIKE ? OR "User".email ILIKE ?)`, pattern, pattern)
+	}
+
+	var memberEntities []entities.WorkspaceMember
+	if err := query.Order(`"User".name`).Find(&memberEntities).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return mappers.WorkspaceMemberEntitiesToDomain(memberEntities), nil
+}
+
+func (r *workspaceMemberRepo) UpdateRole(ctx context.Context, id uint, role domains.WorkspaceRole) error {
+	result := withTx(ctx, r.db).Model(&entities.WorkspaceMember{}).Where("id = ?", id).Update("role", string(role))
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrWorkspaceMemberNotFound)
+	}
+	return nil
+}
+
+// Delete removes the membership for good, so the user can be added again.
+func (r *workspaceMemberRepo) Delete(ctx context.Context, id uint) error {
+	result := withTx(ctx, r.db).Unscoped().Where("id = ?", id).Delete(&entities.WorkspaceMember{})
+	if result.Error != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return fiber.NewError(fiber.StatusNotFound, ErrWorkspaceMemberNotFound)
+	}
+	return nil
+}
+
+func (r *workspaceMemberRepo) CountByRole(ctx context.Context, workspaceID uint, role domains.WorkspaceRole) (int64, error) {
+	var count int64
+	err := withTx(ctx, r.db).Model(&entities.WorkspaceMember{}).
+		Where("workspace_id = ? AND role = ?", workspaceID, string(role)).
+		Count(&count).Error
+	if err != nil {
+		return 0, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+	return count, nil
+}
@@ -0,0 +1,45 @@
+package domains
+
+import "time"
+
+type BoardAnalytics struct {
+	BoardID        uint
+	From           time.Time
+	To             time.Time
+	LeadTime       DurationStats
+	CycleTime      DurationStats
+	Throughput     []ThroughputPoint
+	CumulativeFlow []CumulativeFlowPoint
+}
+
+type DurationStats struct {
+	Count   int
+	Average time.Duration
+	Median  time.Duration
+	P85     time.Duration
+}
+
+type ThroughputPoint struct {
+	WeekStart   time.Time
+	Completed   int
+	StoryPoints int
+}
+
+type CumulativeFlowPoint struct {
+	Date    time.Time
+	Columns []ColumnTaskCount
+}
+
+type ColumnTaskCount struct {
+	ColumnID   uint
+	ColumnName string
+	Count      int
+}
+
+type MemberWorkload struct {
+	User            *User
+	OpenTasks       int
+	OpenStoryPoints int
+	OverdueTasks    int
+	TasksPerColumn  []ColumnTaskCount
+}
@@ -0,0 +1,50 @@
+package domains
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditLoginSucceeded          AuditAction = "auth.login.succeeded"
+	AuditLoginFailed             AuditAction = "auth.login.failed"
+	AuditTokenRefreshed          AuditAction = "auth.token.refreshed"
+	AuditRefreshTokenReused      AuditAction = "auth.token.reused"
+	AuditBoardMemberAdded        AuditAction = "board.member.added"
+	AuditBoardMemberRemoved      AuditAction = "board.member.removed"
+	AuditBoardMemberRoleChanged  AuditAction = "board.member.role_changed"
+	AuditBoardInvitationSent     AuditAction = "board.invitation.sent"
+	AuditBoardInvitationAccepted AuditAction = "board.invitation.accepted"
+	AuditBoardTeamAdded          AuditAction = "board.team.added"
+	AuditBoardTeamRemoved        AuditAction = "board.team.removed"
+	AuditBoardTeamRoleChanged    AuditAction = "board.team.role_changed"
+	AuditBoardPrivacyChanged     AuditAction = "board.privacy.changed"
+	AuditBoardDeleted            AuditAction = "board.deleted"
+
+	AuditWorkspaceMemberAdded       AuditAction = "workspace.member.added"
+	AuditWorkspaceMemberRemoved     AuditAction = "workspace.member.removed"
+	AuditWorkspaceMemberRoleChanged AuditAction = "workspace.member.role_changed"
+)
+
+// AuditLog is an entry of the append-only audit log of security-relevant
+// events. The actor is unknown for failed logins with an unknown email.
+type AuditLog struct {
+	ID           uint
+	Action       AuditAction
+	ActorID      *uint
+	TargetUserID *uint
+	BoardID      *uint
+	Details      map[string]string
+	IP           string
+	UserAgent    string
+	CreatedAt    time.Time
+}
+
+// AuditLogFilter narrows down the audit log, empty fields match every entry.
+type AuditLogFilter struct {
+	Action       AuditAction
+	ActorID      *uint
+	TargetUserID *uint
+	BoardID      *uint
+	From         *time.Time
+	To           *time.Time
+}
@@ -0,0 +1,119 @@
+package domains
+
+// BoardAction is something a user can do on a board or on what belongs to it.
+// Roles grant actions as their permissions.
+type BoardAction string
+
+const (
+	ActionBoardView              BoardAction = "board:view"
+	ActionBoardUpdate            BoardAction = "board:update"
+	ActionBoardDelete            BoardAction = "board:delete"
+	ActionBoardArchive           BoardAction = "board:archive"
+	ActionBoardTransferOwnership BoardAction = "board:transfer_ownership"
+	ActionBoardShare             BoardAction = "board:share"
+	ActionBoardMove              BoardAction = "board:move"
+
+	ActionBoardMemberInvite     BoardAction = "member:invite"
+	ActionBoardMemberRemove     BoardAction = "member:remove"
+	ActionBoardMemberChangeRole BoardAction = "member:change_role"
+
+	ActionBoardRoleManage BoardAction = "role:manage"
+
+	ActionColumnView    BoardAction = "column:view"
+	ActionColumnCreate  BoardAction = "column:create"
+	ActionColumnUpdate  BoardAction = "column:update"
+	ActionColumnDelete  BoardAction = "column:delete"
+	ActionColumnArchive BoardAction = "column:archive"
+
+	ActionTaskView             BoardAction = "task:view"
+	ActionTaskCreate           BoardAction = "task:create"
+	ActionTaskUpdate           BoardAction = "task:update"
+	ActionTaskDelete           BoardAction = "task:delete"
+	ActionTaskArchive          BoardAction = "task:archive"
+	ActionTaskMove             BoardAction = "task:move"
+	ActionTaskAssign           BoardAction = "task:assign"
+	ActionTaskLabel            BoardAction = "task:label"
+	ActionTaskManageDependency BoardAction = "task:manage_dependency"
+
+	ActionCommentView   BoardAction = "comment:view"
+	ActionCommentCreate BoardAction = "comment:create"
+	ActionCommentDelete BoardAction = "comment:delete"
+
+	ActionSprintView   BoardAction = "sprint:view"
+	ActionSprintManage BoardAction = "sprint:manage"
+
+	ActionSavedViewUse    BoardAction = "view:use"
+	ActionSavedViewManage BoardAction = "view:manage"
+
+	ActionAnalyticsView BoardAction = "analytics:view"
+
+	ActionTrashView    BoardAction = "trash:view"
+	ActionTrashRestore BoardAction = "trash:restore"
+)
+
+// builtinPolicy lists every action with the lowest built-in role granted it.
+var builtinPolicy = []struct {
+	action BoardAction
+	role   RoleW
+}{
+	{ActionBoardView, Viewer},
+	{ActionBoardUpdate, Maintainer},
+	{ActionBoardDelete, Owner},
+	{ActionBoardArchive, Owner},
+	{ActionBoardTransferOwnership, Owner},
+	{ActionBoardShare, Owner},
+	{ActionBoardMove, Owner},
+
+	{ActionBoardMemberInvite, Maintainer},
+	{ActionBoardMemberRemove, Maintainer},
+	{ActionBoardMemberChangeRole, Maintainer},
+
+	{ActionBoardRoleManage, Maintainer},
+
+	{ActionColumnView, Viewer},
+	{ActionColumnCreate, Maintainer},
+	{ActionColumnUpdate, Editor},
+	{ActionColumnDelete, Editor},
+	{ActionColumnArchive, Maintainer},
+
+	{ActionTaskView, Viewer},
+	{ActionTaskCreate, Maintainer},
+	{ActionTaskUpdate, Maintainer},
+	{ActionTaskDelete, Maintainer},
+	{ActionTaskArchive, Maintainer},
+	{ActionTaskMove, Editor},
+	{ActionTaskAssign, Editor},
+	{ActionTaskLabel, Editor},
+	{ActionTaskManageDependency, Editor},
+
+	{ActionCommentView, Viewer},
+	{ActionCommentCreate, Editor},
+	{ActionCommentDelete, Maintainer},
+
+	{ActionSprintView, Viewer},
+	{ActionSprintManage, Maintainer},
+
+	{ActionSavedViewUse, Viewer},
+	{ActionSavedViewManage, Maintainer},
+
+	{ActionAnalyticsView, Viewer},
+
+	{ActionTrashView, Maintainer},
+	{ActionTrashRestore, Maintainer},
+}
+
+// IsValid reports whether the action is known, unknown actions are never granted.
+func (a BoardAction) IsValid() bool {
+	for _, rule := range builtinPolicy {
+		if rule.action == a {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPublic reports whether users who are not members of a public board may
+// perform the action.
+func (a BoardAction) IsPublic() bool {
+	return a == ActionBoardView || a == ActionColumnView || a == ActionTaskView
+}
@@ -1,8 +1,19 @@
 package domains
 
+import "time"
+
 type Board struct {
-	ID        uint
-	CreatedBy uint
-	Name      string
-	IsPrivate bool
+	ID          uint
+	CreatedBy   uint
+	Name        string
+	IsPrivate   bool
+	WorkspaceID *uint
+	ArchivedAt  *time.Time
+	DeletedBy   *uint
+}
+
+// BoardFilter narrows down the boards listed to a user.
+type BoardFilter struct {
+	WorkspaceID *uint
+	Search      string
 }
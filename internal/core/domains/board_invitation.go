@@ -0,0 +1,30 @@
+package domains
+
+import "time"
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationDeclined InvitationStatus = "declined"
+	InvitationExpired  InvitationStatus = "expired"
+)
+
+// BoardInvitation invites an email address to a board with a role. The address
+// does not need to belong to a registered user yet.
+type BoardInvitation struct {
+	ID          uint
+	BoardID     uint
+	Email       string
+	RoleID      uint
+	InvitedBy   uint
+	Status      InvitationStatus
+	TokenHash   string
+	ExpiresAt   time.Time
+	RespondedAt *time.Time
+	CreatedAt   time.Time
+
+	Board *Board
+	Role  *Role
+}
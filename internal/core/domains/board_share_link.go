@@ -0,0 +1,21 @@
+package domains
+
+import "time"
+
+// BoardShareLink gives anyone holding its token read-only access to the
+// columns and tasks of a board, until it expires or is revoked.
+type BoardShareLink struct {
+	ID        uint
+	BoardID   uint
+	CreatedBy uint
+	Prefix    string
+	TokenHash string
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// IsActive reports whether the link still grants access at the given time.
+func (l *BoardShareLink) IsActive(now time.Time) bool {
+	return l.RevokedAt == nil && (l.ExpiresAt == nil || now.Before(*l.ExpiresAt))
+}
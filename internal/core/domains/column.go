@@ -1,13 +1,16 @@
 package domains
 
+import "time"
+
 type Column struct {
-	ID            uint   `json:"id,omitempty"`
-	BoardID       uint   `json:"board_id,omitempty"`
-	Name          string `json:"name,omitempty"`
-	OrderPosition int    `json:"order_position,omitempty"`
-	IsFinal       bool   `json:"is_final,omitempty"`
-	CreatedBy     uint   `json:"created_by,omitempty"`
-	Board         *Board `json:"board,omitempty"`
+	ID            uint       `json:"id,omitempty"`
+	BoardID       uint       `json:"board_id,omitempty"`
+	Name          string     `json:"name,omitempty"`
+	OrderPosition int        `json:"order_position,omitempty"`
+	IsFinal       bool       `json:"is_final,omitempty"`
+	CreatedBy     uint       `json:"created_by,omitempty"`
+	ArchivedAt    *time.Time `json:"archived_at,omitempty"`
+	Board         *Board     `json:"board,omitempty"`
 }
 
 type ColumnUpdate struct {
@@ -0,0 +1,8 @@
+package domains
+
+type Label struct {
+	ID      uint
+	BoardID uint
+	Name    string
+	Color   string
+}
@@ -0,0 +1,82 @@
+package domains
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TokenResource is a group of endpoints a personal access token can be scoped to.
+type TokenResource string
+
+const (
+	ResourceBoards        TokenResource = "boards"
+	ResourceColumns       TokenResource = "columns"
+	ResourceTasks         TokenResource = "tasks"
+	ResourceSprints       TokenResource = "sprints"
+	ResourceViews         TokenResource = "views"
+	ResourceAnalytics     TokenResource = "analytics"
+	ResourceTrash         TokenResource = "trash"
+	ResourceNotifications TokenResource = "notifications"
+)
+
+var tokenResources = []TokenResource{
+	ResourceBoards,
+	ResourceColumns,
+	ResourceTasks,
+	ResourceSprints,
+	ResourceViews,
+	ResourceAnalytics,
+	ResourceTrash,
+	ResourceNotifications,
+}
+
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+// PersonalAccessToken lets scripts call the API on behalf of a user. Scopes
+// look like "tasks:read" or "tasks:write", write access includes read access.
+type PersonalAccessToken struct {
+	ID         uint
+	UserID     uint
+	Name       string
+	Prefix     string
+	TokenHash  string
+	Scopes     []string
+	BoardID    *uint
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// ValidateTokenScope checks a scope is "<resource>:read" or "<resource>:write".
+func ValidateTokenScope(scope string) error {
+	resource, access, ok := strings.Cut(scope, ":")
+	if !ok || !slices.Contains(tokenResources, TokenResource(resource)) || (access != ScopeRead && access != ScopeWrite) {
+		return fmt.Errorf("invalid scope: %s", scope)
+	}
+	return nil
+}
+
+func (t *PersonalAccessToken) IsActive(now time.Time) bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+	return t.ExpiresAt == nil || now.Before(*t.ExpiresAt)
+}
+
+// Allows reports whether the scopes grant read or write access to the resource.
+func (t *PersonalAccessToken) Allows(resource TokenResource, write bool) bool {
+	return ScopesAllow(t.Scopes, resource, write)
+}
+
+func ScopesAllow(scopes []string, resource TokenResource, write bool) bool {
+	if slices.Contains(scopes, string(resource)+":"+ScopeWrite) {
+		return true
+	}
+	return !write && slices.Contains(scopes, string(resource)+":"+ScopeRead)
+}
@@ -0,0 +1,12 @@
+package domains
+
+import "time"
+
+// RecoveryCode is a single-use code that replaces a TOTP code when the user
+// lost their authenticator. Only a hash of the code is kept.
+type RecoveryCode struct {
+	ID       uint
+	UserID   uint
+	CodeHash string
+	UsedAt   *time.Time
+}
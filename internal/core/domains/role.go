@@ -1,14 +1,63 @@
 package domains
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
+// Role is a named set of permissions a member holds on a board. Roles without
+// a board are available on every board, custom roles belong to one board.
 type Role struct {
 	ID          uint
 	Name        string
 	Description string
-	Weight      int
+	BoardID     *uint
+	Permissions []BoardAction
+}
+
+// Can reports whether the role grants the action.
+func (r *Role) Can(action BoardAction) bool {
+	return slices.Contains(r.Permissions, action)
+}
+
+// Covers reports whether the role grants every permission of the other role.
+func (r *Role) Covers(other *Role) bool {
+	for _, action := range other.Permissions {
+		if !r.Can(action) {
+			return false
+		}
+	}
+	return true
+}
+
+// BuiltIn reports whether the role is one of the roles seeded on startup.
+func (r *Role) BuiltIn() bool {
+	if r.BoardID != nil {
+		return false
+	}
+	_, err := ParseRole(r.Name)
+	return err == nil
 }
 
+// MergeRoles returns a role granting every permission of the roles, for
+// members holding a role on a board both directly and through teams.
+func MergeRoles(roles ...*Role) *Role {
+	if len(roles) == 1 {
+		return roles[0]
+	}
+	merged := &Role{}
+	for _, role := range roles {
+		for _, action := range role.Permissions {
+			if !merged.Can(action) {
+				merged.Permissions = append(merged.Permissions, action)
+			}
+		}
+	}
+	return merged
+}
+
+// RoleW names the built-in roles seeded on startup, ordered from the most to
+// the least permissions.
 type RoleW int
 
 const (
@@ -32,6 +81,17 @@ func (r RoleW) String() string {
 	return roleNames[r]
 }
 
+// Permissions returns the default permissions of the built-in role.
+func (r RoleW) Permissions() []BoardAction {
+	var permissions []BoardAction
+	for _, rule := range builtinPolicy {
+		if r <= rule.role {
+			permissions = append(permissions, rule.action)
+		}
+	}
+	return permissions
+}
+
 func ParseRole(s string) (RoleW, error) {
 	for i, name := range roleNames {
 		if name == s {
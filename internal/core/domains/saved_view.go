@@ -0,0 +1,17 @@
+package domains
+
+import "time"
+
+// SavedView is a named task listing of a board. It belongs to a user
+// and can be shared with every member of the board.
+type SavedView struct {
+	ID             uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	BoardID        uint
+	UserID         uint
+	Name           string
+	Filter         TaskFilter
+	VisibleColumns []uint
+	IsShared       bool
+}
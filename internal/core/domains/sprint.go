@@ -0,0 +1,41 @@
+package domains
+
+import "time"
+
+type SprintState string
+
+const (
+	SprintPlanned SprintState = "planned"
+	SprintActive  SprintState = "active"
+	SprintClosed  SprintState = "closed"
+)
+
+type Sprint struct {
+	ID        uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	BoardID   uint
+	CreatedBy uint
+	Name      string
+	Goal      string
+	StartDate time.Time
+	EndDate   time.Time
+	State     SprintState
+	StartedAt *time.Time
+	ClosedAt  *time.Time
+}
+
+// SprintTask records that a task was committed to a sprint, so a closed
+// sprint keeps its scope after unfinished tasks are carried over.
+type SprintTask struct {
+	SprintID  uint
+	TaskID    uint
+	CreatedAt time.Time
+	RemovedAt *time.Time
+}
+
+type BurndownPoint struct {
+	Date            time.Time
+	RemainingPoints int
+	IdealPoints     float64
+}
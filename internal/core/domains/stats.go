@@ -0,0 +1,12 @@
+package domains
+
+// SystemStats counts the main records of the installation for admins.
+type SystemStats struct {
+	Users           int64
+	DisabledUsers   int64
+	UnverifiedUsers int64
+	Workspaces      int64
+	Boards          int64
+	ArchivedBoards  int64
+	Tasks           int64
+}
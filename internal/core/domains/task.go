@@ -16,12 +16,14 @@ type Task struct {
 	ParentID      *uint
 	AssigneeID    *uint
 	ColumnID      uint
+	SprintID      *uint
 	OrderPosition int
 	Name          string
 	Description   string
 	StartDateTime *time.Time
 	EndDateTime   *time.Time
 	StoryPoint    int
+	ArchivedAt    *time.Time
 	Board         *Board
 	Creator       *User
 	Column        *Column
@@ -53,3 +55,75 @@ type TaskComment struct {
 	Task      *Task
 	User      *User
 }
+
+// TaskColumnTransition is a single move of a task between columns.
+// FromColumnID is nil for the transition recorded when the task is created.
+type TaskColumnTransition struct {
+	ID           uint
+	CreatedAt    time.Time
+	TaskID       uint
+	BoardID      uint
+	UserID       uint
+	FromColumnID *uint
+	ToColumnID   uint
+}
+
+type TaskSortField string
+
+const (
+	TaskSortOrderPosition TaskSortField = "order_position"
+	TaskSortCreatedAt     TaskSortField = "created_at"
+	TaskSortUpdatedAt     TaskSortField = "updated_at"
+	TaskSortName          TaskSortField = "name"
+	TaskSortStoryPoint    TaskSortField = "story_point"
+	TaskSortDueDate       TaskSortField = "end_datetime"
+)
+
+func (f TaskSortField) IsValid() bool {
+	switch f {
+	case TaskSortOrderPosition, TaskSortCreatedAt, TaskSortUpdatedAt, TaskSortName, TaskSortStoryPoint, TaskSortDueDate:
+		return true
+	default:
+		return false
+	}
+}
+
+type TaskGroupBy string
+
+const (
+	TaskGroupByNone     TaskGroupBy = ""
+	TaskGroupByColumn   TaskGroupBy = "column"
+	TaskGroupByAssignee TaskGroupBy = "assignee"
+	TaskGroupBySprint   TaskGroupBy = "sprint"
+)
+
+func (g TaskGroupBy) IsValid() bool {
+	switch g {
+	case TaskGroupByNone, TaskGroupByColumn, TaskGroupByAssignee, TaskGroupBySprint:
+		return true
+	default:
+		return false
+	}
+}
+
+// TaskSort orders a task listing. Tasks are ordered by GroupBy first,
+// so the groups stay contiguous across pages.
+type TaskSort struct {
+	GroupBy TaskGroupBy
+	Field   TaskSortField
+	Desc    bool
+}
+
+// TaskFilter narrows a board task listing. Empty fields are ignored,
+// DueFrom and DueTo are inclusive days compared against EndDateTime.
+type TaskFilter struct {
+	ColumnIDs   []uint
+	AssigneeIDs []uint
+	Unassigned  bool
+	SprintID    *uint
+	Backlog     bool
+	Search      string
+	DueFrom     *time.Time
+	DueTo       *time.Time
+	Sort        TaskSort
+}
@@ -0,0 +1,31 @@
+package domains
+
+type BulkTaskOperationType string
+
+const (
+	BulkTaskMove           BulkTaskOperationType = "move"
+	BulkTaskAssign         BulkTaskOperationType = "assign"
+	BulkTaskSetStoryPoints BulkTaskOperationType = "set_story_points"
+	BulkTaskAddLabel       BulkTaskOperationType = "add_label"
+	BulkTaskDelete         BulkTaskOperationType = "delete"
+	BulkTaskArchive        BulkTaskOperationType = "archive"
+)
+
+// BulkTaskOperation applies one operation to many tasks of a board.
+// Only the field of the operation type is used. When Atomic is set the
+// first failing task aborts the whole operation, otherwise every task
+// is tried and the failed ones are reported.
+type BulkTaskOperation struct {
+	Type       BulkTaskOperationType
+	TaskIDs    []uint
+	ColumnID   uint
+	AssigneeID uint
+	StoryPoint int
+	Label      string
+	Atomic     bool
+}
+
+type BulkTaskResult struct {
+	TaskID uint
+	Err    error
+}
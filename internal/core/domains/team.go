@@ -0,0 +1,35 @@
+package domains
+
+import "time"
+
+// Team is a group of workspace members that can be granted a role on the
+// boards of the workspace as a whole.
+type Team struct {
+	ID          uint
+	WorkspaceID uint
+	Name        string
+	CreatedBy   uint
+	CreatedAt   time.Time
+}
+
+type TeamMember struct {
+	ID        uint
+	TeamID    uint
+	UserID    uint
+	CreatedAt time.Time
+
+	User *User
+}
+
+// BoardTeam grants every member of a team a role on a board, like the
+// membership of a single user does.
+type BoardTeam struct {
+	ID        uint
+	BoardID   uint
+	TeamID    uint
+	RoleID    uint
+	CreatedAt time.Time
+
+	Team *Team
+	Role *Role
+}
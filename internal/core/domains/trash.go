@@ -0,0 +1,28 @@
+package domains
+
+import "time"
+
+type TrashItemType string
+
+const (
+	TrashItemTask   TrashItemType = "task"
+	TrashItemColumn TrashItemType = "column"
+)
+
+// TrashItem is a soft-deleted task or column that can still be restored
+// until the retention worker purges it.
+type TrashItem struct {
+	ID        uint
+	Type      TrashItemType
+	BoardID   uint
+	Name      string
+	DeletedAt time.Time
+	DeletedBy *User
+}
+
+// PurgeResult counts the rows hard-deleted by a retention run.
+type PurgeResult struct {
+	Boards  int64
+	Columns int64
+	Tasks   int64
+}
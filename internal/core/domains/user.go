@@ -1,17 +1,40 @@
 package domains
 
 import (
-	"crypto/sha256"
 	"fmt"
+	"strings"
+	"time"
+	"unicode"
 
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
 	"github.com/gofiber/fiber/v2"
 )
 
 var (
 	ErrUserNotFound    = fiber.NewError(fiber.StatusNotFound, "User not found")
 	ErrInvalidPassword = fiber.NewError(fiber.StatusUnauthorized, "Invalid user password")
+	ErrPasswordTooLong = fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("password must be at most %d bytes", password.MaxLength))
 )
 
+const defaultPasswordMinLength = 8
+
+// PasswordPolicy lists the rules a new password has to satisfy.
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// DefaultPasswordPolicy is used when no policy is configured, it keeps the
+// rules passwords had before the policy became configurable.
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:     defaultPasswordMinLength,
+	RequireUpper:  true,
+	RequireSymbol: true,
+}
+
 type UserRole uint8
 
 func (ur UserRole) String() string {
@@ -31,20 +54,97 @@ const (
 )
 
 type User struct {
-	ID       uint
-	Name     string
-	Email    string
-	Password string
-	Role     UserRole
+	ID              uint
+	Name            string
+	Email           string
+	Password        string
+	Role            UserRole
+	EmailVerifiedAt *time.Time
+	AvatarURL       string
+	Timezone        string
+	Locale          string
+	MFASecret       string
+	MFAEnabledAt    *time.Time
+	// DisabledAt is set while an admin has disabled the account
+	DisabledAt *time.Time
+	// PasswordResetRequired blocks password logins until the password is reset
+	PasswordResetRequired bool
+	CreatedAt             time.Time
+}
+
+// UserFilter narrows down the users listed to admins.
+type UserFilter struct {
+	Search   string
+	Disabled *bool
 }
 
-func (u *User) ValidatePassword() error {
+func (u *User) MFAEnabled() bool {
+	return u.MFAEnabledAt != nil
+}
+
+func (u *User) Disabled() bool {
+	return u.DisabledAt != nil
+}
+
+// ValidatePassword checks the plain text password of the user against the policy.
+func (u *User) ValidatePassword(policy PasswordPolicy) error {
+	if policy == (PasswordPolicy{}) {
+		policy = DefaultPasswordPolicy
+	}
+
+	minLength := policy.MinLength
+	if minLength <= 0 {
+		minLength = defaultPasswordMinLength
+	}
+
+	if len([]rune(u.Password)) < minLength {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("password must be at least %d characters", minLength))
+	}
+	if len(u.Password) > password.MaxLength {
+		return ErrPasswordTooLong
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range u.Password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+
+	var missing []string
+	if policy.RequireUpper && !hasUpper {
+		missing = append(missing, "an uppercase letter")
+	}
+	if policy.RequireLower && !hasLower {
+		missing = append(missing, "a lowercase letter")
+	}
+	if policy.RequireDigit && !hasDigit {
+		missing = append(missing, "a digit")
+	}
+	if policy.RequireSymbol && !hasSymbol {
+		missing = append(missing, "a symbol")
+	}
+	if len(missing) > 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "password must contain "+strings.Join(missing, ", "))
+	}
+
 	return nil
 }
 
+// HasPassword reports whether the user can log in with a password, users
+// created by an identity provider have none until they set one.
+func (u *User) HasPassword() bool {
+	return u.Password != ""
+}
+
+// PasswordIsValid checks a plain text password against the stored hash.
 func (u *User) PasswordIsValid(pass string) bool {
-	h := sha256.New()
-	h.Write([]byte(pass))
-	passSha256 := h.Sum(nil)
-	return fmt.Sprintf("%x", passSha256) == u.Password
+	return password.Compare(u.Password, pass)
 }
@@ -0,0 +1,9 @@
+package domains
+
+type UserIdentity struct {
+	ID       uint
+	UserID   uint
+	Provider string
+	Subject  string
+	Email    string
+}
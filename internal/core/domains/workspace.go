@@ -0,0 +1,49 @@
+package domains
+
+import (
+	"slices"
+	"time"
+)
+
+// WorkspaceRole is the role of a user in a workspace. Guests only see the
+// boards they are members of, everyone else also sees the public boards and
+// the member directory of the workspace.
+type WorkspaceRole string
+
+const (
+	WorkspaceRoleOwner  WorkspaceRole = "owner"
+	WorkspaceRoleAdmin  WorkspaceRole = "admin"
+	WorkspaceRoleMember WorkspaceRole = "member"
+	WorkspaceRoleGuest  WorkspaceRole = "guest"
+)
+
+// workspaceRoles lists the roles from the most to the least privileged.
+var workspaceRoles = []WorkspaceRole{WorkspaceRoleOwner, WorkspaceRoleAdmin, WorkspaceRoleMember, WorkspaceRoleGuest}
+
+func (r WorkspaceRole) IsValid() bool {
+	return slices.Contains(workspaceRoles, r)
+}
+
+// AtLeast reports whether the role is as privileged as the other role.
+func (r WorkspaceRole) AtLeast(other WorkspaceRole) bool {
+	i, j := slices.Index(workspaceRoles, r), slices.Index(workspaceRoles, other)
+	return i >= 0 && j >= 0 && i <= j
+}
+
+// Workspace groups boards and the users working on them.
+type Workspace struct {
+	ID        uint
+	Name      string
+	CreatedBy uint
+	CreatedAt time.Time
+}
+
+type WorkspaceMember struct {
+	ID          uint
+	WorkspaceID uint
+	UserID      uint
+	Role        WorkspaceRole
+	CreatedAt   time.Time
+
+	User *User
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+// AuditLogRepo stores the audit log, entries can not be changed or deleted.
+type AuditLogRepo interface {
+	Create(ctx context.Context, entry *domains.AuditLog) error
+	// GetAll lists the matching entries, newest first.
+	GetAll(ctx context.Context, filter domains.AuditLogFilter, limit, offset uint) ([]domains.AuditLog, uint, error)
+	// GetAfter lists the matching entries with an id above afterID, oldest first.
+	GetAfter(ctx context.Context, filter domains.AuditLogFilter, afterID uint, limit uint) ([]domains.AuditLog, error)
+}
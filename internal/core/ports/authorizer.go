@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+// Authorizer decides whether a user may perform an action on a board.
+// It returns nil when the action is allowed and a forbidden error otherwise.
+type Authorizer interface {
+	Authorize(ctx context.Context, userID, boardID uint, action domains.BoardAction) error
+	// AuthorizeRole also requires the role of the user to hold every permission
+	// of the roles, the roles being granted to or taken from another member.
+	AuthorizeRole(ctx context.Context, userID, boardID uint, action domains.BoardAction, roles ...*domains.Role) error
+	// MemberRole returns the role the user holds on the board directly and
+	// through teams, or nil when the user is not a member of the board.
+	MemberRole(ctx context.Context, userID, boardID uint) (*domains.Role, error)
+}
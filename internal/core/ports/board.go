@@ -9,6 +9,22 @@ type BoardRepo interface {
 	Create(ctx context.Context, board *domains.Board) error
 	GetByID(ctx context.Context, id uint) (*domains.Board, error)
 	Update(ctx context.Context, board *domains.Board) error
-	Delete(ctx context.Context, id uint) error
+	Delete(ctx context.Context, id uint, deletedBy uint) error
 	GetAll(ctx context.Context) ([]domains.Board, error)
+	// Search lists every board matching the filter, private and archived ones
+	// included, with the total count of matches.
+	Search(ctx context.Context, filter domains.BoardFilter, limit uint, offset uint) ([]domains.Board, uint, error)
+	// GetVisible returns the boards the user or a team of the user is a member
+	// of and the public boards of the user. Boards of a workspace are only
+	// visible to its members, and its public boards only to those who are not
+	// guests.
+	GetVisible(ctx context.Context, userID uint, filter domains.BoardFilter) ([]domains.Board, error)
+	// CountByWorkspace counts the boards of the workspace, deleted boards
+	// included until they are purged since they can still be restored.
+	CountByWorkspace(ctx context.Context, workspaceID uint) (int64, error)
+	SetWorkspace(ctx context.Context, id uint, workspaceID uint) error
+	Archive(ctx context.Context, id uint) error
+	Unarchive(ctx context.Context, id uint) error
+	GetDeletedByID(ctx context.Context, id uint) (*domains.Board, error)
+	Restore(ctx context.Context, id uint) error
 }
@@ -12,4 +12,6 @@ type BoardMemberRepo interface {
 	Delete(ctx context.Context, id uint) error
 	GetBoardMember(ctx context.Context, boardID, userID uint) (*domains.BoardMember, error)
 	GetBoardMembers(ctx context.Context, boardID uint) ([]domains.BoardMember, error)
+	GetUserMemberships(ctx context.Context, userID uint) ([]domains.BoardMember, error)
+	BackfillOwners(ctx context.Context, ownerRoleID uint) error
 }
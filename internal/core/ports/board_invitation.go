@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type BoardInvitationRepo interface {
+	Create(ctx context.Context, invitation *domains.BoardInvitation) error
+	GetByID(ctx context.Context, id uint) (*domains.BoardInvitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*domains.BoardInvitation, error)
+	GetByBoardID(ctx context.Context, boardID uint) ([]domains.BoardInvitation, error)
+	// GetPending returns the pending invitations of the email, on one board when boardID is not zero.
+	GetPending(ctx context.Context, email string, boardID uint) ([]domains.BoardInvitation, error)
+	// Respond moves a pending invitation to the status, it fails when the
+	// invitation is not pending anymore.
+	Respond(ctx context.Context, id uint, status domains.InvitationStatus, respondedAt time.Time) error
+	ExpireOverdue(ctx context.Context, now time.Time) error
+}
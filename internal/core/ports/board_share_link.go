@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type BoardShareLinkRepo interface {
+	Create(ctx context.Context, link *domains.BoardShareLink) error
+	GetByHash(ctx context.Context, tokenHash string) (*domains.BoardShareLink, error)
+	GetByBoardID(ctx context.Context, boardID uint) ([]domains.BoardShareLink, error)
+	Revoke(ctx context.Context, boardID uint, id uint) error
+}
@@ -2,14 +2,24 @@ package ports
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+var ErrCacheMiss = errors.New("cache: key not found")
+
 type CacheRepository interface {
 	// Set stores the value in the cache
 	Set(ctx context.Context, key string, value string, ttl time.Duration) error
-	// Get retrieves the value from the cache
+	// SetNX stores the value only if the key is missing and reports whether it did
+	SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	// Get retrieves the value from the cache, returning ErrCacheMiss for a missing key
 	Get(ctx context.Context, key string) (string, error)
+	// GetDel retrieves and removes the value in one step, returning ErrCacheMiss for a missing key
+	GetDel(ctx context.Context, key string) (string, error)
+	// Incr increments an integer value and returns the result, keeping its expiration.
+	// It returns ErrCacheMiss instead of creating a missing key
+	Incr(ctx context.Context, key string) (int64, error)
 	// Delete removes the value from the cache
 	Delete(ctx context.Context, key string) error
 	// DeleteByPrefix removes the value from the cache with the given prefix
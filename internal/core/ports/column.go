@@ -14,5 +14,10 @@ type ColumnRepo interface {
 	Update(ctx context.Context, updateColumn *domains.ColumnUpdate) error
 	Move(ctx context.Context, moveColumn *domains.ColumnMove) error
 	Final(ctx context.Context, id uint) error
-	Delete(ctx context.Context, id uint) error
+	Delete(ctx context.Context, id uint, deletedBy uint, moveTo *uint) error
+	Archive(ctx context.Context, id uint) error
+	Unarchive(ctx context.Context, id uint) error
+	GetDeletedListByBoardID(ctx context.Context, boardID uint) ([]domains.TrashItem, error)
+	GetDeletedByID(ctx context.Context, id uint) (*domains.Column, error)
+	Restore(ctx context.Context, id uint) error
 }
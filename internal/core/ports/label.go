@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type LabelRepo interface {
+	// GetOrCreate returns the label of the board with the given name, creating it when missing.
+	GetOrCreate(ctx context.Context, boardID uint, name string) (*domains.Label, error)
+	AddToTask(ctx context.Context, taskID uint, labelID uint) error
+}
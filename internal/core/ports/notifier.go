@@ -12,8 +12,12 @@ type NotificationInput struct {
 type Notifier interface {
 	SendInAppNotification(ctx context.Context, userID uint, input NotificationInput) error
 	SendEmailNotification(to string, subject string, body string) error
+	// SendTemplatedEmail renders the named HTML email template with data and sends it
+	SendTemplatedEmail(to string, subject string, templateName string, data any) error
 }
 
 var (
 	NewTaskAssignedNotification = "new-task-assigned"
+	BoardInvitationNotification = "board-invitation"
+	TeamNotification            = "team"
 )
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type PersonalAccessTokenRepo interface {
+	Create(ctx context.Context, token *domains.PersonalAccessToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*domains.PersonalAccessToken, error)
+	GetByUserID(ctx context.Context, userID uint) ([]domains.PersonalAccessToken, error)
+	Revoke(ctx context.Context, userID uint, id uint) error
+	RevokeByUserID(ctx context.Context, userID uint) error
+	TouchLastUsed(ctx context.Context, id uint, usedAt time.Time) error
+}
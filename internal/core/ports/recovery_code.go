@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type RecoveryCodeRepo interface {
+	// Replace drops the recovery codes of the user and stores the given hashes instead.
+	Replace(ctx context.Context, userID uint, codeHashes []string) error
+	GetUnused(ctx context.Context, userID uint) ([]domains.RecoveryCode, error)
+	// MarkUsed marks an unused code as used, reporting false when it was used already.
+	MarkUsed(ctx context.Context, id uint) (bool, error)
+	CountUnused(ctx context.Context, userID uint) (int64, error)
+	DeleteByUserID(ctx context.Context, userID uint) error
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type RetentionRepo interface {
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (domains.PurgeResult, error)
+}
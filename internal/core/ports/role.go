@@ -11,5 +11,11 @@ type RoleRepository interface {
 	GetAll(ctx context.Context) ([]domains.Role, error)
 	Update(ctx context.Context, role *domains.Role) error
 	Delete(ctx context.Context, id uint) error
+	// GetByName finds a role available on every board.
 	GetByName(ctx context.Context, name string) (*domains.Role, error)
+	GetBoardRoleByName(ctx context.Context, boardID uint, name string) (*domains.Role, error)
+	GetBoardRoles(ctx context.Context, boardID uint) ([]domains.Role, error)
+	// InUse reports whether the role is held by a board member or team, or
+	// offered by a pending invitation.
+	InUse(ctx context.Context, id uint) (bool, error)
 }
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type SavedViewRepo interface {
+	Create(ctx context.Context, view *domains.SavedView) error
+	GetByID(ctx context.Context, id uint) (*domains.SavedView, error)
+	Update(ctx context.Context, view *domains.SavedView) error
+	Delete(ctx context.Context, id uint) error
+	// GetListByBoardID returns the views of the user and the shared views of the board.
+	GetListByBoardID(ctx context.Context, boardID uint, userID uint) ([]domains.SavedView, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type SprintRepo interface {
+	Create(ctx context.Context, sprint *domains.Sprint) error
+	GetByID(ctx context.Context, id uint) (*domains.Sprint, error)
+	Update(ctx context.Context, sprint *domains.Sprint) error
+	Delete(ctx context.Context, id uint) error
+	GetListByBoardID(ctx context.Context, boardID uint) ([]domains.Sprint, error)
+	GetActiveByBoardID(ctx context.Context, boardID uint) (*domains.Sprint, error)
+	AssignTasks(ctx context.Context, sprintID uint, taskIDs []uint) error
+	RemoveTask(ctx context.Context, sprintID uint, taskID uint) error
+	GetSprintTasks(ctx context.Context, sprintID uint) ([]domains.SprintTask, error)
+	// Close stores the state of the closed sprint and carries its unfinished
+	// tasks over to toSprintID, or back to the backlog when it is nil.
+	Close(ctx context.Context, sprint *domains.Sprint, toSprintID *uint, taskIDs []uint) error
+}
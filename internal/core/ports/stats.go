@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type StatsRepo interface {
+	GetSystemStats(ctx context.Context) (domains.SystemStats, error)
+}
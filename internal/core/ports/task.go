@@ -10,8 +10,8 @@ type TaskRepo interface {
 	Create(ctx context.Context, task *domains.Task) error
 	GetByID(ctx context.Context, id uint) (*domains.Task, error)
 	Update(ctx context.Context, task *domains.Task) error
-	Delete(ctx context.Context, id uint) error
-	GetListByBoardID(ctx context.Context, boardID uint, limit uint, offset uint) ([]domains.Task, uint, error)
+	Delete(ctx context.Context, id uint, deletedBy uint) error
+	GetListByBoardID(ctx context.Context, boardID uint, filter domains.TaskFilter, limit uint, offset uint) ([]domains.Task, uint, error)
 	GetTaskDependencies(ctx context.Context, taskID uint) ([]domains.TaskDependency, error)
 	AddTaskDependency(ctx context.Context, taskID, dependentTaskID uint) error
 	RemoveTaskDependency(ctx context.Context, taskID, dependentTaskID uint) error
@@ -19,6 +19,16 @@ type TaskRepo interface {
 	GetAllTaskDependencies(ctx context.Context) ([]domains.TaskDependency, error)
 	GetTaskChildren(ctx context.Context, taskID uint) ([]domains.TaskChild, error)
 	AssignUserToTask(ctx context.Context, taskID uint, userID uint) error
+	GetListByIDs(ctx context.Context, ids []uint) ([]domains.Task, error)
+	AddColumnTransition(ctx context.Context, transition *domains.TaskColumnTransition) error
+	GetColumnTransitions(ctx context.Context, taskIDs []uint) ([]domains.TaskColumnTransition, error)
+	GetColumnTransitionsByBoardID(ctx context.Context, boardID uint) ([]domains.TaskColumnTransition, error)
+	Archive(ctx context.Context, id uint) error
+	Unarchive(ctx context.Context, id uint) error
+	GetDeletedListByBoardID(ctx context.Context, boardID uint) ([]domains.TrashItem, error)
+	GetDeletedByID(ctx context.Context, id uint) (*domains.Task, error)
+	Restore(ctx context.Context, id uint, clearParent bool) error
+	UnassignUser(ctx context.Context, userID uint, reassignTo *uint) error
 }
 
 type TaskCommentRepo interface {
@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type TeamRepo interface {
+	Create(ctx context.Context, team *domains.Team) error
+	GetByID(ctx context.Context, id uint) (*domains.Team, error)
+	Update(ctx context.Context, team *domains.Team) error
+	// Delete removes the team with its members and board roles.
+	Delete(ctx context.Context, id uint) error
+	GetByWorkspaceID(ctx context.Context, workspaceID uint) ([]domains.Team, error)
+}
+
+type TeamMemberRepo interface {
+	Create(ctx context.Context, member *domains.TeamMember) error
+	GetMember(ctx context.Context, teamID, userID uint) (*domains.TeamMember, error)
+	GetMembers(ctx context.Context, teamID uint) ([]domains.TeamMember, error)
+	Delete(ctx context.Context, id uint) error
+	// DeleteByWorkspaceUser removes the user from every team of the workspace.
+	DeleteByWorkspaceUser(ctx context.Context, workspaceID, userID uint) error
+}
+
+type BoardTeamRepo interface {
+	Create(ctx context.Context, boardTeam *domains.BoardTeam) error
+	GetBoardTeam(ctx context.Context, boardID, teamID uint) (*domains.BoardTeam, error)
+	GetByBoardID(ctx context.Context, boardID uint) ([]domains.BoardTeam, error)
+	UpdateRole(ctx context.Context, id uint, roleID uint) error
+	Delete(ctx context.Context, id uint) error
+	// GetUserRoleIDs returns the roles the teams of the user hold on the board.
+	GetUserRoleIDs(ctx context.Context, boardID, userID uint) ([]uint, error)
+}
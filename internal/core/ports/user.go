@@ -2,6 +2,8 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 )
 
@@ -9,4 +11,13 @@ type UserRepo interface {
 	Create(ctx context.Context, user *domains.User) error
 	GetByID(ctx context.Context, id uint) (*domains.User, error)
 	GetByEmail(ctx context.Context, email string) (*domains.User, error)
+	UpdatePassword(ctx context.Context, id uint, passwordHash string) error
+	MarkEmailVerified(ctx context.Context, id uint) error
+	Update(ctx context.Context, user *domains.User) error
+	Anonymize(ctx context.Context, id uint) error
+	UpdateMFA(ctx context.Context, id uint, secret string, enabledAt *time.Time) error
+	// GetAll lists the users matching the filter with the total count of matches.
+	GetAll(ctx context.Context, filter domains.UserFilter, limit uint, offset uint) ([]domains.User, uint, error)
+	SetDisabledAt(ctx context.Context, id uint, disabledAt *time.Time) error
+	SetPasswordResetRequired(ctx context.Context, id uint, required bool) error
 }
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type UserIdentityRepo interface {
+	GetByProviderSubject(ctx context.Context, provider string, subject string) (*domains.UserIdentity, error)
+	Create(ctx context.Context, identity *domains.UserIdentity) error
+	Delete(ctx context.Context, id uint) error
+}
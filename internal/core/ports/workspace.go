@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+type WorkspaceRepo interface {
+	Create(ctx context.Context, workspace *domains.Workspace) error
+	GetByID(ctx context.Context, id uint) (*domains.Workspace, error)
+	Update(ctx context.Context, workspace *domains.Workspace) error
+	Delete(ctx context.Context, id uint) error
+	// GetByUserID returns the workspaces the user is a member of.
+	GetByUserID(ctx context.Context, userID uint) ([]domains.Workspace, error)
+}
+
+type WorkspaceMemberRepo interface {
+	Create(ctx context.Context, member *domains.WorkspaceMember) error
+	GetMember(ctx context.Context, workspaceID, userID uint) (*domains.WorkspaceMember, error)
+	// GetMembers returns the members of the workspace with their users, the
+	// search matches the name or email of the users.
+	GetMembers(ctx context.Context, workspaceID uint, search string) ([]domains.WorkspaceMember, error)
+	UpdateRole(ctx context.Context, id uint, role domains.WorkspaceRole) error
+	Delete(ctx context.Context, id uint) error
+	CountByRole(ctx context.Context, workspaceID uint, role domains.WorkspaceRole) (int64, error)
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidResetToken        = fiber.NewError(fiber.StatusBadRequest, "invalid or expired password reset token")
+	ErrInvalidVerificationToken = fiber.NewError(fiber.StatusBadRequest, "invalid or expired email verification token")
+	ErrTooManyEmailRequests     = fiber.NewError(fiber.StatusTooManyRequests, "an email was sent to this address recently, please try again later")
+)
+
+const (
+	passwordResetKeyPrefix     = "auth:password_reset:"
+	emailVerificationKeyPrefix = "auth:email_verification:"
+	emailCooldownKeyPrefix     = "auth:email_cooldown:"
+
+	passwordResetTemplate     = "password_reset.html"
+	emailVerificationTemplate = "verify_email.html"
+	emailChangedTemplate      = "email_changed.html"
+
+	defaultResetTokenExp        = 30 * time.Minute
+	defaultVerificationTokenExp = 24 * time.Hour
+	defaultEmailCooldown        = time.Minute
+)
+
+type AccountSettings struct {
+	LinkBaseURL          string
+	ResetTokenExp        time.Duration
+	VerificationTokenExp time.Duration
+	EmailCooldown        time.Duration
+}
+
+type accountEmailData struct {
+	Name      string
+	Link      string
+	ExpiresIn string
+}
+
+type emailChangedData struct {
+	Name     string
+	NewEmail string
+}
+
+// AccountService handles password recovery and email verification. Tokens are
+// random strings mailed to the user; only their SHA-256 digest is stored in
+// the cache, and each one is deleted on first use. A token is bound to the
+// address it was mailed to and stops working when the email changes.
+type AccountService struct {
+	userRepo       ports.UserRepo
+	cache          ports.CacheRepository
+	notifier       ports.Notifier
+	authService    *AuthService
+	hasher         *password.Hasher
+	passwordPolicy domains.PasswordPolicy
+	settings       AccountSettings
+}
+
+func NewAccountService(userRepo ports.UserRepo, cache ports.CacheRepository, notifier ports.Notifier, authService *AuthService,
+	hasher *password.Hasher, passwordPolicy domains.PasswordPolicy, settings AccountSettings) *AccountService {
+	if settings.ResetTokenExp <= 0 {
+		settings.ResetTokenExp = defaultResetTokenExp
+	}
+	if settings.VerificationTokenExp <= 0 {
+		settings.VerificationTokenExp = defaultVerificationTokenExp
+	}
+	if settings.EmailCooldown <= 0 {
+		settings.EmailCooldown = defaultEmailCooldown
+	}
+	return &AccountService{
+		userRepo:       userRepo,
+		cache:          cache,
+		notifier:       notifier,
+		authService:    authService,
+		hasher:         hasher,
+		passwordPolicy: passwordPolicy,
+		settings:       settings,
+	}
+}
+
+// ForgotPassword mails a password reset link. It succeeds for unknown
+// addresses too, so the endpoint does not reveal which emails are registered.
+func (s *AccountService) ForgotPassword(ctx context.Context, email string) error {
+	if err := s.checkEmailCooldown(ctx, "password_reset", email); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := s.storeToken(ctx, passwordResetKeyPrefix, user, s.settings.ResetTokenExp)
+	if err != nil {
+		return err
+	}
+
+	return s.sendEmail(user, "Reset your password", passwordResetTemplate, "/password/reset", token, s.settings.ResetTokenExp)
+}
+
+// ResetPassword sets a new password using a reset token and logs the user out of all sessions.
+func (s *AccountService) ResetPassword(ctx context.Context, token string, newPassword string) error {
+	// the token stays usable when the password is rejected
+	if err := (&domains.User{Password: newPassword}).ValidatePassword(s.passwordPolicy); err != nil {
+		return err
+	}
+
+	user, err := s.consumeToken(ctx, passwordResetKeyPrefix, token, ErrInvalidResetToken)
+	if err != nil {
+		return err
+	}
+	userID := user.ID
+
+	hash, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	if err := s.userRepo.UpdatePassword(ctx, userID, hash); err != nil {
+		return err
+	}
+	if err := s.userRepo.SetPasswordResetRequired(ctx, userID, false); err != nil {
+		return err
+	}
+
+	return s.authService.RevokeUserSessions(ctx, userID)
+}
+
+// ForcePasswordReset logs the user out of all sessions, revokes their personal
+// access tokens and mails a password reset link. Password logins are refused
+// until the password is reset.
+func (s *AccountService) ForcePasswordReset(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.SetPasswordResetRequired(ctx, userID, true); err != nil {
+		return err
+	}
+	if err := s.authService.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.authService.RevokePersonalAccessTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	token, err := s.storeToken(ctx, passwordResetKeyPrefix, user, s.settings.ResetTokenExp)
+	if err != nil {
+		return err
+	}
+
+	return s.sendEmail(user, "Reset your password", passwordResetTemplate, "/password/reset", token, s.settings.ResetTokenExp)
+}
+
+// SendVerificationEmail mails an email verification link unless the address is already verified.
+func (s *AccountService) SendVerificationEmail(ctx context.Context, user *domains.User) error {
+	if user.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	if err := s.checkEmailCooldown(ctx, "verification", user.Email); err != nil {
+		return err
+	}
+
+	token, err := s.storeToken(ctx, emailVerificationKeyPrefix, user, s.settings.VerificationTokenExp)
+	if err != nil {
+		return err
+	}
+
+	return s.sendEmail(user, "Verify your email", emailVerificationTemplate, "/email/verify", token, s.settings.VerificationTokenExp)
+}
+
+// ResendVerificationEmail mails a new verification link, silently ignoring unknown addresses.
+func (s *AccountService) ResendVerificationEmail(ctx context.Context, email string) error {
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if isNotFound(err) {
+			return s.checkEmailCooldown(ctx, "verification", email)
+		}
+		return err
+	}
+	return s.SendVerificationEmail(ctx, user)
+}
+
+// VerifyEmail marks the email of the user as verified and returns the user id.
+func (s *AccountService) VerifyEmail(ctx context.Context, token string) (uint, error) {
+	user, err := s.consumeToken(ctx, emailVerificationKeyPrefix, token, ErrInvalidVerificationToken)
+	if err != nil {
+		return 0, err
+	}
+
+	return user.ID, s.userRepo.MarkEmailVerified(ctx, user.ID)
+}
+
+// SendEmailChangedNotice tells the previous address of the user that the email
+// was changed, so the owner notices when somebody else took over the account.
+func (s *AccountService) SendEmailChangedNotice(user *domains.User, oldEmail string) error {
+	data := emailChangedData{Name: user.Name, NewEmail: user.Email}
+	if err := s.notifier.SendTemplatedEmail(oldEmail, "Your email address was changed", emailChangedTemplate, data); err != nil {
+		log.ErrorLog.Printf("Error sending %s to user %d: %v\n", emailChangedTemplate, user.ID, err)
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: "Failed to send email"}
+	}
+	return nil
+}
+
+// RevokeTokens deletes the outstanding password reset and verification tokens
+// of the user, they were mailed to an address the user no longer has.
+func (s *AccountService) RevokeTokens(ctx context.Context, userID uint) error {
+	for _, keyPrefix := range []string{passwordResetKeyPrefix, emailVerificationKeyPrefix} {
+		if err := s.cache.DeleteByPrefix(ctx, fmt.Sprintf("%s%d:", keyPrefix, userID)); err != nil {
+			return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+// checkEmailCooldown allows one email per purpose and address within the cooldown.
+func (s *AccountService) checkEmailCooldown(ctx context.Context, purpose string, email string) error {
+	key := emailCooldownKeyPrefix + purpose + ":" + strings.ToLower(strings.TrimSpace(email))
+
+	_, err := s.cache.Get(ctx, key)
+	if err == nil {
+		return ErrTooManyEmailRequests
+	}
+	if !errors.Is(err, ports.ErrCacheMiss) {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	if err := s.cache.Set(ctx, key, "1", s.settings.EmailCooldown); err != nil {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	return nil
+}
+
+// storeToken creates a token of the form "<user id>.<random>" and stores
+// "<user id>:<email>" under its digest, keyed by user so RevokeTokens finds it.
+func (s *AccountService) storeToken(ctx context.Context, keyPrefix string, user *domains.User, ttl time.Duration) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	token := strconv.FormatUint(uint64(user.ID), 10) + "." + base64.RawURLEncoding.EncodeToString(buf)
+
+	value := strconv.FormatUint(uint64(user.ID), 10) + ":" + user.Email
+	if err := s.cache.Set(ctx, tokenKey(keyPrefix, user.ID, token), value, ttl); err != nil {
+		return "", &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	return token, nil
+}
+
+// consumeToken removes a token and returns its user, so a token is only good
+// once. Tokens mailed to an address the user has changed since are invalid.
+func (s *AccountService) consumeToken(ctx context.Context, keyPrefix string, token string, errInvalid error) (*domains.User, error) {
+	rawID, _, found := strings.Cut(token, ".")
+	if !found {
+		return nil, errInvalid
+	}
+	userID, err := strconv.ParseUint(rawID, 10, 64)
+	if err != nil {
+		return nil, errInvalid
+	}
+
+	value, err := s.cache.GetDel(ctx, tokenKey(keyPrefix, uint(userID), token))
+	if err != nil {
+		if errors.Is(err, ports.ErrCacheMiss) {
+			return nil, errInvalid
+		}
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	storedID, email, found := strings.Cut(value, ":")
+	if !found || storedID != rawID {
+		return nil, errInvalid
+	}
+
+	user, err := s.userRepo.GetByID(ctx, uint(userID))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errInvalid
+		}
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, email) {
+		return nil, errInvalid
+	}
+	return user, nil
+}
+
+func tokenKey(keyPrefix string, userID uint, token string) string {
+	return fmt.Sprintf("%s%d:%s", keyPrefix, userID, hashToken(token))
+}
+
+func (s *AccountService) sendEmail(user *domains.User, subject string, templateName string, path string, token string, exp time.Duration) error {
+	data := accountEmailData{
+		Name:      user.Name,
+		Link:      strings.TrimRight(s.settings.LinkBaseURL, "/") + path + "?token=" + url.QueryEscape(token),
+		ExpiresIn: formatDuration(exp),
+	}
+
+	if err := s.notifier.SendTemplatedEmail(user.Email, subject, templateName, data); err != nil {
+		log.ErrorLog.Printf("Error sending %s to user %d: %v\n", templateName, user.ID, err)
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: "Failed to send email"}
+	}
+	return nil
+}
+
+func hashToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
+
+func formatDuration(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%d hours", int(d/time.Hour))
+	}
+	return fmt.Sprintf("%d minutes", int(d/time.Minute))
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type sentEmail struct {
+	to       string
+	template string
+	data     any
+}
+
+// recordingMailer keeps the emails sent instead of sending them.
+type recordingMailer struct {
+	ports.Notifier
+	sent *[]sentEmail
+}
+
+func (m recordingMailer) SendTemplatedEmail(to string, _ string, templateName string, data any) error {
+	*m.sent = append(*m.sent, sentEmail{to: to, template: templateName, data: data})
+	return nil
+}
+
+// lastToken returns the token of the link in the last account email.
+func lastToken(t *testing.T, sent []sentEmail) string {
+	t.Helper()
+	if len(sent) == 0 {
+		t.Fatal("expected an email to be sent")
+	}
+	data, ok := sent[len(sent)-1].data.(accountEmailData)
+	if !ok {
+		t.Fatalf("expected an account email, got %+v", sent[len(sent)-1])
+	}
+	link, err := url.Parse(data.Link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return link.Query().Get("token")
+}
+
+func newTestAccountService(user *domains.User, cache ports.CacheRepository, sent *[]sentEmail) *AccountService {
+	return NewAccountService(authUserRepo{user: user}, cache, recordingMailer{sent: sent}, nil,
+		password.NewHasher(bcrypt.MinCost), domains.PasswordPolicy{}, AccountSettings{LinkBaseURL: "https://example.com"})
+}
+
+func TestVerifyEmailRejectsTokensOfAnOldAddress(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "old@example.com"}
+	var sent []sentEmail
+	service := newTestAccountService(user, newMemoryCache(), &sent)
+	ctx := context.Background()
+
+	if err := service.SendVerificationEmail(ctx, user); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	token := lastToken(t, sent)
+
+	user.Email = "new@example.com"
+	if _, err := service.VerifyEmail(ctx, token); !errors.Is(err, ErrInvalidVerificationToken) {
+		t.Fatalf("expected a token mailed to the old address to be refused, got %v", err)
+	}
+	if user.EmailVerifiedAt != nil {
+		t.Fatal("expected the new address to stay unverified")
+	}
+
+	if err := service.SendVerificationEmail(ctx, user); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	userID, err := service.VerifyEmail(ctx, lastToken(t, sent))
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if userID != user.ID || user.EmailVerifiedAt == nil {
+		t.Errorf("expected user %d to be verified, got user %d verified at %v", user.ID, userID, user.EmailVerifiedAt)
+	}
+}
+
+func TestRevokeTokens(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "owner@example.com"}
+	var sent []sentEmail
+	service := newTestAccountService(user, newMemoryCache(), &sent)
+	ctx := context.Background()
+
+	if err := service.SendVerificationEmail(ctx, user); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	verificationToken := lastToken(t, sent)
+	if err := service.ForgotPassword(ctx, user.Email); err != nil {
+		t.Fatalf("forgot password: %v", err)
+	}
+	resetToken := lastToken(t, sent)
+
+	if err := service.RevokeTokens(ctx, user.ID); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+
+	if _, err := service.VerifyEmail(ctx, verificationToken); !errors.Is(err, ErrInvalidVerificationToken) {
+		t.Errorf("expected the verification token to be revoked, got %v", err)
+	}
+	if err := service.ResetPassword(ctx, resetToken, "Secret#2"); !errors.Is(err, ErrInvalidResetToken) {
+		t.Errorf("expected the reset token to be revoked, got %v", err)
+	}
+}
+
+func TestResetPasswordUsesTheTokenOnce(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "owner@example.com"}
+	cache := newMemoryCache()
+	var sent []sentEmail
+	service := NewAccountService(authUserRepo{user: user}, cache, recordingMailer{sent: &sent}, newTestAuthService(t, user, cache),
+		password.NewHasher(bcrypt.MinCost), domains.PasswordPolicy{}, AccountSettings{LinkBaseURL: "https://example.com"})
+	ctx := context.Background()
+
+	if err := service.ForgotPassword(ctx, user.Email); err != nil {
+		t.Fatalf("forgot password: %v", err)
+	}
+	token := lastToken(t, sent)
+
+	if err := service.ResetPassword(ctx, token, "weak"); err == nil || errors.Is(err, ErrInvalidResetToken) {
+		t.Fatalf("expected the weak password to be refused, got %v", err)
+	}
+	if err := service.ResetPassword(ctx, token, "Secret#2"); err != nil {
+		t.Fatalf("expected the token to survive a refused password, got %v", err)
+	}
+	if err := service.ResetPassword(ctx, token, "Secret#3"); !errors.Is(err, ErrInvalidResetToken) {
+		t.Errorf("expected a used token to be refused, got %v", err)
+	}
+	if !password.Compare(user.Password, "Secret#2") {
+		t.Error("expected the password of the first reset to be kept")
+	}
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrCannotDisableSelf = fiber.NewError(fiber.StatusBadRequest, "admins can not disable their own account")
+)
+
+// AdminService backs the system administration API, its routes are only open
+// to users with the admin role.
+type AdminService struct {
+	userRepo       ports.UserRepo
+	boardRepo      ports.BoardRepo
+	statsRepo      ports.StatsRepo
+	authService    *AuthService
+	accountService *AccountService
+}
+
+func NewAdminService(userRepo ports.UserRepo, boardRepo ports.BoardRepo, statsRepo ports.StatsRepo, authService *AuthService,
+	accountService *AccountService) *AdminService {
+	return &AdminService{
+		userRepo:       userRepo,
+		boardRepo:      boardRepo,
+		statsRepo:      statsRepo,
+		authService:    authService,
+		accountService: accountService,
+	}
+}
+
+func (s *AdminService) GetUsers(ctx context.Context, filter domains.UserFilter, pageNumber uint, pageSize uint) ([]domains.User, uint, error) {
+	return s.userRepo.GetAll(ctx, filter, pageSize, (pageNumber-1)*pageSize)
+}
+
+func (s *AdminService) GetUser(ctx context.Context, id uint) (*domains.User, error) {
+	return s.userRepo.GetByID(ctx, id)
+}
+
+// DisableUser disables the account and logs the user out of all sessions.
+// Disabled users can not log in, refresh tokens or use personal access tokens.
+func (s *AdminService) DisableUser(ctx context.Context, actorID, id uint) error {
+	if actorID == id {
+		return ErrCannotDisableSelf
+	}
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user.Disabled() {
+		return nil
+	}
+
+	now := time.Now()
+	if err = s.userRepo.SetDisabledAt(ctx, id, &now); err != nil {
+		return err
+	}
+	return s.authService.RevokeUserSessions(ctx, id)
+}
+
+func (s *AdminService) EnableUser(ctx context.Context, id uint) error {
+	return s.userRepo.SetDisabledAt(ctx, id, nil)
+}
+
+func (s *AdminService) ForcePasswordReset(ctx context.Context, id uint) error {
+	return s.accountService.ForcePasswordReset(ctx, id)
+}
+
+func (s *AdminService) GetBoards(ctx context.Context, filter domains.BoardFilter, pageNumber uint, pageSize uint) ([]domains.Board, uint, error) {
+	return s.boardRepo.Search(ctx, filter, pageSize, (pageNumber-1)*pageSize)
+}
+
+func (s *AdminService) GetStats(ctx context.Context) (domains.SystemStats, error) {
+	return s.statsRepo.GetSystemStats(ctx)
+}
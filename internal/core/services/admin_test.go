@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type adminTest struct {
+	service *AdminService
+	auth    *AuthService
+	account *AccountService
+	user    *domains.User
+	revoked []uint
+	sent    []sentEmail
+}
+
+// newAdminTest returns an admin service managing a user who logs in with Secret#1.
+func newAdminTest(t *testing.T) *adminTest {
+	t.Helper()
+	hash, err := password.NewHasher(bcrypt.MinCost).Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := &adminTest{user: &domains.User{ID: editorID, Email: "editor@example.com", Password: hash, Role: domains.UserRoleUser}}
+
+	cache := newMemoryCache()
+	test.auth = newTestAuthService(t, test.user, cache)
+	test.auth.tokenRepo = revokingTokenRepo{revoked: &test.revoked}
+	test.account = newTestAccountService(test.user, cache, &test.sent)
+	test.account.authService = test.auth
+	test.service = NewAdminService(authUserRepo{user: test.user}, nil, nil, test.auth, test.account)
+	return test
+}
+
+// login starts a session and waits for the next millisecond, revocations
+// spare the tokens issued in the same millisecond.
+func (test *adminTest) login(t *testing.T) *UserToken {
+	t.Helper()
+	result, err := test.auth.Login(context.Background(), test.user.Email, "Secret#1")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	time.Sleep(time.Millisecond)
+	return result.Token
+}
+
+func TestAdminForcePasswordReset(t *testing.T) {
+	test := newAdminTest(t)
+	ctx := context.Background()
+	token := test.login(t)
+
+	if err := test.service.ForcePasswordReset(ctx, test.user.ID); err != nil {
+		t.Fatalf("force password reset: %v", err)
+	}
+
+	if _, err := test.auth.ValidateAccessToken(ctx, token.AuthorizationToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("expected the session to end, got %v", err)
+	}
+	if !reflect.DeepEqual(test.revoked, []uint{test.user.ID}) {
+		t.Errorf("expected the personal access tokens of the user to be revoked, got %v", test.revoked)
+	}
+	if _, err := test.auth.Login(ctx, test.user.Email, "Secret#1"); !errors.Is(err, ErrPasswordResetRequired) {
+		t.Errorf("expected password logins to be refused, got %v", err)
+	}
+
+	if err := test.account.ResetPassword(ctx, lastToken(t, test.sent), "Secret#2"); err != nil {
+		t.Fatalf("reset password: %v", err)
+	}
+	if _, err := test.auth.Login(ctx, test.user.Email, "Secret#2"); err != nil {
+		t.Errorf("expected the new password to log in, got %v", err)
+	}
+}
+
+func TestAdminDisableUser(t *testing.T) {
+	test := newAdminTest(t)
+	ctx := context.Background()
+	token := test.login(t)
+
+	if err := test.service.DisableUser(ctx, test.user.ID, test.user.ID); !errors.Is(err, ErrCannotDisableSelf) {
+		t.Errorf("expected admins not to disable themselves, got %v", err)
+	}
+
+	if err := test.service.DisableUser(ctx, ownerID, test.user.ID); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+	if !test.user.Disabled() {
+		t.Fatal("expected the user to be disabled")
+	}
+	if _, err := test.auth.ValidateAccessToken(ctx, token.AuthorizationToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("expected the session to end, got %v", err)
+	}
+	if _, err := test.auth.RefreshAuth(ctx, token.RefreshToken); err == nil {
+		t.Error("expected the refresh token to be refused")
+	}
+	if _, err := test.auth.Login(ctx, test.user.Email, "Secret#1"); !errors.Is(err, ErrUserDisabled) {
+		t.Errorf("expected logins to be refused, got %v", err)
+	}
+
+	if err := test.service.EnableUser(ctx, test.user.ID); err != nil {
+		t.Fatalf("enable: %v", err)
+	}
+	if _, err := test.auth.Login(ctx, test.user.Email, "Secret#1"); err != nil {
+		t.Errorf("expected an enabled user to log in, got %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AnalyticsService struct {
+	taskRepo     ports.TaskRepo
+	columnRepo   ports.ColumnRepo
+	boardService *BoardService
+	authorizer   ports.Authorizer
+}
+
+const (
+	analyticsDefaultDays = 30
+	analyticsMaxDays     = 366
+)
+
+var (
+	ErrAnalyticsInvalidRange = fiber.NewError(fiber.StatusBadRequest, "analytics range end must not be before its start")
+	ErrAnalyticsRangeTooLong = fiber.NewError(fiber.StatusBadRequest, "analytics range can not be longer than a year")
+)
+
+func NewAnalyticsService(taskRepo ports.TaskRepo, columnRepo ports.ColumnRepo, boardService *BoardService, authorizer ports.Authorizer) *AnalyticsService {
+	return &AnalyticsService{
+		taskRepo:     taskRepo,
+		columnRepo:   columnRepo,
+		boardService: boardService,
+		authorizer:   authorizer,
+	}
+}
+
+// GetBoardAnalytics computes flow metrics of a board between from and to (inclusive days).
+// Zero from/to default to the last 30 days.
+//
+// Lead time is measured from task creation to its arrival in a final column,
+// cycle time from its first move out of the initial (left-most) column to a final column.
+func (s *AnalyticsService) GetBoardAnalytics(ctx context.Context, userID uint, boardID uint, from time.Time, to time.Time) (*domains.BoardAnalytics, error) {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionAnalyticsView); err != nil {
+		return nil, err
+	}
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	to = truncateToDay(to)
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -(analyticsDefaultDays - 1))
+	}
+	from = truncateToDay(from)
+
+	if to.Before(from) {
+		return nil, ErrAnalyticsInvalidRange
+	}
+	if int(to.Sub(from)/dayDuration) >= analyticsMaxDays {
+		return nil, ErrAnalyticsRangeTooLong
+	}
+
+	tasks, _, err := s.taskRepo.GetListByBoardID(ctx, boardID, domains.TaskFilter{}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.taskRepo.GetColumnTransitionsByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.columnRepo.GetAll(ctx, boardID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return calculateBoardAnalytics(boardID, tasks, transitions, columns.Data, from, to), nil
+}
+
+func calculateBoardAnalytics(
+	boardID uint,
+	tasks []domains.Task,
+	transitions []domains.TaskColumnTransition,
+	columns []*domains.Column,
+	from time.Time,
+	to time.Time,
+) *domains.BoardAnalytics {
+	finalColumns := finalColumnIDs(columns)
+	transitionsByTask := groupTransitionsByTask(transitions)
+
+	var initialColumnID uint
+	if len(columns) > 0 {
+		initialColumnID = columns[0].ID
+	}
+
+	rangeEnd := to.Add(dayDuration)
+
+	var leadTimes, cycleTimes []time.Duration
+	throughput := make(map[time.Time]*domains.ThroughputPoint)
+	for week := startOfWeek(from); week.Before(rangeEnd); week = week.AddDate(0, 0, 7) {
+		throughput[week] = &domains.ThroughputPoint{WeekStart: week}
+	}
+
+	for _, task := range tasks {
+		if !finalColumns[task.ColumnID] {
+			continue
+		}
+
+		taskTransitions := transitionsByTask[task.ID]
+		completedAt, ok := completionTime(taskTransitions, finalColumns)
+		if !ok || completedAt.Before(from) || !completedAt.Before(rangeEnd) {
+			continue
+		}
+
+		leadTimes = append(leadTimes, completedAt.Sub(task.CreatedAt))
+		if startedAt, started := cycleStartTime(taskTransitions, initialColumnID); started && !startedAt.After(completedAt) {
+			cycleTimes = append(cycleTimes, completedAt.Sub(startedAt))
+		}
+
+		point := throughput[startOfWeek(completedAt)]
+		point.Completed++
+		point.StoryPoints += task.StoryPoint
+	}
+
+	analytics := &domains.BoardAnalytics{
+		BoardID:    boardID,
+		From:       from,
+		To:         to,
+		LeadTime:   newDurationStats(leadTimes),
+		CycleTime:  newDurationStats(cycleTimes),
+		Throughput: make([]domains.ThroughputPoint, 0, len(throughput)),
+	}
+
+	for _, point := range throughput {
+		analytics.Throughput = append(analytics.Throughput, *point)
+	}
+	sort.Slice(analytics.Throughput, func(i, j int) bool {
+		return analytics.Throughput[i].WeekStart.Before(analytics.Throughput[j].WeekStart)
+	})
+
+	for day := from; !day.After(to); day = day.Add(dayDuration) {
+		endOfDay := day.Add(dayDuration)
+
+		counts := make(map[uint]int, len(columns))
+		for _, task := range tasks {
+			if !task.CreatedAt.Before(endOfDay) {
+				continue
+			}
+			counts[columnAt(task, transitionsByTask[task.ID], endOfDay)]++
+		}
+
+		point := domains.CumulativeFlowPoint{
+			Date:    day,
+			Columns: make([]domains.ColumnTaskCount, len(columns)),
+		}
+		for i, column := range columns {
+			point.Columns[i] = domains.ColumnTaskCount{
+				ColumnID:   column.ID,
+				ColumnName: column.Name,
+				Count:      counts[column.ID],
+			}
+		}
+		analytics.CumulativeFlow = append(analytics.CumulativeFlow, point)
+	}
+
+	return analytics
+}
+
+// completionTime returns when the task last entered a final column.
+func completionTime(transitions []domains.TaskColumnTransition, finalColumns map[uint]bool) (time.Time, bool) {
+	for i := len(transitions) - 1; i >= 0; i-- {
+		if finalColumns[transitions[i].ToColumnID] {
+			return transitions[i].CreatedAt, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// cycleStartTime returns when the task first entered a column other than the initial one.
+func cycleStartTime(transitions []domains.TaskColumnTransition, initialColumnID uint) (time.Time, bool) {
+	for _, transition := range transitions {
+		if transition.ToColumnID != initialColumnID {
+			return transition.CreatedAt, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func newDurationStats(durations []time.Duration) domains.DurationStats {
+	if len(durations) == 0 {
+		return domains.DurationStats{}
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	var total time.Duration
+	for _, duration := range durations {
+		total += duration
+	}
+
+	return domains.DurationStats{
+		Count:   len(durations),
+		Average: total / time.Duration(len(durations)),
+		Median:  percentile(durations, 50),
+		P85:     percentile(durations, 85),
+	}
+}
+
+// percentile uses the nearest-rank method on sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func startOfWeek(t time.Time) time.Time {
+	day := truncateToDay(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// GetBoardWorkload reports the assigned tasks of every board member.
+// Tasks outside final columns are open, open tasks past their end datetime are overdue.
+func (s *AnalyticsService) GetBoardWorkload(ctx context.Context, userID uint, boardID uint) ([]domains.MemberWorkload, error) {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionAnalyticsView); err != nil {
+		return nil, err
+	}
+
+	members, err := s.boardService.GetBoardMembersByBoardId(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, _, err := s.taskRepo.GetListByBoardID(ctx, boardID, domains.TaskFilter{}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.columnRepo.GetAll(ctx, boardID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return calculateWorkload(members, tasks, columns.Data, time.Now()), nil
+}
+
+func calculateWorkload(members []*domains.User, tasks []domains.Task, columns []*domains.Column, now time.Time) []domains.MemberWorkload {
+	finalColumns := finalColumnIDs(columns)
+
+	tasksByAssignee := make(map[uint][]domains.Task)
+	for _, task := range tasks {
+		if task.AssigneeID != nil {
+			tasksByAssignee[*task.AssigneeID] = append(tasksByAssignee[*task.AssigneeID], task)
+		}
+	}
+
+	workloads := make([]domains.MemberWorkload, len(members))
+	for i, member := range members {
+		workload := domains.MemberWorkload{
+			User:           member,
+			TasksPerColumn: make([]domains.ColumnTaskCount, len(columns)),
+		}
+
+		perColumn := make(map[uint]int, len(columns))
+		for _, task := range tasksByAssignee[member.ID] {
+			perColumn[task.ColumnID]++
+
+			if finalColumns[task.ColumnID] {
+				continue
+			}
+			workload.OpenTasks++
+			workload.OpenStoryPoints += task.StoryPoint
+			if task.EndDateTime != nil && task.EndDateTime.Before(now) {
+				workload.OverdueTasks++
+			}
+		}
+
+		for j, column := range columns {
+			workload.TasksPerColumn[j] = domains.ColumnTaskCount{
+				ColumnID:   column.ID,
+				ColumnName: column.Name,
+				Count:      perColumn[column.ID],
+			}
+		}
+
+		workloads[i] = workload
+	}
+
+	return workloads
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+// the columns are listed in board order, the initial column is the first one
+// and not the one with the lowest id
+const (
+	todoColumnID  uint = 20
+	doingColumnID uint = 10
+	doneColumnID  uint = 30
+)
+
+var analyticsColumns = []*domains.Column{
+	{ID: todoColumnID, Name: "To Do"},
+	{ID: doingColumnID, Name: "Doing"},
+	{ID: doneColumnID, Name: "Done", IsFinal: true},
+}
+
+// analyticsDay returns noon of a day in August 2024, the 5th is a Monday.
+func analyticsDay(day int) time.Time {
+	return time.Date(2024, 8, day, 12, 0, 0, 0, time.UTC)
+}
+
+func move(taskID, from, to uint, day int) domains.TaskColumnTransition {
+	return domains.TaskColumnTransition{TaskID: taskID, FromColumnID: &from, ToColumnID: to, CreatedAt: analyticsDay(day)}
+}
+
+func TestCalculateBoardAnalytics(t *testing.T) {
+	tasks := []domains.Task{
+		// started on the 6th, done on the 8th
+		{ID: 1, ColumnID: doneColumnID, StoryPoint: 3, CreatedAt: analyticsDay(5)},
+		// done on the 7th, reopened on the 9th and done again on the 13th
+		{ID: 2, ColumnID: doneColumnID, StoryPoint: 5, CreatedAt: analyticsDay(5)},
+		// done before the range
+		{ID: 3, ColumnID: doneColumnID, StoryPoint: 8, CreatedAt: analyticsDay(1)},
+		// still in progress
+		{ID: 4, ColumnID: doingColumnID, StoryPoint: 2, CreatedAt: analyticsDay(10)},
+		// created in progress, moved back to the initial column, then done on the 15th
+		{ID: 5, ColumnID: doneColumnID, StoryPoint: 1, CreatedAt: analyticsDay(12)},
+	}
+	transitions := []domains.TaskColumnTransition{
+		move(1, todoColumnID, doingColumnID, 6),
+		move(1, doingColumnID, doneColumnID, 8),
+		move(2, doneColumnID, doingColumnID, 9),
+		move(2, todoColumnID, doneColumnID, 7),
+		move(2, doingColumnID, doneColumnID, 13),
+		move(3, todoColumnID, doneColumnID, 2),
+		move(4, todoColumnID, doingColumnID, 11),
+		move(5, doingColumnID, todoColumnID, 13),
+		move(5, todoColumnID, doneColumnID, 15),
+	}
+	from := time.Date(2024, 8, 5, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 8, 18, 0, 0, 0, 0, time.UTC)
+
+	analytics := calculateBoardAnalytics(1, tasks, transitions, analyticsColumns, from, to)
+
+	days := func(n float64) time.Duration { return time.Duration(n * float64(dayDuration)) }
+	stats := []struct {
+		name string
+		got  domains.DurationStats
+		want domains.DurationStats
+	}{
+		// lead times are 3, 8 and 3 days, a reopened task counts from its last completion
+		{name: "lead time", got: analytics.LeadTime, want: domains.DurationStats{
+			Count: 3, Average: days(14) / 3, Median: days(3), P85: days(8),
+		}},
+		// cycle times are 2, 6 and 0 days, moves into the initial column do not start the cycle
+		{name: "cycle time", got: analytics.CycleTime, want: domains.DurationStats{
+			Count: 3, Average: days(8) / 3, Median: days(2), P85: days(6),
+		}},
+	}
+	for _, s := range stats {
+		if s.got != s.want {
+			t.Errorf("%s = %+v, want %+v", s.name, s.got, s.want)
+		}
+	}
+
+	throughput := []domains.ThroughputPoint{
+		{WeekStart: from, Completed: 1, StoryPoints: 3},
+		{WeekStart: from.AddDate(0, 0, 7), Completed: 2, StoryPoints: 6},
+	}
+	if !reflect.DeepEqual(analytics.Throughput, throughput) {
+		t.Errorf("throughput = %+v, want %+v", analytics.Throughput, throughput)
+	}
+
+	if len(analytics.CumulativeFlow) != 14 {
+		t.Fatalf("got %d cumulative flow points, want 14", len(analytics.CumulativeFlow))
+	}
+	flow := []struct {
+		day               int
+		todo, doing, done int
+	}{
+		{day: 5, todo: 2, done: 1},
+		{day: 7, todo: 0, doing: 1, done: 2},
+		{day: 9, doing: 1, done: 2},
+		{day: 12, doing: 3, done: 2},
+		{day: 13, todo: 1, doing: 1, done: 3},
+		{day: 18, doing: 1, done: 4},
+	}
+	for _, f := range flow {
+		point := analytics.CumulativeFlow[f.day-5]
+		if !point.Date.Equal(from.AddDate(0, 0, f.day-5)) {
+			t.Errorf("point %d date = %v", f.day, point.Date)
+		}
+		want := []domains.ColumnTaskCount{
+			{ColumnID: todoColumnID, ColumnName: "To Do", Count: f.todo},
+			{ColumnID: doingColumnID, ColumnName: "Doing", Count: f.doing},
+			{ColumnID: doneColumnID, ColumnName: "Done", Count: f.done},
+		}
+		if !reflect.DeepEqual(point.Columns, want) {
+			t.Errorf("August %d: columns = %+v, want %+v", f.day, point.Columns, want)
+		}
+	}
+}
+
+func TestCalculateWorkload(t *testing.T) {
+	now := analyticsDay(10)
+	before, after := analyticsDay(9), analyticsDay(11)
+	assignee := func(id uint) *uint { return &id }
+
+	members := []*domains.User{{ID: editorID}, {ID: viewerID}}
+	tasks := []domains.Task{
+		{ID: 1, ColumnID: todoColumnID, StoryPoint: 3, AssigneeID: assignee(editorID), EndDateTime: &after},
+		// overdue
+		{ID: 2, ColumnID: doingColumnID, StoryPoint: 5, AssigneeID: assignee(editorID), EndDateTime: &before},
+		// done tasks are neither open nor overdue
+		{ID: 3, ColumnID: doneColumnID, StoryPoint: 8, AssigneeID: assignee(editorID), EndDateTime: &before},
+		// unassigned
+		{ID: 4, ColumnID: todoColumnID, StoryPoint: 2, EndDateTime: &before},
+		// assigned to someone who left the board
+		{ID: 5, ColumnID: todoColumnID, StoryPoint: 1, AssigneeID: assignee(outsiderID)},
+	}
+
+	workloads := calculateWorkload(members, tasks, analyticsColumns, now)
+
+	perColumn := func(todo, doing, done int) []domains.ColumnTaskCount {
+		return []domains.ColumnTaskCount{
+			{ColumnID: todoColumnID, ColumnName: "To Do", Count: todo},
+			{ColumnID: doingColumnID, ColumnName: "Doing", Count: doing},
+			{ColumnID: doneColumnID, ColumnName: "Done", Count: done},
+		}
+	}
+	want := []domains.MemberWorkload{
+		{User: members[0], OpenTasks: 2, OpenStoryPoints: 8, OverdueTasks: 1, TasksPerColumn: perColumn(1, 1, 1)},
+		{User: members[1], TasksPerColumn: perColumn(0, 0, 0)},
+	}
+	if !reflect.DeepEqual(workloads, want) {
+		t.Errorf("workloads = %+v, want %+v", workloads, want)
+	}
+}
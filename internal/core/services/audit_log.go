@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
+)
+
+const auditLogExportBatch = 500
+
+// AuditService records security-relevant events, such as logins and changes
+// of who can access a board, and lets admins search and export them.
+type AuditService struct {
+	auditLogRepo ports.AuditLogRepo
+}
+
+func NewAuditService(auditLogRepo ports.AuditLogRepo) *AuditService {
+	return &AuditService{auditLogRepo: auditLogRepo}
+}
+
+// Record stores the entry with the client of the request. Inside a
+// transaction the entry is only kept when the transaction is committed.
+func (s *AuditService) Record(ctx context.Context, entry domains.AuditLog) error {
+	entry.IP, entry.UserAgent = valuecontext.GetClient(ctx)
+	return s.auditLogRepo.Create(ctx, &entry)
+}
+
+func (s *AuditService) GetAuditLogs(ctx context.Context, filter domains.AuditLogFilter, pageNumber uint, pageSize uint) ([]domains.AuditLog, uint, error) {
+	return s.auditLogRepo.GetAll(ctx, filter, pageSize, (pageNumber-1)*pageSize)
+}
+
+// ExportAuditLogs passes every matching entry to write, oldest first. Entries
+// are loaded in batches so exports of the whole log do not fill the memory.
+func (s *AuditService) ExportAuditLogs(ctx context.Context, filter domains.AuditLogFilter, write func(domains.AuditLog) error) error {
+	var afterID uint
+	for {
+		entries, err := s.auditLogRepo.GetAfter(ctx, filter, afterID, auditLogExportBatch)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err = write(entry); err != nil {
+				return err
+			}
+		}
+		if len(entries) < auditLogExportBatch {
+			return nil
+		}
+		afterID = entries[len(entries)-1].ID
+	}
+}
+
+// auditUser returns a pointer to the user id for audit log entries.
+func auditUser(id uint) *uint {
+	return &id
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
+	"github.com/gofiber/fiber/v2"
+)
+
+// auditBoardRepo accepts every change
+type auditBoardRepo struct {
+	fakeBoardRepo
+}
+
+func (auditBoardRepo) Update(context.Context, *domains.Board) error { return nil }
+
+type recordingAuditLogRepo struct {
+	ports.AuditLogRepo
+	entries *[]domains.AuditLog
+}
+
+func (r recordingAuditLogRepo) Create(_ context.Context, entry *domains.AuditLog) error {
+	*r.entries = append(*r.entries, *entry)
+	return nil
+}
+
+// auditEntry is an expected audit log entry of the owner.
+type auditEntry struct {
+	action  domains.AuditAction
+	board   *uint
+	target  *uint
+	details map[string]string
+}
+
+func expectAuditEntries(t *testing.T, entries []domains.AuditLog, want []auditEntry) {
+	t.Helper()
+	if len(entries) != len(want) {
+		t.Fatalf("recorded %d entries, want %d: %+v", len(entries), len(want), entries)
+	}
+	samePointer := func(got, want *uint) bool {
+		return (got == nil) == (want == nil) && (want == nil || *got == *want)
+	}
+	for i, w := range want {
+		entry := entries[i]
+		if entry.Action != w.action || *entry.ActorID != ownerID {
+			t.Errorf("entry %d = %+v, want %s by the owner", i, entry, w.action)
+		}
+		if !samePointer(entry.BoardID, w.board) {
+			t.Errorf("entry %d board = %v, want %v", i, entry.BoardID, w.board)
+		}
+		if !samePointer(entry.TargetUserID, w.target) {
+			t.Errorf("entry %d target = %v, want %v", i, entry.TargetUserID, w.target)
+		}
+		if fmt.Sprint(entry.Details) != fmt.Sprint(w.details) {
+			t.Errorf("entry %d details = %v, want %v", i, entry.Details, w.details)
+		}
+		if entry.IP != "203.0.113.7" || entry.UserAgent != "test" {
+			t.Errorf("entry %d client = %s %s, want the client of the request", i, entry.IP, entry.UserAgent)
+		}
+	}
+}
+
+func newAuditContext() context.Context {
+	return valuecontext.NewValueContext(context.Background(), &valuecontext.ContextValue{ClientIP: "203.0.113.7", UserAgent: "test"})
+}
+
+func TestBoardServiceAuditLog(t *testing.T) {
+	var entries []domains.AuditLog
+	members := newMemoryBoardMemberRepo(map[uint]domains.RoleW{ownerID: domains.Owner, viewerID: domains.Viewer}, ownerID, viewerID)
+	authorizer := NewBoardAuthorizer(fakeBoardRepo{}, members, fakeRoleRepo{}, nil, fakeBoardTeamRepo{})
+	service := NewBoardService(auditBoardRepo{}, members, fakeUserRepo{}, fakeRoleRepo{}, nil, authorizer,
+		NewAuditService(recordingAuditLogRepo{entries: &entries}))
+	ctx := newAuditContext()
+
+	if err := service.UpdateBoard(ctx, ownerID, &domains.Board{ID: privateBoardID, Name: "renamed", IsPrivate: true}); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	if err := service.UpdateBoard(ctx, ownerID, &domains.Board{ID: privateBoardID, IsPrivate: false}); err != nil {
+		t.Fatalf("make public: %v", err)
+	}
+	if err := service.ChangeUserRole(ctx, ownerID, viewerID, privateBoardID, domains.Owner.String()); err != nil {
+		t.Fatalf("change role: %v", err)
+	}
+
+	board := auditUser(privateBoardID)
+	expectAuditEntries(t, entries, []auditEntry{
+		{action: domains.AuditBoardPrivacyChanged, board: board, details: map[string]string{"is_private": "false"}},
+		{action: domains.AuditBoardMemberRoleChanged, board: board, target: auditUser(viewerID), details: map[string]string{"role": "Owner"}},
+	})
+}
+
+const auditWorkspaceID uint = 1
+
+// memoryWorkspaceMemberRepo keeps the members of a workspace.
+type memoryWorkspaceMemberRepo struct {
+	ports.WorkspaceMemberRepo
+	members *[]domains.WorkspaceMember
+}
+
+func (r memoryWorkspaceMemberRepo) Create(_ context.Context, member *domains.WorkspaceMember) error {
+	member.ID = uint(len(*r.members) + 1)
+	*r.members = append(*r.members, *member)
+	return nil
+}
+
+func (r memoryWorkspaceMemberRepo) GetMember(_ context.Context, workspaceID, userID uint) (*domains.WorkspaceMember, error) {
+	for _, member := range *r.members {
+		if member.WorkspaceID == workspaceID && member.UserID == userID {
+			return &member, nil
+		}
+	}
+	return nil, fiber.NewError(fiber.StatusNotFound, "workspace member not found")
+}
+
+func (r memoryWorkspaceMemberRepo) UpdateRole(_ context.Context, id uint, role domains.WorkspaceRole) error {
+	for i := range *r.members {
+		if (*r.members)[i].ID == id {
+			(*r.members)[i].Role = role
+		}
+	}
+	return nil
+}
+
+func (r memoryWorkspaceMemberRepo) Delete(_ context.Context, id uint) error {
+	for i := range *r.members {
+		if (*r.members)[i].ID == id {
+			*r.members = append((*r.members)[:i], (*r.members)[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r memoryWorkspaceMemberRepo) CountByRole(_ context.Context, workspaceID uint, role domains.WorkspaceRole) (int64, error) {
+	var count int64
+	for _, member := range *r.members {
+		if member.WorkspaceID == workspaceID && member.Role == role {
+			count++
+		}
+	}
+	return count, nil
+}
+
+type noTeamMemberRepo struct {
+	ports.TeamMemberRepo
+}
+
+func (noTeamMemberRepo) DeleteByWorkspaceUser(context.Context, uint, uint) error { return nil }
+
+func TestWorkspaceServiceAuditLog(t *testing.T) {
+	var entries []domains.AuditLog
+	members := memoryWorkspaceMemberRepo{members: &[]domains.WorkspaceMember{
+		{ID: 1, WorkspaceID: auditWorkspaceID, UserID: ownerID, Role: domains.WorkspaceRoleOwner},
+	}}
+	editor := &domains.User{ID: editorID, Email: "editor@example.com"}
+	service := NewWorkspaceService(nil, members, noTeamMemberRepo{}, nil, nil, authUserRepo{user: editor}, nil,
+		NewAuditService(recordingAuditLogRepo{entries: &entries}))
+	ctx := newAuditContext()
+
+	if _, err := service.AddMember(ctx, ownerID, auditWorkspaceID, editor.Email, domains.WorkspaceRoleGuest); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := service.ChangeMemberRole(ctx, ownerID, auditWorkspaceID, editorID, domains.WorkspaceRoleAdmin); err != nil {
+		t.Fatalf("change role: %v", err)
+	}
+	if err := service.RemoveMember(ctx, ownerID, auditWorkspaceID, editorID); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+
+	workspace := strconv.FormatUint(uint64(auditWorkspaceID), 10)
+	target := auditUser(editorID)
+	expectAuditEntries(t, entries, []auditEntry{
+		{action: domains.AuditWorkspaceMemberAdded, target: target, details: map[string]string{"role": "guest", "workspace_id": workspace}},
+		{action: domains.AuditWorkspaceMemberRoleChanged, target: target, details: map[string]string{"role": "admin", "workspace_id": workspace}},
+		{action: domains.AuditWorkspaceMemberRemoved, target: target, details: map[string]string{"workspace_id": workspace}},
+	})
+}
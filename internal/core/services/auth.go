@@ -2,30 +2,78 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
 	user_model "github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 	user_repo "github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/jwt"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 
 	jwt2 "github.com/golang-jwt/jwt/v5"
 )
 
+var (
+	ErrInvalidAccessToken  = fiber.NewError(fiber.StatusUnauthorized, "Invalid token")
+	ErrInvalidRefreshToken = fiber.NewError(fiber.StatusUnauthorized, "Invalid refresh token")
+	ErrRefreshTokenReused  = fiber.NewError(fiber.StatusUnauthorized, "Refresh token reuse detected, please log in again")
+	ErrTokenRevoked        = fiber.NewError(fiber.StatusUnauthorized, "Token has been revoked")
+	ErrInvalidMFAChallenge = fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired MFA challenge, please log in again")
+
+	ErrUserDisabled          = fiber.NewError(fiber.StatusForbidden, "Account is disabled")
+	ErrPasswordResetRequired = fiber.NewError(fiber.StatusForbidden, "Password reset required, use the link sent to your email")
+)
+
+// Refresh tokens are tracked in the cache: every issued refresh token ID
+// until it is used once, and every token family (the chain of refresh tokens
+// rotated from one login) until it expires or gets revoked.
+const (
+	refreshTokenKeyPrefix    = "auth:refresh:"
+	refreshFamilyKeyPrefix   = "auth:refresh_family:"
+	revokedTokenKeyPrefix    = "auth:revoked:"
+	sessionsRevokedKeyPrefix = "auth:sessions_revoked:"
+	tokenLastUsedPrecision   = time.Minute
+	mfaChallengeKeyPrefix    = "auth:mfa_challenge:"
+
+	defaultMFAChallengeExp = 5 * time.Minute
+	maxMFAAttempts         = 5
+)
+
 type AuthService struct {
 	userRepo               *user_repo.UserRepo
-	secret                 []byte
+	tokenRepo              user_repo.PersonalAccessTokenRepo
+	cache                  user_repo.CacheRepository
+	hasher                 *password.Hasher
+	mfaService             *MFAService
+	auditService           *AuditService
+	keys                   *jwt.KeySet
 	tokenExpiration        uint
 	refreshTokenExpiration uint
+	mfaChallengeExpiration time.Duration
 }
 
-func NewAuthService(userRepo user_repo.UserRepo, secret []byte,
-	tokenExpiration uint, refreshTokenExpiration uint) *AuthService {
+func NewAuthService(userRepo user_repo.UserRepo, tokenRepo user_repo.PersonalAccessTokenRepo, cache user_repo.CacheRepository, hasher *password.Hasher, mfaService *MFAService,
+	auditService *AuditService, keys *jwt.KeySet,
+	tokenExpiration uint, refreshTokenExpiration uint, mfaChallengeExpiration time.Duration) *AuthService {
+	if mfaChallengeExpiration <= 0 {
+		mfaChallengeExpiration = defaultMFAChallengeExp
+	}
 	return &AuthService{
 		userRepo:               &userRepo,
-		secret:                 secret,
+		tokenRepo:              tokenRepo,
+		cache:                  cache,
+		hasher:                 hasher,
+		mfaService:             mfaService,
+		auditService:           auditService,
+		keys:                   keys,
 		tokenExpiration:        tokenExpiration,
 		refreshTokenExpiration: refreshTokenExpiration,
+		mfaChallengeExpiration: mfaChallengeExpiration,
 	}
 }
 
@@ -35,14 +83,25 @@ type UserToken struct {
 	ExpiresAt          int64
 }
 
-func (s *AuthService) Login(ctx context.Context, email, pass string) (*UserToken, error) {
+// LoginResult holds either the tokens of the session or, for users with MFA
+// enabled, the challenge token to exchange for them along with a valid code.
+type LoginResult struct {
+	Token        *UserToken
+	MFAToken     string
+	MFAExpiresAt int64
+	MFARequired  bool
+}
+
+func (s *AuthService) Login(ctx context.Context, email, pass string) (*LoginResult, error) {
 	user, err := (*s.userRepo).GetByEmail(ctx, email)
 
 	if user == nil {
+		s.recordLoginFailure(ctx, nil, email, user_model.ErrUserNotFound)
 		return nil, user_model.ErrUserNotFound
 	}
 
 	if !user.PasswordIsValid(pass) {
+		s.recordLoginFailure(ctx, user, email, user_model.ErrInvalidPassword)
 		return nil, user_model.ErrInvalidPassword
 	}
 
@@ -50,36 +109,193 @@ func (s *AuthService) Login(ctx context.Context, email, pass string) (*UserToken
 		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
 	}
 
-	// calc expiration time values
-	var (
-		authExp    = time.Now().Add(time.Minute * time.Duration(s.tokenExpiration))
-		refreshExp = time.Now().Add(time.Minute * time.Duration(s.refreshTokenExpiration))
-	)
+	if user.Disabled() {
+		s.recordLoginFailure(ctx, user, email, ErrUserDisabled)
+		return nil, ErrUserDisabled
+	}
+	if user.PasswordResetRequired {
+		s.recordLoginFailure(ctx, user, email, ErrPasswordResetRequired)
+		return nil, ErrPasswordResetRequired
+	}
+
+	s.upgradePasswordHash(ctx, user, pass)
+
+	return s.StartSession(ctx, user)
+}
+
+// StartSession logs in a user whose identity has been checked already, by
+// password or an identity provider. Users with MFA enabled get a challenge.
+func (s *AuthService) StartSession(ctx context.Context, user *user_model.User) (*LoginResult, error) {
+	if user.Disabled() {
+		return nil, ErrUserDisabled
+	}
+
+	if user.MFAEnabled() {
+		return s.issueMFAChallenge(ctx, user)
+	}
 
-	authToken, err := jwt.CreateToken(s.secret, s.userClaims(user, authExp))
+	if err := s.recordLogin(ctx, user, false); err != nil {
+		return nil, err
+	}
+
+	token, err := s.issueTokens(ctx, user, uuid.NewString())
 	if err != nil {
-		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()} // todo
+		return nil, err
+	}
+	return &LoginResult{Token: token}, nil
+}
+
+// CompleteMFALogin exchanges an MFA challenge token and a TOTP or recovery
+// code for the session tokens. A challenge allows a few attempts and is used
+// up by the first successful one.
+func (s *AuthService) CompleteMFALogin(ctx context.Context, mfaToken string, code string) (*UserToken, error) {
+	claims, err := s.keys.Parse(mfaToken)
+	if err != nil || claims.TokenType != jwt.MFAChallengeTokenType {
+		return nil, ErrInvalidMFAChallenge
 	}
 
-	refreshToken, err := jwt.CreateToken(s.secret, s.userClaims(user, refreshExp))
+	// every attempt is counted before the code is checked, so concurrent
+	// attempts cannot get past the limit
+	key := mfaChallengeKeyPrefix + claims.ID
+	attempts, err := s.cache.Incr(ctx, key)
 	if err != nil {
-		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()} // todo
+		if errors.Is(err, user_repo.ErrCacheMiss) {
+			return nil, ErrInvalidMFAChallenge
+		}
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	if attempts > maxMFAAttempts {
+		return nil, ErrInvalidMFAChallenge
 	}
 
-	return &UserToken{
-		AuthorizationToken: authToken,
-		RefreshToken:       refreshToken,
-		ExpiresAt:          authExp.Unix(),
+	user, err := (*s.userRepo).GetByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Disabled() {
+		s.recordLoginFailure(ctx, user, user.Email, ErrUserDisabled)
+		return nil, ErrUserDisabled
+	}
+
+	if err := s.mfaService.Verify(ctx, user, code); err != nil {
+		s.recordLoginFailure(ctx, user, user.Email, err)
+		if attempts >= maxMFAAttempts {
+			log.WarningLog.Printf("Too many MFA attempts for user %d, dropping the challenge\n", user.ID)
+			if err := s.cache.Delete(ctx, key); err != nil {
+				return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+			}
+		}
+		return nil, err
+	}
+
+	// a concurrent attempt may have used up the challenge in the meantime
+	if _, err := s.cache.GetDel(ctx, key); err != nil {
+		if errors.Is(err, user_repo.ErrCacheMiss) {
+			return nil, ErrInvalidMFAChallenge
+		}
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	if err := s.recordLogin(ctx, user, true); err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, user, uuid.NewString())
+}
+
+// recordLogin adds a successful login to the audit log.
+func (s *AuthService) recordLogin(ctx context.Context, user *user_model.User, mfa bool) error {
+	return s.auditService.Record(ctx, user_model.AuditLog{
+		Action:       user_model.AuditLoginSucceeded,
+		ActorID:      auditUser(user.ID),
+		TargetUserID: auditUser(user.ID),
+		Details:      map[string]string{"mfa": strconv.FormatBool(mfa)},
+	})
+}
+
+// recordLoginFailure adds a failed login to the audit log, the user is nil
+// when nobody has the email. The login fails anyway, so errors are only logged.
+func (s *AuthService) recordLoginFailure(ctx context.Context, user *user_model.User, email string, reason error) {
+	entry := user_model.AuditLog{
+		Action:  user_model.AuditLoginFailed,
+		Details: map[string]string{"email": email, "reason": reason.Error()},
+	}
+	if user != nil {
+		entry.ActorID = auditUser(user.ID)
+		entry.TargetUserID = auditUser(user.ID)
+	}
+	if err := s.auditService.Record(ctx, entry); err != nil {
+		log.ErrorLog.Printf("Error recording failed login: %v\n", err)
+	}
+}
+
+func (s *AuthService) issueMFAChallenge(ctx context.Context, user *user_model.User) (*LoginResult, error) {
+	now := time.Now()
+	exp := now.Add(s.mfaChallengeExpiration)
+
+	claims := s.userClaims(user, now, exp)
+	claims.TokenType = jwt.MFAChallengeTokenType
+	token, err := s.keys.Sign(claims)
+	if err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	if err := s.cache.Set(ctx, mfaChallengeKeyPrefix+claims.ID, "0", s.mfaChallengeExpiration); err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	return &LoginResult{
+		MFARequired:  true,
+		MFAToken:     token,
+		MFAExpiresAt: exp.Unix(),
 	}, nil
 }
 
+// RefreshAuth rotates a refresh token: the presented token is used up and a
+// new access and refresh token pair of the same family is returned. Presenting
+// a used refresh token again revokes the whole family.
 func (s *AuthService) RefreshAuth(ctx context.Context, refreshToken string) (*UserToken, error) {
-	claim, err := jwt.ParseToken(refreshToken, s.secret)
-	if err != nil {
-		return nil, &fiber.Error{Code: fiber.StatusUnauthorized, Message: "Invalid refresh token"}
+	claims, err := s.keys.Parse(refreshToken)
+	if err != nil || claims.TokenType != jwt.RefreshTokenType || claims.FamilyID == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if err := s.checkSessionsRevoked(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.cache.Get(ctx, refreshFamilyKeyPrefix+claims.FamilyID); err != nil {
+		if errors.Is(err, user_repo.ErrCacheMiss) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	// the token is used up in one step, so of two concurrent refreshes with the
+	// same token only one gets it and the other one counts as reuse
+	if _, err := s.cache.GetDel(ctx, refreshTokenKeyPrefix+claims.ID); err != nil {
+		if !errors.Is(err, user_repo.ErrCacheMiss) {
+			return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+		}
+
+		log.WarningLog.Printf("Refresh token reuse detected for user %d, revoking token family %s\n", claims.UserID, claims.FamilyID)
+		err = s.auditService.Record(ctx, user_model.AuditLog{
+			Action:       user_model.AuditRefreshTokenReused,
+			ActorID:      auditUser(claims.UserID),
+			TargetUserID: auditUser(claims.UserID),
+			Details:      map[string]string{"family_id": claims.FamilyID},
+		})
+		if err != nil {
+			log.ErrorLog.Printf("Error recording refresh token reuse: %v\n", err)
+		}
+		if err := s.cache.Delete(ctx, refreshFamilyKeyPrefix+claims.FamilyID); err != nil {
+			return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+		}
+		return nil, ErrRefreshTokenReused
 	}
 
-	u, err := (*s.userRepo).GetByID(ctx, claim.UserID)
+	u, err := (*s.userRepo).GetByID(ctx, claims.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -88,31 +304,249 @@ func (s *AuthService) RefreshAuth(ctx context.Context, refreshToken string) (*Us
 		return nil, user_model.ErrUserNotFound
 	}
 
+	if u.Disabled() {
+		return nil, ErrUserDisabled
+	}
+
+	token, err := s.issueTokens(ctx, u, claims.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the old refresh token is used up, so failing now would end the session
+	err = s.auditService.Record(ctx, user_model.AuditLog{
+		Action:       user_model.AuditTokenRefreshed,
+		ActorID:      auditUser(u.ID),
+		TargetUserID: auditUser(u.ID),
+		Details:      map[string]string{"family_id": claims.FamilyID},
+	})
+	if err != nil {
+		log.ErrorLog.Printf("Error recording token refresh: %v\n", err)
+	}
+	return token, nil
+}
+
+// ValidateAccessToken parses an access token and rejects refresh tokens and
+// revoked tokens. Disabling a user revokes the sessions of the user, so access
+// tokens of disabled users are rejected as revoked.
+func (s *AuthService) ValidateAccessToken(ctx context.Context, token string) (*jwt.UserClaims, error) {
+	claims, err := s.keys.Parse(token)
+	if err != nil || claims.TokenType != jwt.AccessTokenType {
+		return nil, ErrInvalidAccessToken
+	}
+
+	if _, err := s.cache.Get(ctx, revokedTokenKeyPrefix+claims.ID); err == nil {
+		return nil, ErrTokenRevoked
+	} else if !errors.Is(err, user_repo.ErrCacheMiss) {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	if err := s.checkSessionsRevoked(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// ValidatePersonalAccessToken looks up a personal access token and returns
+// claims carrying its scopes and board restriction.
+func (s *AuthService) ValidatePersonalAccessToken(ctx context.Context, token string) (*jwt.UserClaims, error) {
+	pat, err := s.tokenRepo.GetByHash(ctx, hashToken(token))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrInvalidAccessToken
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if !pat.IsActive(now) {
+		return nil, ErrInvalidAccessToken
+	}
+
+	user, err := (*s.userRepo).GetByID(ctx, pat.UserID)
+	if err != nil {
+		return nil, ErrInvalidAccessToken
+	}
+	if user.Disabled() {
+		return nil, ErrUserDisabled
+	}
+
+	// last use is informational, so it is written at most once a minute
+	if pat.LastUsedAt == nil || now.Sub(*pat.LastUsedAt) >= tokenLastUsedPrecision {
+		if err := s.tokenRepo.TouchLastUsed(ctx, pat.ID, now); err != nil {
+			log.ErrorLog.Printf("Error updating last use of personal access token %d: %v\n", pat.ID, err)
+		}
+	}
+
+	claims := &jwt.UserClaims{
+		RegisteredClaims: jwt2.RegisteredClaims{
+			ID:       fmt.Sprintf("pat:%d", pat.ID),
+			IssuedAt: jwt2.NewNumericDate(pat.CreatedAt),
+		},
+		UserID:    user.ID,
+		Role:      user.Role.String(),
+		TokenType: jwt.PersonalAccessTokenType,
+		Scopes:    pat.Scopes,
+	}
+	if pat.ExpiresAt != nil {
+		claims.ExpiresAt = jwt2.NewNumericDate(*pat.ExpiresAt)
+	}
+	if pat.BoardID != nil {
+		claims.BoardID = *pat.BoardID
+	}
+	return claims, nil
+}
+
+// RevokePersonalAccessTokens revokes every personal access token of the user.
+func (s *AuthService) RevokePersonalAccessTokens(ctx context.Context, userID uint) error {
+	return s.tokenRepo.RevokeByUserID(ctx, userID)
+}
+
+// Logout revokes the access token of the request and, when given, the family
+// of the refresh token issued with it.
+func (s *AuthService) Logout(ctx context.Context, claims *jwt.UserClaims, refreshToken string) error {
+	if refreshToken != "" {
+		refreshClaims, err := s.keys.Parse(refreshToken)
+		if err != nil || refreshClaims.TokenType != jwt.RefreshTokenType || refreshClaims.UserID != claims.UserID {
+			return ErrInvalidRefreshToken
+		}
+		if err := s.cache.Delete(ctx, refreshFamilyKeyPrefix+refreshClaims.FamilyID); err != nil {
+			return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+		}
+	}
+
+	return s.revokeToken(ctx, claims)
+}
+
+// LogoutAll revokes every access and refresh token issued to the user so far.
+func (s *AuthService) LogoutAll(ctx context.Context, claims *jwt.UserClaims) error {
+	if err := s.RevokeUserSessions(ctx, claims.UserID); err != nil {
+		return err
+	}
+
+	// tokens issued in the same millisecond survive the timestamp check
+	return s.revokeToken(ctx, claims)
+}
+
+// RevokeUserSessions invalidates every token issued to the user before now,
+// with a precision of a millisecond.
+func (s *AuthService) RevokeUserSessions(ctx context.Context, userID uint) error {
+	ttl := time.Minute * time.Duration(max(s.tokenExpiration, s.refreshTokenExpiration))
+	key := fmt.Sprintf("%s%d", sessionsRevokedKeyPrefix, userID)
+	if err := s.cache.Set(ctx, key, strconv.FormatInt(time.Now().UnixMilli(), 10), ttl); err != nil {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *AuthService) revokeToken(ctx context.Context, claims *jwt.UserClaims) error {
+	if claims.ExpiresAt == nil {
+		return nil
+	}
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := s.cache.Set(ctx, revokedTokenKeyPrefix+claims.ID, "1", ttl); err != nil {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	return nil
+}
+
+// checkSessionsRevoked rejects tokens issued before the user logged out of all sessions.
+func (s *AuthService) checkSessionsRevoked(ctx context.Context, claims *jwt.UserClaims) error {
+	value, err := s.cache.Get(ctx, fmt.Sprintf("%s%d", sessionsRevokedKeyPrefix, claims.UserID))
+	if err != nil {
+		if errors.Is(err, user_repo.ErrCacheMiss) {
+			return nil
+		}
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	revokedAt, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	issuedAt := claims.IssuedAtMilli
+	if issuedAt == 0 && claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.UnixMilli()
+	}
+	if issuedAt < revokedAt {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+func (s *AuthService) issueTokens(ctx context.Context, user *user_model.User, familyID string) (*UserToken, error) {
 	// calc expiration time values
 	var (
-		authExp = time.Now().Add(time.Minute * time.Duration(s.tokenExpiration))
+		now        = time.Now()
+		authExp    = now.Add(time.Minute * time.Duration(s.tokenExpiration))
+		refreshExp = now.Add(time.Minute * time.Duration(s.refreshTokenExpiration))
 	)
 
-	authToken, err := jwt.CreateToken(s.secret, s.userClaims(u, authExp))
+	authClaims := s.userClaims(user, now, authExp)
+	authClaims.TokenType = jwt.AccessTokenType
+	authToken, err := s.keys.Sign(authClaims)
+	if err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()} // todo
+	}
+
+	refreshClaims := s.userClaims(user, now, refreshExp)
+	refreshClaims.TokenType = jwt.RefreshTokenType
+	refreshClaims.FamilyID = familyID
+	refreshToken, err := s.keys.Sign(refreshClaims)
 	if err != nil {
 		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()} // todo
 	}
 
+	ttl := refreshExp.Sub(now)
+	if err := s.cache.Set(ctx, refreshFamilyKeyPrefix+familyID, strconv.FormatUint(uint64(user.ID), 10), ttl); err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	if err := s.cache.Set(ctx, refreshTokenKeyPrefix+refreshClaims.ID, familyID, ttl); err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
 	return &UserToken{
 		AuthorizationToken: authToken,
 		RefreshToken:       refreshToken,
-		ExpiresAt:          authExp.UnixMilli(),
+		ExpiresAt:          authExp.Unix(),
 	}, nil
 }
 
-func (s *AuthService) userClaims(user *user_model.User, exp time.Time) *jwt.UserClaims {
+// upgradePasswordHash rehashes a password stored with a legacy digest or an
+// outdated cost. A failure only delays the upgrade to the next login.
+func (s *AuthService) upgradePasswordHash(ctx context.Context, user *user_model.User, pass string) {
+	if !s.hasher.NeedsRehash(user.Password) {
+		return
+	}
+
+	hash, err := s.hasher.Hash(pass)
+	if err != nil {
+		log.ErrorLog.Printf("Error rehashing password of user %d: %v\n", user.ID, err)
+		return
+	}
+
+	if err := (*s.userRepo).UpdatePassword(ctx, user.ID, hash); err != nil {
+		log.ErrorLog.Printf("Error upgrading password hash of user %d: %v\n", user.ID, err)
+		return
+	}
+	user.Password = hash
+}
+
+func (s *AuthService) userClaims(user *user_model.User, issuedAt time.Time, exp time.Time) *jwt.UserClaims {
 	return &jwt.UserClaims{
 		RegisteredClaims: jwt2.RegisteredClaims{
+			ID:       uuid.NewString(),
+			IssuedAt: jwt2.NewNumericDate(issuedAt),
 			ExpiresAt: &jwt2.NumericDate{
 				Time: exp,
 			},
 		},
-		UserID: user.ID,
-		Role:   user.Role.String(),
+		UserID:        user.ID,
+		Role:          user.Role.String(),
+		IssuedAtMilli: issuedAt.UnixMilli(),
 	}
 }
@@ -0,0 +1,402 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/jwt"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// memoryCache is an in-memory cache, expiration is not tracked.
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: map[string]string{}}
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value string, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = value
+	return nil
+}
+
+func (c *memoryCache) SetNX(_ context.Context, key string, value string, _ time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.values[key]; ok {
+		return false, nil
+	}
+	c.values[key] = value
+	return true, nil
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	if !ok {
+		return "", ports.ErrCacheMiss
+	}
+	return value, nil
+}
+
+func (c *memoryCache) GetDel(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	if !ok {
+		return "", ports.ErrCacheMiss
+	}
+	delete(c.values, key)
+	return value, nil
+}
+
+func (c *memoryCache) Incr(_ context.Context, key string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	if !ok {
+		return 0, ports.ErrCacheMiss
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	c.values[key] = strconv.FormatInt(n+1, 10)
+	return n + 1, nil
+}
+
+func (c *memoryCache) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+func (c *memoryCache) DeleteByPrefix(_ context.Context, prefix string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.values {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.values, key)
+		}
+	}
+	return nil
+}
+
+func (*memoryCache) Close() error { return nil }
+
+// authUserRepo holds a single user and keeps password updates.
+type authUserRepo struct {
+	fakeUserRepo
+	user *domains.User
+}
+
+func (r authUserRepo) GetByEmail(_ context.Context, email string) (*domains.User, error) {
+	if email != r.user.Email {
+		return nil, nil
+	}
+	user := *r.user
+	return &user, nil
+}
+
+func (r authUserRepo) GetByID(_ context.Context, id uint) (*domains.User, error) {
+	if id != r.user.ID {
+		return nil, fiber.NewError(fiber.StatusNotFound, "user not found")
+	}
+	user := *r.user
+	return &user, nil
+}
+
+func (r authUserRepo) UpdatePassword(_ context.Context, _ uint, passwordHash string) error {
+	r.user.Password = passwordHash
+	return nil
+}
+
+func (r authUserRepo) Update(_ context.Context, user *domains.User) error {
+	*r.user = *user
+	return nil
+}
+
+func (r authUserRepo) UpdateMFA(_ context.Context, _ uint, secret string, enabledAt *time.Time) error {
+	r.user.MFASecret, r.user.MFAEnabledAt = secret, enabledAt
+	return nil
+}
+
+func (r authUserRepo) MarkEmailVerified(context.Context, uint) error {
+	now := time.Now()
+	r.user.EmailVerifiedAt = &now
+	return nil
+}
+
+func (r authUserRepo) SetDisabledAt(_ context.Context, _ uint, disabledAt *time.Time) error {
+	r.user.DisabledAt = disabledAt
+	return nil
+}
+
+func (r authUserRepo) SetPasswordResetRequired(_ context.Context, _ uint, required bool) error {
+	r.user.PasswordResetRequired = required
+	return nil
+}
+
+func newTestAuthService(t *testing.T, user *domains.User, cache ports.CacheRepository) *AuthService {
+	t.Helper()
+	keys, err := jwt.NewKeySet([]*jwt.Key{jwt.NewHMACKey(jwt.LegacyKeyID, []byte("secret"), time.Time{})}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []domains.AuditLog
+	return NewAuthService(authUserRepo{user: user}, nil, cache, password.NewHasher(bcrypt.MinCost), nil,
+		NewAuditService(recordingAuditLogRepo{entries: &entries}), keys, 15, 60, 0)
+}
+
+// legacyDigest is an unsalted SHA-256 digest, as passwords were stored before bcrypt.
+func legacyDigest(pass string) string {
+	digest := sha256.Sum256([]byte(pass))
+	return hex.EncodeToString(digest[:])
+}
+
+func TestLoginUpgradesLegacyPasswordHash(t *testing.T) {
+	user := &domains.User{
+		ID:       ownerID,
+		Email:    "owner@example.com",
+		Password: legacyDigest("Secret#1"),
+		Role:     domains.UserRoleUser,
+	}
+	service := newTestAuthService(t, user, newMemoryCache())
+	ctx := context.Background()
+
+	if _, err := service.Login(ctx, user.Email, "Secret#2"); !errors.Is(err, domains.ErrInvalidPassword) {
+		t.Fatalf("expected a wrong password to fail, got %v", err)
+	}
+	if user.Password != legacyDigest("Secret#1") {
+		t.Fatal("expected a failed login to keep the stored hash")
+	}
+
+	result, err := service.Login(ctx, user.Email, "Secret#1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Token == nil {
+		t.Fatal("expected the login to return tokens")
+	}
+
+	if cost, err := bcrypt.Cost([]byte(user.Password)); err != nil || cost != bcrypt.MinCost {
+		t.Fatalf("expected the password to be rehashed with bcrypt, got %q", user.Password)
+	}
+	if !password.Compare(user.Password, "Secret#1") {
+		t.Error("expected the upgraded hash to match the password")
+	}
+
+	upgraded := user.Password
+	if _, err := service.Login(ctx, user.Email, "Secret#1"); err != nil {
+		t.Fatalf("unexpected error logging in with the upgraded hash: %v", err)
+	}
+	if user.Password != upgraded {
+		t.Error("expected a current hash not to be rehashed")
+	}
+}
+
+func startTestSession(t *testing.T, service *AuthService, user *domains.User) *UserToken {
+	t.Helper()
+	result, err := service.StartSession(context.Background(), user)
+	if err != nil {
+		t.Fatalf("start session: %v", err)
+	}
+	return result.Token
+}
+
+func TestRefreshAuthRotatesTokens(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "owner@example.com", Role: domains.UserRoleUser}
+	service := newTestAuthService(t, user, newMemoryCache())
+	ctx := context.Background()
+
+	first := startTestSession(t, service, user)
+	second, err := service.RefreshAuth(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("expected a new refresh token")
+	}
+	if _, err := service.ValidateAccessToken(ctx, second.AuthorizationToken); err != nil {
+		t.Errorf("expected the new access token to be valid, got %v", err)
+	}
+
+	firstClaims, _ := service.keys.Parse(first.RefreshToken)
+	secondClaims, _ := service.keys.Parse(second.RefreshToken)
+	if firstClaims.FamilyID != secondClaims.FamilyID {
+		t.Errorf("expected the rotated token to stay in family %s, got %s", firstClaims.FamilyID, secondClaims.FamilyID)
+	}
+
+	if _, err := service.RefreshAuth(ctx, second.AuthorizationToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected an access token to be refused as refresh token, got %v", err)
+	}
+}
+
+type failingAuditLogRepo struct {
+	ports.AuditLogRepo
+}
+
+func (failingAuditLogRepo) Create(context.Context, *domains.AuditLog) error {
+	return errors.New("audit log unavailable")
+}
+
+func TestRefreshAuthSurvivesAuditFailure(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "owner@example.com", Role: domains.UserRoleUser}
+	service := newTestAuthService(t, user, newMemoryCache())
+	ctx := context.Background()
+
+	first := startTestSession(t, service, user)
+	service.auditService = NewAuditService(failingAuditLogRepo{})
+
+	second, err := service.RefreshAuth(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected the refresh to succeed without the audit log, got %v", err)
+	}
+	if _, err := service.RefreshAuth(ctx, second.RefreshToken); err != nil {
+		t.Errorf("expected the rotated token to stay usable, got %v", err)
+	}
+}
+
+func TestRefreshAuthReuseRevokesFamily(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "owner@example.com", Role: domains.UserRoleUser}
+	service := newTestAuthService(t, user, newMemoryCache())
+	ctx := context.Background()
+
+	first := startTestSession(t, service, user)
+	other := startTestSession(t, service, user)
+
+	second, err := service.RefreshAuth(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+
+	if _, err := service.RefreshAuth(ctx, first.RefreshToken); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("expected reuse to be detected, got %v", err)
+	}
+	if _, err := service.RefreshAuth(ctx, second.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected the rotated token of the family to be revoked, got %v", err)
+	}
+	if _, err := service.RefreshAuth(ctx, other.RefreshToken); err != nil {
+		t.Errorf("expected other sessions to keep working, got %v", err)
+	}
+}
+
+func TestRefreshAuthConcurrentUseCountsAsReuse(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "owner@example.com", Role: domains.UserRoleUser}
+	service := newTestAuthService(t, user, newMemoryCache())
+	token := startTestSession(t, service, user)
+
+	const attempts = 8
+	errs := make(chan error, attempts)
+	var wg sync.WaitGroup
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.RefreshAuth(context.Background(), token.RefreshToken)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, ErrRefreshTokenReused), errors.Is(err, ErrInvalidRefreshToken):
+		default:
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("expected exactly one refresh to succeed, got %d", succeeded)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "owner@example.com", Role: domains.UserRoleUser}
+	service := newTestAuthService(t, user, newMemoryCache())
+	ctx := context.Background()
+
+	token := startTestSession(t, service, user)
+	other := startTestSession(t, service, user)
+
+	claims, err := service.ValidateAccessToken(ctx, token.AuthorizationToken)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if err := service.Logout(ctx, claims, other.RefreshToken); err != nil {
+		t.Fatalf("logout: %v", err)
+	}
+	if err := service.Logout(ctx, claims, token.AuthorizationToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected an access token to be refused as refresh token, got %v", err)
+	}
+
+	if _, err := service.ValidateAccessToken(ctx, token.AuthorizationToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("expected the access token to be revoked, got %v", err)
+	}
+	if _, err := service.RefreshAuth(ctx, other.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected the refresh token family to be revoked, got %v", err)
+	}
+	if _, err := service.ValidateAccessToken(ctx, other.AuthorizationToken); err != nil {
+		t.Errorf("expected the access token of the other session to stay valid, got %v", err)
+	}
+	if _, err := service.RefreshAuth(ctx, token.RefreshToken); err != nil {
+		t.Errorf("expected the refresh token of the logged out access token to be untouched, got %v", err)
+	}
+}
+
+func TestLogoutAll(t *testing.T) {
+	user := &domains.User{ID: ownerID, Email: "owner@example.com", Role: domains.UserRoleUser}
+	service := newTestAuthService(t, user, newMemoryCache())
+	ctx := context.Background()
+
+	token := startTestSession(t, service, user)
+	other := startTestSession(t, service, user)
+
+	// revocation has a precision of a millisecond, a token of the same
+	// millisecond survives it, one of the same second does not
+	time.Sleep(time.Millisecond)
+
+	claims, err := service.ValidateAccessToken(ctx, token.AuthorizationToken)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if err := service.LogoutAll(ctx, claims); err != nil {
+		t.Fatalf("logout all: %v", err)
+	}
+
+	for _, issued := range []*UserToken{token, other} {
+		if _, err := service.ValidateAccessToken(ctx, issued.AuthorizationToken); !errors.Is(err, ErrTokenRevoked) {
+			t.Errorf("expected the access token to be revoked, got %v", err)
+		}
+		if _, err := service.RefreshAuth(ctx, issued.RefreshToken); !errors.Is(err, ErrTokenRevoked) {
+			t.Errorf("expected the refresh token to be revoked, got %v", err)
+		}
+	}
+
+	fresh := startTestSession(t, service, user)
+	if _, err := service.ValidateAccessToken(ctx, fresh.AuthorizationToken); err != nil {
+		t.Errorf("expected a new login to work, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrAccessDenied = &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+)
+
+// BoardAuthorizer checks board actions against the permissions of the roles
+// the user holds on the board, directly and through teams. Boards of a
+// workspace are closed to users outside of the workspace, even to board members.
+type BoardAuthorizer struct {
+	boardRepo           ports.BoardRepo
+	boardMemberRepo     ports.BoardMemberRepo
+	roleRepo            ports.RoleRepository
+	workspaceMemberRepo ports.WorkspaceMemberRepo
+	boardTeamRepo       ports.BoardTeamRepo
+}
+
+func NewBoardAuthorizer(boardRepo ports.BoardRepo, boardMemberRepo ports.BoardMemberRepo, roleRepo ports.RoleRepository,
+	workspaceMemberRepo ports.WorkspaceMemberRepo, boardTeamRepo ports.BoardTeamRepo) *BoardAuthorizer {
+	return &BoardAuthorizer{
+		boardRepo:           boardRepo,
+		boardMemberRepo:     boardMemberRepo,
+		roleRepo:            roleRepo,
+		workspaceMemberRepo: workspaceMemberRepo,
+		boardTeamRepo:       boardTeamRepo,
+	}
+}
+
+func (a *BoardAuthorizer) Authorize(ctx context.Context, userID, boardID uint, action domains.BoardAction) error {
+	if !action.IsValid() {
+		return ErrAccessDenied
+	}
+
+	board, err := a.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	workspaceRole, err := a.workspaceRole(ctx, userID, board)
+	if err != nil {
+		return err
+	}
+
+	role, err := a.memberRole(ctx, userID, boardID)
+	if err != nil {
+		return err
+	}
+	if role != nil {
+		if !role.Can(action) {
+			return ErrAccessDenied
+		}
+		return nil
+	}
+
+	if !action.IsPublic() || board.IsPrivate || workspaceRole == domains.WorkspaceRoleGuest {
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+func (a *BoardAuthorizer) AuthorizeRole(ctx context.Context, userID, boardID uint, action domains.BoardAction, roles ...*domains.Role) error {
+	if err := a.Authorize(ctx, userID, boardID, action); err != nil {
+		return err
+	}
+
+	userRole, err := a.memberRole(ctx, userID, boardID)
+	if err != nil {
+		return err
+	}
+	if userRole == nil {
+		return ErrAccessDenied
+	}
+	for _, role := range roles {
+		if !userRole.Covers(role) {
+			return ErrAccessDenied
+		}
+	}
+	return nil
+}
+
+// workspaceRole returns the role of the user in the workspace of the board,
+// users outside of the workspace are denied.
+func (a *BoardAuthorizer) workspaceRole(ctx context.Context, userID uint, board *domains.Board) (domains.WorkspaceRole, error) {
+	if board.WorkspaceID == nil {
+		return "", nil
+	}
+	member, err := a.workspaceMemberRepo.GetMember(ctx, *board.WorkspaceID, userID)
+	if err != nil {
+		if isNotFound(err) {
+			return "", ErrAccessDenied
+		}
+		return "", err
+	}
+	return member.Role, nil
+}
+
+func (a *BoardAuthorizer) MemberRole(ctx context.Context, userID, boardID uint) (*domains.Role, error) {
+	board, err := a.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = a.workspaceRole(ctx, userID, board); err != nil {
+		if errors.Is(err, ErrAccessDenied) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return a.memberRole(ctx, userID, boardID)
+}
+
+// memberRole returns the role the user holds on the board directly and
+// through teams, or nil when the user is not a member of the board.
+func (a *BoardAuthorizer) memberRole(ctx context.Context, userID, boardID uint) (*domains.Role, error) {
+	roleIDs, err := a.boardTeamRepo.GetUserRoleIDs(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := a.boardMemberRepo.GetBoardMember(ctx, boardID, userID)
+	if err == nil {
+		roleIDs = append(roleIDs, member.RoleID)
+	} else if !isNotFound(err) {
+		return nil, err
+	}
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+
+	roles := make([]*domains.Role, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		role, err := a.roleRepo.GetByID(ctx, roleID)
+		if err != nil {
+			return nil, err
+		}
+		// custom roles only ever apply to their own board
+		if role.BoardID != nil && *role.BoardID != boardID {
+			return nil, ErrAccessDenied
+		}
+		roles = append(roles, role)
+	}
+	return domains.MergeRoles(roles...), nil
+}
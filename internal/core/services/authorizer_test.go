@@ -0,0 +1,699 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Every board has one member per role, user ids follow the role weights.
+const (
+	ownerID      uint = 1
+	maintainerID uint = 2
+	editorID     uint = 3
+	viewerID     uint = 4
+	outsiderID   uint = 5
+
+	privateBoardID uint = 1
+	publicBoardID  uint = 2
+)
+
+var testRoles = []domains.RoleW{domains.Owner, domains.Maintainer, domains.Editor, domains.Viewer}
+
+func roleID(role domains.RoleW) uint { return uint(role) + 1 }
+
+func memberID(role domains.RoleW) uint { return uint(role) + 1 }
+
+type fakeBoardRepo struct {
+	ports.BoardRepo
+}
+
+func (fakeBoardRepo) GetByID(_ context.Context, id uint) (*domains.Board, error) {
+	if id != privateBoardID && id != publicBoardID {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Board not found")
+	}
+	return &domains.Board{ID: id, CreatedBy: ownerID, IsPrivate: id == privateBoardID}, nil
+}
+
+func (fakeBoardRepo) GetDeletedByID(_ context.Context, id uint) (*domains.Board, error) {
+	deletedBy := ownerID
+	return &domains.Board{ID: id, CreatedBy: ownerID, DeletedBy: &deletedBy}, nil
+}
+
+func (fakeBoardRepo) Restore(context.Context, uint) error { return nil }
+
+type fakeBoardMemberRepo struct {
+	ports.BoardMemberRepo
+}
+
+func (fakeBoardMemberRepo) GetBoardMember(_ context.Context, boardID, userID uint) (*domains.BoardMember, error) {
+	if userID < ownerID || userID > viewerID {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Board member not found")
+	}
+	return &domains.BoardMember{ID: boardID*10 + userID, BoardID: boardID, UserID: userID, RoleID: userID}, nil
+}
+
+type fakeRoleRepo struct {
+	ports.RoleRepository
+}
+
+func builtinRole(role domains.RoleW) *domains.Role {
+	return &domains.Role{ID: roleID(role), Name: role.String(), Permissions: role.Permissions()}
+}
+
+func (fakeRoleRepo) GetByID(_ context.Context, id uint) (*domains.Role, error) {
+	return builtinRole(domains.RoleW(id - 1)), nil
+}
+
+func (fakeRoleRepo) GetByName(_ context.Context, name string) (*domains.Role, error) {
+	role, err := domains.ParseRole(name)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+	return builtinRole(role), nil
+}
+
+func (r fakeRoleRepo) GetBoardRoleByName(ctx context.Context, _ uint, name string) (*domains.Role, error) {
+	return r.GetByName(ctx, name)
+}
+
+type fakeUserRepo struct {
+	ports.UserRepo
+}
+
+func (fakeUserRepo) GetByID(_ context.Context, id uint) (*domains.User, error) {
+	return &domains.User{ID: id}, nil
+}
+
+// every board has a task and a column with the id of the board
+type fakeTaskRepo struct {
+	ports.TaskRepo
+}
+
+func (fakeTaskRepo) GetByID(_ context.Context, id uint) (*domains.Task, error) {
+	return &domains.Task{ID: id, BoardID: id}, nil
+}
+
+// no user is in a team unless a test says so, every board has a viewer team
+type fakeBoardTeamRepo struct {
+	ports.BoardTeamRepo
+}
+
+func (fakeBoardTeamRepo) GetUserRoleIDs(context.Context, uint, uint) ([]uint, error) {
+	return nil, nil
+}
+
+func (fakeBoardTeamRepo) GetBoardTeam(_ context.Context, boardID, teamID uint) (*domains.BoardTeam, error) {
+	role := builtinRole(domains.Viewer)
+	return &domains.BoardTeam{ID: boardID, BoardID: boardID, TeamID: teamID, RoleID: role.ID, Role: role}, nil
+}
+
+type fakeColumnRepo struct {
+	ports.ColumnRepo
+}
+
+func (fakeColumnRepo) GetByID(ctx context.Context, id uint) (*domains.Column, error) {
+	board, err := fakeBoardRepo{}.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &domains.Column{ID: id, BoardID: id, Board: board}, nil
+}
+
+var errAuthorized = errors.New("authorized")
+
+// stopAuthorizer ends service calls right after a successful authorization, so
+// the services do not need working repositories behind the check. Routes that
+// check the role of another member stop after that role check.
+type stopAuthorizer struct {
+	next      ports.Authorizer
+	roleCheck bool
+}
+
+func (a *stopAuthorizer) Authorize(ctx context.Context, userID, boardID uint, action domains.BoardAction) error {
+	if err := a.next.Authorize(ctx, userID, boardID, action); err != nil {
+		return err
+	}
+	if a.roleCheck {
+		return nil
+	}
+	return errAuthorized
+}
+
+func (a *stopAuthorizer) AuthorizeRole(ctx context.Context, userID, boardID uint, action domains.BoardAction, roles ...*domains.Role) error {
+	if err := a.next.AuthorizeRole(ctx, userID, boardID, action, roles...); err != nil {
+		return err
+	}
+	return errAuthorized
+}
+
+func (a *stopAuthorizer) MemberRole(ctx context.Context, userID, boardID uint) (*domains.Role, error) {
+	return a.next.MemberRole(ctx, userID, boardID)
+}
+
+type testServices struct {
+	board     *BoardService
+	column    *ColumnService
+	task      *TaskService
+	sprint    *SprintService
+	view      *SavedViewService
+	analytics *AnalyticsService
+	trash     *TrashService
+	role      *RoleService
+	share     *BoardShareService
+	team      *TeamService
+}
+
+func newTestServices(roleCheck bool) *testServices {
+	boardRepo := fakeBoardRepo{}
+	authorizer := &stopAuthorizer{
+		next:      NewBoardAuthorizer(boardRepo, fakeBoardMemberRepo{}, fakeRoleRepo{}, nil, fakeBoardTeamRepo{}),
+		roleCheck: roleCheck,
+	}
+
+	boardService := NewBoardService(boardRepo, fakeBoardMemberRepo{}, fakeUserRepo{}, fakeRoleRepo{}, nil, authorizer, nil)
+	columnService := NewColumnService(fakeColumnRepo{}, authorizer)
+	taskService := NewTaskService(fakeTaskRepo{}, nil, boardService, columnService, nil, nil, authorizer)
+	return &testServices{
+		board:     boardService,
+		column:    columnService,
+		task:      taskService,
+		sprint:    NewSprintService(nil, nil, nil, authorizer),
+		view:      NewSavedViewService(nil, taskService, authorizer),
+		analytics: NewAnalyticsService(nil, nil, boardService, authorizer),
+		trash:     NewTrashService(nil, nil, boardRepo, authorizer),
+		role:      NewRoleService(fakeRoleRepo{}, fakeUserRepo{}, authorizer),
+		share:     NewBoardShareService(nil, boardRepo, nil, nil, authorizer),
+		team:      NewTeamService(nil, nil, nil, boardRepo, fakeBoardTeamRepo{}, fakeRoleRepo{}, nil, authorizer, nil, nil),
+	}
+}
+
+// routeCase is a board scoped route with the lowest role allowed to use it.
+// Public routes are also open to non-members of public boards.
+type routeCase struct {
+	route     string
+	minRole   domains.RoleW
+	public    bool
+	roleCheck bool
+	call      func(s *testServices, userID, boardID uint) error
+}
+
+func ignore[T any](_ T, err error) error { return err }
+
+func ignore2[T any](_ T, _ uint, err error) error { return err }
+
+var routeCases = []routeCase{
+	// boards
+	{route: "GET /boards/:id", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore(s.board.GetBoardByID(context.Background(), u, b))
+	}},
+	{route: "PUT /boards/:id", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.board.UpdateBoard(context.Background(), u, &domains.Board{ID: b, Name: "board"})
+	}},
+	{route: "DELETE /boards/:id", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		return s.board.DeleteBoard(context.Background(), u, b)
+	}},
+	{route: "POST /boards/:id/archive", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		return s.board.ArchiveBoard(context.Background(), u, b)
+	}},
+	{route: "POST /boards/:id/unarchive", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		return s.board.UnarchiveBoard(context.Background(), u, b)
+	}},
+	{route: "POST /boards/:id/transfer-ownership", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		return s.board.TransferOwnership(context.Background(), u, b, editorID)
+	}},
+	{route: "POST /boards/:id/add-user as Viewer", minRole: domains.Maintainer, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.board.InviteUserToBoard(context.Background(), u, outsiderID, b, domains.Viewer.String())
+	}},
+	{route: "POST /boards/:id/add-user as Maintainer", minRole: domains.Maintainer, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.board.InviteUserToBoard(context.Background(), u, outsiderID, b, domains.Maintainer.String())
+	}},
+	{route: "POST /boards/:id/add-user as Owner", minRole: domains.Owner, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.board.InviteUserToBoard(context.Background(), u, outsiderID, b, domains.Owner.String())
+	}},
+	{route: "DELETE /boards/:board_id/users/:user_id of a Viewer", minRole: domains.Maintainer, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.board.RemoveUserFromBoard(context.Background(), u, viewerID, b)
+	}},
+	{route: "DELETE /boards/:board_id/users/:user_id of an Owner", minRole: domains.Owner, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.board.RemoveUserFromBoard(context.Background(), u, ownerID, b)
+	}},
+	{route: "PUT /boards/:board_id/users/:user_id Viewer to Editor", minRole: domains.Maintainer, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.board.ChangeUserRole(context.Background(), u, viewerID, b, domains.Editor.String())
+	}},
+	{route: "PUT /boards/:board_id/users/:user_id Viewer to Owner", minRole: domains.Owner, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.board.ChangeUserRole(context.Background(), u, viewerID, b, domains.Owner.String())
+	}},
+	{route: "PUT /boards/:board_id/users/:user_id Owner to Viewer", minRole: domains.Owner, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.board.ChangeUserRole(context.Background(), u, ownerID, b, domains.Viewer.String())
+	}},
+
+	// roles, custom roles can not hold permissions their creator does not have
+	{route: "GET /boards/:id/roles", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore(s.role.GetBoardRoles(context.Background(), u, b))
+	}},
+	{route: "POST /roles for a board with Editor permissions", minRole: domains.Maintainer, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.role.CreateRole(context.Background(), u, &domains.Role{Name: "role", BoardID: &b, Permissions: domains.Editor.Permissions()})
+	}},
+	{route: "POST /roles for a board with Owner permissions", minRole: domains.Owner, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.role.CreateRole(context.Background(), u, &domains.Role{Name: "role", BoardID: &b, Permissions: domains.Owner.Permissions()})
+	}},
+
+	// share links
+	{route: "POST /boards/:id/share-links", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		_, _, err := s.share.CreateLink(context.Background(), u, b, nil)
+		return err
+	}},
+	{route: "GET /boards/:id/share-links", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		return ignore(s.share.GetLinks(context.Background(), u, b))
+	}},
+	{route: "DELETE /boards/:id/share-links/:linkID", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		return s.share.RevokeLink(context.Background(), u, b, 1)
+	}},
+
+	// teams
+	{route: "GET /boards/:id/teams", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore(s.team.GetBoardTeams(context.Background(), u, b))
+	}},
+	{route: "POST /boards/:id/teams as Viewer", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.team.GrantBoardRole(context.Background(), u, b, 1, domains.Viewer.String()))
+	}},
+	{route: "POST /boards/:id/teams as Owner", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		return ignore(s.team.GrantBoardRole(context.Background(), u, b, 1, domains.Owner.String()))
+	}},
+	{route: "PUT /boards/:id/teams/:teamID Viewer to Owner", minRole: domains.Owner, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.team.ChangeBoardTeamRole(context.Background(), u, b, 1, domains.Owner.String())
+	}},
+	{route: "DELETE /boards/:id/teams/:teamID of a Viewer team", minRole: domains.Maintainer, roleCheck: true, call: func(s *testServices, u, b uint) error {
+		return s.team.RevokeBoardRole(context.Background(), u, b, 1)
+	}},
+
+	// columns
+	{route: "POST /boards/:boardId/columns", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.column.CreateColumn(context.Background(), &domains.Column{BoardID: b, CreatedBy: u, Name: "column"})
+	}},
+	{route: "GET /boards/:boardId/columns", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore(s.column.GetAllColumns(context.Background(), u, b, 10, 0))
+	}},
+	{route: "GET /boards/:boardId/columns/:id", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore(s.column.GetColumnById(context.Background(), u, b))
+	}},
+	{route: "PUT /boards/:boardId/columns/:id", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return s.column.Update(context.Background(), b, u, &domains.ColumnUpdate{ID: b, Name: "column"})
+	}},
+	{route: "PUT /boards/:boardId/columns/:id/move", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return s.column.Move(context.Background(), b, u, &domains.ColumnMove{ID: b})
+	}},
+	{route: "PUT /boards/:boardId/columns/:id/final", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return s.column.Final(context.Background(), b, u, b)
+	}},
+	{route: "DELETE /boards/:boardId/columns/:id", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return s.column.Delete(context.Background(), b, u, b, nil)
+	}},
+	{route: "POST /boards/:boardId/columns/:id/archive", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.column.ArchiveColumn(context.Background(), b, u, b)
+	}},
+	{route: "POST /boards/:boardId/columns/:id/unarchive", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.column.UnarchiveColumn(context.Background(), b, u, b)
+	}},
+
+	// tasks
+	{route: "POST /boards/:boardID/tasks", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.CreateTask(context.Background(), &domains.Task{BoardID: b, CreatedBy: u}))
+	}},
+	{route: "POST /boards/:boardID/tasks/bulk move", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.BulkTaskOperation(context.Background(), u, b, domains.BulkTaskOperation{Type: domains.BulkTaskMove, TaskIDs: []uint{b}, ColumnID: b}))
+	}},
+	{route: "POST /boards/:boardID/tasks/bulk delete", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.BulkTaskOperation(context.Background(), u, b, domains.BulkTaskOperation{Type: domains.BulkTaskDelete, TaskIDs: []uint{b}}))
+	}},
+	{route: "PUT /boards/:boardID/tasks/:id", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.UpdateTask(context.Background(), u, b, &domains.Task{ID: b, BoardID: b}))
+	}},
+	{route: "GET /boards/:boardID/tasks", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore2(s.task.GetTasksByBoardID(context.Background(), u, b, domains.TaskFilter{}, 1, 10))
+	}},
+	{route: "GET /boards/:boardID/tasks/:id", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.GetTaskByID(context.Background(), u, b, b))
+	}},
+	{route: "GET /boards/:boardID/tasks/:id/children", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.GetTaskChildren(context.Background(), u, b, b))
+	}},
+	{route: "DELETE /boards/:boardID/tasks/:id", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.task.DeleteTask(context.Background(), u, b)
+	}},
+	{route: "POST /boards/:boardID/tasks/:id/archive", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.task.ArchiveTask(context.Background(), u, b, b)
+	}},
+	{route: "POST /boards/:boardID/tasks/:id/unarchive", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.task.UnarchiveTask(context.Background(), u, b, b)
+	}},
+	{route: "PATCH /boards/:boardID/tasks/:id/column", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.ChangeTaskColumn(context.Background(), u, &domains.Task{ID: b, BoardID: b}, b))
+	}},
+	{route: "POST /boards/:boardID/tasks/:taskID/dependencies/:dependentTaskID", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return s.task.AddTaskDependency(context.Background(), u, b, b, b)
+	}},
+	{route: "DELETE /boards/:boardID/tasks/:taskID/dependencies/:dependentTaskID", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return s.task.RemoveTaskDependency(context.Background(), u, b, b, b)
+	}},
+	{route: "GET /boards/:boardID/tasks/:taskID/dependencies", minRole: domains.Viewer, public: true, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.GetTaskDependencies(context.Background(), u, b, b))
+	}},
+	{route: "POST /boards/:boardID/tasks/:taskID/comments", minRole: domains.Editor, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.CreateComment(context.Background(), u, b, &domains.TaskComment{UserID: u, TaskID: b}))
+	}},
+	{route: "GET /boards/:boardID/tasks/:taskID/comments", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore2(s.task.GetTaskComments(context.Background(), u, b, b, 1, 10))
+	}},
+	{route: "GET /boards/:boardID/tasks/:taskID/comments/:id", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.task.GetTaskComment(context.Background(), u, b, b, "comment"))
+	}},
+	{route: "DELETE /boards/:boardID/tasks/:taskID/comments/:id", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.task.DeleteComment(context.Background(), u, b, b, "comment")
+	}},
+
+	// sprints
+	{route: "POST /boards/:id/sprints", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.sprint.CreateSprint(context.Background(), &domains.Sprint{BoardID: b, CreatedBy: u})
+	}},
+	{route: "GET /boards/:id/sprints", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.sprint.GetSprints(context.Background(), u, b))
+	}},
+	{route: "GET /boards/:id/sprints/:sprintId", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.sprint.GetSprintByID(context.Background(), u, b, 1))
+	}},
+	{route: "PUT /boards/:id/sprints/:sprintId", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.sprint.UpdateSprint(context.Background(), u, &domains.Sprint{ID: 1, BoardID: b}))
+	}},
+	{route: "DELETE /boards/:id/sprints/:sprintId", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.sprint.DeleteSprint(context.Background(), u, b, 1)
+	}},
+	{route: "POST /boards/:id/sprints/:sprintId/tasks", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.sprint.AssignTasks(context.Background(), u, b, 1, []uint{b})
+	}},
+	{route: "DELETE /boards/:id/sprints/:sprintId/tasks/:taskId", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.sprint.RemoveTask(context.Background(), u, b, 1, b)
+	}},
+	{route: "POST /boards/:id/sprints/:sprintId/start", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.sprint.StartSprint(context.Background(), u, b, 1))
+	}},
+	{route: "POST /boards/:id/sprints/:sprintId/close", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.sprint.CloseSprint(context.Background(), u, b, 1, nil))
+	}},
+	{route: "GET /boards/:id/sprints/:sprintId/burndown", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.sprint.GetBurndown(context.Background(), u, b, 1))
+	}},
+
+	// saved views, views of the user can be changed by every member
+	{route: "POST /boards/:boardID/views", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return s.view.CreateView(context.Background(), &domains.SavedView{UserID: u, BoardID: b})
+	}},
+	{route: "GET /boards/:boardID/views", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.view.GetViews(context.Background(), u, b))
+	}},
+	{route: "GET /boards/:boardID/views/:id", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.view.GetViewByID(context.Background(), u, b, 1))
+	}},
+	{route: "PUT /boards/:boardID/views/:id", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.view.UpdateView(context.Background(), u, &domains.SavedView{ID: 1, UserID: u, BoardID: b}))
+	}},
+	{route: "DELETE /boards/:boardID/views/:id", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return s.view.DeleteView(context.Background(), u, b, 1)
+	}},
+	{route: "GET /boards/:boardID/views/:id/tasks", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore2(s.view.ExecuteView(context.Background(), u, b, 1, 1, 10))
+	}},
+
+	// analytics
+	{route: "GET /boards/:id/analytics", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.analytics.GetBoardAnalytics(context.Background(), u, b, time.Time{}, time.Time{}))
+	}},
+	{route: "GET /boards/:id/workload", minRole: domains.Viewer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.analytics.GetBoardWorkload(context.Background(), u, b))
+	}},
+
+	// trash, deleted boards can only be restored by the owner who deleted them
+	{route: "GET /boards/:boardID/trash", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return ignore(s.trash.GetBoardTrash(context.Background(), u, b))
+	}},
+	{route: "POST /boards/:boardID/trash/tasks/:id/restore", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.trash.RestoreTask(context.Background(), u, b, b)
+	}},
+	{route: "POST /boards/:boardID/trash/columns/:id/restore", minRole: domains.Maintainer, call: func(s *testServices, u, b uint) error {
+		return s.trash.RestoreColumn(context.Background(), u, b, b)
+	}},
+	{route: "POST /boards/:boardID/restore", minRole: domains.Owner, call: func(s *testServices, u, b uint) error {
+		return s.trash.RestoreBoard(context.Background(), u, b)
+	}},
+}
+
+func TestRouteAuthorization(t *testing.T) {
+	users := []struct {
+		name   string
+		id     uint
+		role   domains.RoleW
+		member bool
+	}{
+		{name: "owner", id: ownerID, role: domains.Owner, member: true},
+		{name: "maintainer", id: maintainerID, role: domains.Maintainer, member: true},
+		{name: "editor", id: editorID, role: domains.Editor, member: true},
+		{name: "viewer", id: viewerID, role: domains.Viewer, member: true},
+		{name: "outsider", id: outsiderID},
+	}
+	boards := []struct {
+		name string
+		id   uint
+	}{
+		{name: "private", id: privateBoardID},
+		{name: "public", id: publicBoardID},
+	}
+
+	for _, tc := range routeCases {
+		for _, board := range boards {
+			for _, user := range users {
+				want := (user.member && user.role <= tc.minRole) ||
+					(tc.public && board.id == publicBoardID)
+
+				t.Run(fmt.Sprintf("%s/%s board/%s", tc.route, board.name, user.name), func(t *testing.T) {
+					err := tc.call(newTestServices(tc.roleCheck), user.id, board.id)
+
+					allowed := err == nil || errors.Is(err, errAuthorized)
+					var fiberErr *fiber.Error
+					denied := errors.As(err, &fiberErr) && fiberErr.Code == fiber.StatusForbidden
+					if !allowed && !denied {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					if allowed != want {
+						t.Errorf("allowed = %v, want %v", allowed, want)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestBoardAuthorizerDeniesUnknownActions(t *testing.T) {
+	authorizer := NewBoardAuthorizer(fakeBoardRepo{}, fakeBoardMemberRepo{}, fakeRoleRepo{}, nil, fakeBoardTeamRepo{})
+
+	for _, role := range testRoles {
+		err := authorizer.Authorize(context.Background(), memberID(role), publicBoardID, "board:unknown")
+		if !errors.Is(err, ErrAccessDenied) {
+			t.Errorf("%s: err = %v, want access denied", role, err)
+		}
+	}
+}
+
+// a member of the private board holding a custom role of that board
+const (
+	customUserID uint = 6
+	customRoleID uint = 6
+)
+
+type customRoleMemberRepo struct {
+	fakeBoardMemberRepo
+}
+
+func (r customRoleMemberRepo) GetBoardMember(ctx context.Context, boardID, userID uint) (*domains.BoardMember, error) {
+	if userID == customUserID {
+		return &domains.BoardMember{ID: boardID*10 + userID, BoardID: boardID, UserID: userID, RoleID: customRoleID}, nil
+	}
+	return r.fakeBoardMemberRepo.GetBoardMember(ctx, boardID, userID)
+}
+
+type customRoleRepo struct {
+	fakeRoleRepo
+}
+
+func (r customRoleRepo) GetByID(ctx context.Context, id uint) (*domains.Role, error) {
+	if id == customRoleID {
+		boardID := privateBoardID
+		return &domains.Role{
+			ID:          id,
+			Name:        "Mover",
+			BoardID:     &boardID,
+			Permissions: []domains.BoardAction{domains.ActionBoardView, domains.ActionTaskView, domains.ActionTaskMove},
+		}, nil
+	}
+	return r.fakeRoleRepo.GetByID(ctx, id)
+}
+
+func TestBoardAuthorizerCustomRole(t *testing.T) {
+	authorizer := NewBoardAuthorizer(fakeBoardRepo{}, customRoleMemberRepo{}, customRoleRepo{}, nil, fakeBoardTeamRepo{})
+
+	cases := []struct {
+		action  domains.BoardAction
+		boardID uint
+		want    bool
+	}{
+		{action: domains.ActionTaskMove, boardID: privateBoardID, want: true},
+		{action: domains.ActionTaskView, boardID: privateBoardID, want: true},
+		{action: domains.ActionColumnView, boardID: privateBoardID, want: false},
+		{action: domains.ActionTaskCreate, boardID: privateBoardID, want: false},
+		{action: domains.ActionBoardMemberInvite, boardID: privateBoardID, want: false},
+		// the role does not apply on other boards
+		{action: domains.ActionTaskMove, boardID: publicBoardID, want: false},
+		{action: domains.ActionTaskView, boardID: publicBoardID, want: false},
+	}
+	for _, tc := range cases {
+		err := authorizer.Authorize(context.Background(), customUserID, tc.boardID, tc.action)
+		if allowed := err == nil; allowed != tc.want {
+			t.Errorf("%s on board %d: err = %v, want allowed %v", tc.action, tc.boardID, err, tc.want)
+		}
+	}
+}
+
+func TestBoardAuthorizerRoleNeedsCoveringPermissions(t *testing.T) {
+	authorizer := NewBoardAuthorizer(fakeBoardRepo{}, fakeBoardMemberRepo{}, fakeRoleRepo{}, nil, fakeBoardTeamRepo{})
+	mover := &domains.Role{Permissions: []domains.BoardAction{domains.ActionTaskMove}}
+	deleter := &domains.Role{Permissions: []domains.BoardAction{domains.ActionBoardDelete}}
+
+	cases := []struct {
+		name   string
+		userID uint
+		roles  []*domains.Role
+		want   bool
+	}{
+		{name: "maintainer grants a permission it holds", userID: maintainerID, roles: []*domains.Role{mover}, want: true},
+		{name: "maintainer grants a permission it lacks", userID: maintainerID, roles: []*domains.Role{mover, deleter}, want: false},
+		{name: "owner grants every permission", userID: ownerID, roles: []*domains.Role{mover, deleter}, want: true},
+		{name: "editor can not invite", userID: editorID, roles: []*domains.Role{mover}, want: false},
+	}
+	for _, tc := range cases {
+		err := authorizer.AuthorizeRole(context.Background(), tc.userID, privateBoardID, domains.ActionBoardMemberInvite, tc.roles...)
+		if allowed := err == nil; allowed != tc.want {
+			t.Errorf("%s: err = %v, want allowed %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+// a public board of a workspace the viewer of the board has left
+const (
+	workspaceBoardID  uint = 3
+	workspaceID       uint = 1
+	workspaceMemberID uint = 7
+	workspaceGuestID  uint = 8
+)
+
+type workspaceBoardRepo struct {
+	fakeBoardRepo
+}
+
+func (r workspaceBoardRepo) GetByID(ctx context.Context, id uint) (*domains.Board, error) {
+	if id == workspaceBoardID {
+		workspace := workspaceID
+		return &domains.Board{ID: id, CreatedBy: ownerID, WorkspaceID: &workspace}, nil
+	}
+	return r.fakeBoardRepo.GetByID(ctx, id)
+}
+
+type fakeWorkspaceMemberRepo struct {
+	ports.WorkspaceMemberRepo
+}
+
+func (fakeWorkspaceMemberRepo) GetMember(_ context.Context, workspaceID, userID uint) (*domains.WorkspaceMember, error) {
+	role := domains.WorkspaceRoleMember
+	switch userID {
+	case viewerID, outsiderID:
+		return nil, fiber.NewError(fiber.StatusNotFound, "workspace member not found")
+	case workspaceGuestID:
+		role = domains.WorkspaceRoleGuest
+	}
+	return &domains.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: role}, nil
+}
+
+func TestBoardAuthorizerWorkspaceBoards(t *testing.T) {
+	authorizer := NewBoardAuthorizer(workspaceBoardRepo{}, fakeBoardMemberRepo{}, fakeRoleRepo{}, fakeWorkspaceMemberRepo{}, fakeBoardTeamRepo{})
+
+	cases := []struct {
+		name   string
+		userID uint
+		action domains.BoardAction
+		want   bool
+	}{
+		{name: "board member updates", userID: maintainerID, action: domains.ActionBoardUpdate, want: true},
+		{name: "board member outside of the workspace views", userID: viewerID, action: domains.ActionBoardView, want: false},
+		{name: "workspace member views the public board", userID: workspaceMemberID, action: domains.ActionTaskView, want: true},
+		{name: "workspace member updates the public board", userID: workspaceMemberID, action: domains.ActionBoardUpdate, want: false},
+		{name: "workspace guest views the public board", userID: workspaceGuestID, action: domains.ActionBoardView, want: false},
+		{name: "outsider views the public board", userID: outsiderID, action: domains.ActionBoardView, want: false},
+	}
+	for _, tc := range cases {
+		err := authorizer.Authorize(context.Background(), tc.userID, workspaceBoardID, tc.action)
+		if allowed := err == nil; allowed != tc.want {
+			t.Errorf("%s: err = %v, want allowed %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+// the viewer holds the custom mover role of the private board through a team,
+// the workspace guest and the outsider hold the editor role on the workspace
+// board through a team
+type teamBoardTeamRepo struct {
+	ports.BoardTeamRepo
+}
+
+func (teamBoardTeamRepo) GetUserRoleIDs(_ context.Context, boardID, userID uint) ([]uint, error) {
+	switch {
+	case boardID == privateBoardID && userID == viewerID:
+		return []uint{customRoleID}, nil
+	case boardID == workspaceBoardID && (userID == workspaceGuestID || userID == outsiderID):
+		return []uint{roleID(domains.Editor)}, nil
+	}
+	return nil, nil
+}
+
+func TestBoardAuthorizerTeamRoles(t *testing.T) {
+	authorizer := NewBoardAuthorizer(workspaceBoardRepo{}, fakeBoardMemberRepo{}, customRoleRepo{}, fakeWorkspaceMemberRepo{},
+		teamBoardTeamRepo{})
+
+	cases := []struct {
+		name    string
+		userID  uint
+		boardID uint
+		action  domains.BoardAction
+		want    bool
+	}{
+		{name: "viewer keeps the viewer permissions", userID: viewerID, boardID: privateBoardID, action: domains.ActionColumnView, want: true},
+		{name: "viewer moves tasks through the team", userID: viewerID, boardID: privateBoardID, action: domains.ActionTaskMove, want: true},
+		{name: "viewer still can not create tasks", userID: viewerID, boardID: privateBoardID, action: domains.ActionTaskCreate, want: false},
+		{name: "workspace guest edits through the team", userID: workspaceGuestID, boardID: workspaceBoardID, action: domains.ActionTaskMove, want: true},
+		{name: "outsider is denied despite the team", userID: outsiderID, boardID: workspaceBoardID, action: domains.ActionBoardView, want: false},
+	}
+	for _, tc := range cases {
+		err := authorizer.Authorize(context.Background(), tc.userID, tc.boardID, tc.action)
+		if allowed := err == nil; allowed != tc.want {
+			t.Errorf("%s: err = %v, want allowed %v", tc.name, err, tc.want)
+		}
+	}
+
+	role, err := authorizer.MemberRole(context.Background(), outsiderID, workspaceBoardID)
+	if err != nil || role != nil {
+		t.Errorf("outsider member role = %v, %v, want none", role, err)
+	}
+}
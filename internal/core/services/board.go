@@ -2,49 +2,153 @@ package services
 
 import (
 	"context"
+	"strconv"
+
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
 	"github.com/gofiber/fiber/v2"
 )
 
 type BoardService struct {
-	boardRepo       ports.BoardRepo
-	boardMemberRepo ports.BoardMemberRepo
-	userRepo        ports.UserRepo
-	roleRepo        ports.RoleRepository
+	boardRepo           ports.BoardRepo
+	boardMemberRepo     ports.BoardMemberRepo
+	userRepo            ports.UserRepo
+	roleRepo            ports.RoleRepository
+	workspaceMemberRepo ports.WorkspaceMemberRepo
+	authorizer          ports.Authorizer
+	auditService        *AuditService
 }
 
 var (
 	ErrUserIsAlreadyBoardMember = fiber.NewError(fiber.StatusBadRequest, "user is already a board member")
+	ErrBoardNeedsOwner          = fiber.NewError(fiber.StatusBadRequest, "board must keep at least one owner")
+	ErrTransferToSelf           = fiber.NewError(fiber.StatusBadRequest, "user already owns the board")
 )
 
-func NewBoardService(boardRepo ports.BoardRepo, boardMemberRepo ports.BoardMemberRepo, userRepo ports.UserRepo, roleRepo ports.RoleRepository) *BoardService {
+func NewBoardService(boardRepo ports.BoardRepo, boardMemberRepo ports.BoardMemberRepo, userRepo ports.UserRepo, roleRepo ports.RoleRepository,
+	workspaceMemberRepo ports.WorkspaceMemberRepo, authorizer ports.Authorizer, auditService *AuditService) *BoardService {
 	return &BoardService{boardRepo: boardRepo,
-		boardMemberRepo: boardMemberRepo,
-		userRepo:        userRepo,
-		roleRepo:        roleRepo}
+		boardMemberRepo:     boardMemberRepo,
+		userRepo:            userRepo,
+		roleRepo:            roleRepo,
+		workspaceMemberRepo: workspaceMemberRepo,
+		authorizer:          authorizer,
+		auditService:        auditService}
 }
 
+// CreateBoard creates the board and enrolls its creator as the board owner,
+// boards of a workspace can be created by its members who are not guests.
 func (s *BoardService) CreateBoard(ctx context.Context, board *domains.Board) error {
-	return s.boardRepo.Create(ctx, board)
+	if board.WorkspaceID != nil {
+		member, err := s.workspaceMemberRepo.GetMember(ctx, *board.WorkspaceID, board.CreatedBy)
+		if err != nil {
+			if isNotFound(err) {
+				return ErrNotWorkspaceMember
+			}
+			return err
+		}
+		if !member.Role.AtLeast(domains.WorkspaceRoleMember) {
+			return ErrAccessDenied
+		}
+	}
+
+	ownerRole, err := s.roleRepo.GetByName(ctx, domains.Owner.String())
+	if err != nil {
+		return err
+	}
+	if err = s.boardRepo.Create(ctx, board); err != nil {
+		return err
+	}
+	return s.boardMemberRepo.Create(ctx, &domains.BoardMember{
+		BoardID: board.ID,
+		UserID:  board.CreatedBy,
+		RoleID:  ownerRole.ID,
+	})
 }
 
-func (s *BoardService) GetBoardByID(ctx context.Context, id uint) (*domains.Board, error) {
+// GetBoardByID returns a board, private boards are only visible to their members.
+func (s *BoardService) GetBoardByID(ctx context.Context, userID uint, id uint) (*domains.Board, error) {
+	if err := s.authorizer.Authorize(ctx, userID, id, domains.ActionBoardView); err != nil {
+		return nil, err
+	}
 	return s.boardRepo.GetByID(ctx, id)
 }
 
-func (s *BoardService) UpdateBoard(ctx context.Context, board *domains.Board) error {
-	return s.boardRepo.Update(ctx, board)
+func (s *BoardService) UpdateBoard(ctx context.Context, userID uint, board *domains.Board) error {
+	if err := s.authorizer.Authorize(ctx, userID, board.ID, domains.ActionBoardUpdate); err != nil {
+		return err
+	}
+	existing, err := s.boardRepo.GetByID(ctx, board.ID)
+	if err != nil {
+		return err
+	}
+	if err = s.boardRepo.Update(ctx, board); err != nil {
+		return err
+	}
+	if existing.IsPrivate == board.IsPrivate {
+		return nil
+	}
+	return s.auditService.Record(ctx, domains.AuditLog{
+		Action:  domains.AuditBoardPrivacyChanged,
+		ActorID: auditUser(userID),
+		BoardID: auditUser(board.ID),
+		Details: map[string]string{"is_private": strconv.FormatBool(board.IsPrivate)},
+	})
+}
+
+// DeleteBoard deletes a board with everything on it, only the board owner can delete it.
+func (s *BoardService) DeleteBoard(ctx context.Context, userID uint, id uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, id, domains.ActionBoardDelete); err != nil {
+		return err
+	}
+	board, err := s.boardRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = s.boardRepo.Delete(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.auditService.Record(ctx, domains.AuditLog{
+		Action:  domains.AuditBoardDeleted,
+		ActorID: auditUser(userID),
+		BoardID: auditUser(id),
+		Details: map[string]string{"name": board.Name},
+	})
 }
 
-func (s *BoardService) DeleteBoard(ctx context.Context, id uint) error {
-	return s.boardRepo.Delete(ctx, id)
+func (s *BoardService) ArchiveBoard(ctx context.Context, userID uint, id uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, id, domains.ActionBoardArchive); err != nil {
+		return err
+	}
+	return s.boardRepo.Archive(ctx, id)
+}
+
+func (s *BoardService) UnarchiveBoard(ctx context.Context, userID uint, id uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, id, domains.ActionBoardArchive); err != nil {
+		return err
+	}
+	return s.boardRepo.Unarchive(ctx, id)
 }
 
 func (s *BoardService) GetAllBoards(ctx context.Context) ([]domains.Board, error) {
 	return s.boardRepo.GetAll(ctx)
 }
 
+// GetBoards lists the boards visible to the user, never the boards of
+// workspaces the user is not a member of.
+func (s *BoardService) GetBoards(ctx context.Context, userID uint, filter domains.BoardFilter) ([]domains.Board, error) {
+	if filter.WorkspaceID != nil {
+		_, err := s.workspaceMemberRepo.GetMember(ctx, *filter.WorkspaceID, userID)
+		if err != nil {
+			if isNotFound(err) {
+				return nil, ErrNotWorkspaceMember
+			}
+			return nil, err
+		}
+	}
+	return s.boardRepo.GetVisible(ctx, userID, filter)
+}
+
 func (s *BoardService) CreateBoardMember(ctx context.Context, boardMember *domains.BoardMember) error {
 	return s.boardMemberRepo.Create(ctx, boardMember)
 }
@@ -82,24 +186,19 @@ func (s *BoardService) GetRoleByUserIDAndBoardId(ctx context.Context, userID, bo
 	return role, nil
 }
 
-func (s *BoardService) HasRequiredBoardAccess(ctx context.Context, roleW domains.RoleW, userID, boardID uint) (bool, error) {
-	r, errGet := s.GetRoleByUserIDAndBoardId(ctx, userID, boardID)
-	if errGet != nil {
-		return false, errGet
-	}
-	roleWeight, errParse := domains.ParseRole(r.Name)
-	if errParse != nil {
-		return false, &fiber.Error{Code: fiber.StatusInternalServerError, Message: errParse.Error()}
+// InviteUserToBoard adds a user to the board, members can not grant a role
+// with permissions they do not have themselves.
+func (s *BoardService) InviteUserToBoard(ctx context.Context, actorID, userId, boardId uint, roleName string) error {
+	// check role existence and permissions
+	role, err := s.roleRepo.GetBoardRoleByName(ctx, boardId, roleName)
+	if err != nil {
+		return err
 	}
-	if roleWeight > roleW {
-		return false, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied!"}
+	if err = s.authorizeRole(ctx, actorID, boardId, domains.ActionBoardMemberInvite, role.ID); err != nil {
+		return err
 	}
-	return true, nil
-}
-
-func (s *BoardService) InviteUserToBoard(ctx context.Context, userId, boardId uint, roleName string) error {
 	// check board existence and get
-	_, err := s.boardRepo.GetByID(ctx, boardId)
+	board, err := s.boardRepo.GetByID(ctx, boardId)
 	if err != nil {
 		return err
 	}
@@ -108,16 +207,21 @@ func (s *BoardService) InviteUserToBoard(ctx context.Context, userId, boardId ui
 	if err != nil {
 		return err
 	}
+	// boards of a workspace only take members from its directory
+	if board.WorkspaceID != nil {
+		_, err = s.workspaceMemberRepo.GetMember(ctx, *board.WorkspaceID, userId)
+		if err != nil {
+			if isNotFound(err) {
+				return ErrUserNotInWorkspace
+			}
+			return err
+		}
+	}
 	// check if user is member of board before
 	_, err = s.boardMemberRepo.GetBoardMember(ctx, boardId, userId)
 	if err == nil { // if err is nil, it means that the user is already board member
 		return ErrUserIsAlreadyBoardMember
 	}
-	// check role existence and get
-	role, err := s.roleRepo.GetByName(ctx, roleName)
-	if err != nil {
-		return err
-	}
 	// add row to board access and board member
 	boardMember := &domains.BoardMember{
 		BoardID: boardId,
@@ -129,10 +233,15 @@ func (s *BoardService) InviteUserToBoard(ctx context.Context, userId, boardId ui
 		return err
 	}
 
-	return nil
+	return s.recordMemberChange(ctx, domains.AuditBoardMemberAdded, actorID, boardId, userId, role.Name)
 }
 
-func (s *BoardService) RemoveUserFromBoard(ctx context.Context, userId, boardId uint) error {
+// RemoveUserFromBoard removes a member from the board, members can not remove
+// members with permissions they do not have themselves.
+func (s *BoardService) RemoveUserFromBoard(ctx context.Context, actorID, userId, boardId uint) error {
+	if err := s.authorizer.Authorize(ctx, actorID, boardId, domains.ActionBoardMemberRemove); err != nil {
+		return err
+	}
 	// check board existence and get
 	_, err := s.boardRepo.GetByID(ctx, boardId)
 	if err != nil {
@@ -148,16 +257,27 @@ func (s *BoardService) RemoveUserFromBoard(ctx context.Context, userId, boardId
 	if err != nil {
 		return err
 	}
+	if err = s.authorizeRole(ctx, actorID, boardId, domains.ActionBoardMemberRemove, boardMember.RoleID); err != nil {
+		return err
+	}
+	if err = s.ensureNotLastOwner(ctx, boardMember); err != nil {
+		return err
+	}
 
 	// remove user's board membership
 	if err = s.boardMemberRepo.Delete(ctx, boardMember.ID); err != nil {
 		return err
 	}
 
-	return nil
+	return s.recordMemberChange(ctx, domains.AuditBoardMemberRemoved, actorID, boardId, userId, "")
 }
 
-func (s *BoardService) ChangeUserRole(ctx context.Context, userId, boardId uint, roleName string) error {
+// ChangeUserRole changes the role of a member, members need every permission of
+// both the current and the new role of the member.
+func (s *BoardService) ChangeUserRole(ctx context.Context, actorID, userId, boardId uint, roleName string) error {
+	if err := s.authorizer.Authorize(ctx, actorID, boardId, domains.ActionBoardMemberChangeRole); err != nil {
+		return err
+	}
 	// check board existence and get
 	_, err := s.boardRepo.GetByID(ctx, boardId)
 	if err != nil {
@@ -174,10 +294,18 @@ func (s *BoardService) ChangeUserRole(ctx context.Context, userId, boardId uint,
 		return err
 	}
 	// check role existence and get
-	role, err := s.roleRepo.GetByName(ctx, roleName)
+	role, err := s.roleRepo.GetBoardRoleByName(ctx, boardId, roleName)
 	if err != nil {
 		return err
 	}
+	if err = s.authorizeRole(ctx, actorID, boardId, domains.ActionBoardMemberChangeRole, boardMember.RoleID, role.ID); err != nil {
+		return err
+	}
+	if role.Name != domains.Owner.String() {
+		if err = s.ensureNotLastOwner(ctx, boardMember); err != nil {
+			return err
+		}
+	}
 
 	boardMember.RoleID = role.ID
 	// change user role in board
@@ -185,5 +313,213 @@ func (s *BoardService) ChangeUserRole(ctx context.Context, userId, boardId uint,
 		return err
 	}
 
+	return s.recordMemberChange(ctx, domains.AuditBoardMemberRoleChanged, actorID, boardId, userId, role.Name)
+}
+
+// TransferOwnership makes another board member a direct owner of the board,
+// members of the board through a team only are enrolled. A current owner who
+// holds the Owner role directly stays on the board as a maintainer, an Owner
+// role granted through a team is left to the team.
+func (s *BoardService) TransferOwnership(ctx context.Context, userID, boardID, targetUserID uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionBoardTransferOwnership); err != nil {
+		return err
+	}
+	if targetUserID == userID {
+		return ErrTransferToSelf
+	}
+
+	target, err := s.boardMemberRepo.GetBoardMember(ctx, boardID, targetUserID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	if target == nil {
+		role, roleErr := s.authorizer.MemberRole(ctx, targetUserID, boardID)
+		if roleErr != nil {
+			return roleErr
+		}
+		if role == nil {
+			return err
+		}
+		target = &domains.BoardMember{BoardID: boardID, UserID: targetUserID}
+	}
+	current, err := s.boardMemberRepo.GetBoardMember(ctx, boardID, userID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	ownerRole, err := s.roleRepo.GetByName(ctx, domains.Owner.String())
+	if err != nil {
+		return err
+	}
+	maintainerRole, err := s.roleRepo.GetByName(ctx, domains.Maintainer.String())
+	if err != nil {
+		return err
+	}
+
+	target.RoleID = ownerRole.ID
+	if target.ID == 0 {
+		err = s.boardMemberRepo.Create(ctx, target)
+	} else {
+		err = s.boardMemberRepo.Update(ctx, target)
+	}
+	if err != nil {
+		return err
+	}
+	if err = s.recordMemberChange(ctx, domains.AuditBoardMemberRoleChanged, userID, boardID, targetUserID, ownerRole.Name); err != nil {
+		return err
+	}
+
+	if current == nil || current.RoleID != ownerRole.ID {
+		return nil
+	}
+	current.RoleID = maintainerRole.ID
+	if err = s.boardMemberRepo.Update(ctx, current); err != nil {
+		return err
+	}
+	return s.recordMemberChange(ctx, domains.AuditBoardMemberRoleChanged, userID, boardID, userID, maintainerRole.Name)
+}
+
+// recordMemberChange adds a change of the membership of a user to the audit
+// log, with the role the user holds after the change.
+func (s *BoardService) recordMemberChange(ctx context.Context, action domains.AuditAction, actorID, boardID, userID uint, roleName string) error {
+	entry := domains.AuditLog{
+		Action:       action,
+		ActorID:      auditUser(actorID),
+		TargetUserID: auditUser(userID),
+		BoardID:      auditUser(boardID),
+	}
+	if roleName != "" {
+		entry.Details = map[string]string{"role": roleName}
+	}
+	return s.auditService.Record(ctx, entry)
+}
+
+// authorizeRole checks the action for the user against the roles granted to or
+// taken from another member.
+func (s *BoardService) authorizeRole(ctx context.Context, userID, boardID uint, action domains.BoardAction, roleIDs ...uint) error {
+	roles := make([]*domains.Role, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		role, err := s.roleRepo.GetByID(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		roles = append(roles, role)
+	}
+	return s.authorizer.AuthorizeRole(ctx, userID, boardID, action, roles...)
+}
+
+// ensureNotLastOwner fails when the member is the only direct owner of its board.
+func (s *BoardService) ensureNotLastOwner(ctx context.Context, member *domains.BoardMember) error {
+	ownerRole, err := s.roleRepo.GetByName(ctx, domains.Owner.String())
+	if err != nil {
+		return err
+	}
+	if member.RoleID != ownerRole.ID {
+		return nil
+	}
+
+	members, err := s.boardMemberRepo.GetBoardMembers(ctx, member.BoardID)
+	if err != nil {
+		return err
+	}
+	if hasOtherOwner(members, member.UserID, ownerRole.ID) {
+		return nil
+	}
+	return ErrBoardNeedsOwner
+}
+
+// hasOtherOwner reports whether a member other than the user holds the Owner
+// role directly. Owner roles granted through teams do not keep a board owned,
+// teams lose their members and board roles without any check on the board.
+func hasOtherOwner(members []domains.BoardMember, userID, ownerRoleID uint) bool {
+	for _, m := range members {
+		if m.UserID != userID && m.RoleID == ownerRoleID {
+			return true
+		}
+	}
+	return false
+}
+
+// BackfillOwners enrolls the creators of boards that have no owner as owners.
+func (s *BoardService) BackfillOwners(ctx context.Context) error {
+	ownerRole, err := s.roleRepo.GetByName(ctx, domains.Owner.String())
+	if err != nil {
+		return err
+	}
+	return s.boardMemberRepo.BackfillOwners(ctx, ownerRole.ID)
+}
+
+// HandOverBoards removes the user from all of their boards before the account
+// is deleted. Boards where the user is the only direct owner are handed over
+// to the direct member whose role has the most permissions, the
+// longest-standing member winning ties, and boards nobody else is a direct
+// member of are deleted.
+func (s *BoardService) HandOverBoards(ctx context.Context, userID uint) error {
+	memberships, err := s.boardMemberRepo.GetUserMemberships(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	ownerRole, err := s.roleRepo.GetByName(ctx, domains.Owner.String())
+	if err != nil {
+		return err
+	}
+
+	permissionCounts := make(map[uint]int)
+	permissionCount := func(roleID uint) (int, error) {
+		if n, ok := permissionCounts[roleID]; ok {
+			return n, nil
+		}
+		role, err := s.roleRepo.GetByID(ctx, roleID)
+		if err != nil {
+			return 0, err
+		}
+		permissionCounts[roleID] = len(role.Permissions)
+		return len(role.Permissions), nil
+	}
+
+	for _, membership := range memberships {
+		if membership.RoleID == ownerRole.ID {
+			members, err := s.boardMemberRepo.GetBoardMembers(ctx, membership.BoardID)
+			if err != nil {
+				return err
+			}
+
+			if !hasOtherOwner(members, userID, ownerRole.ID) {
+				// members are ordered by join date, so the first best role wins
+				var successor *domains.BoardMember
+				var successorPermissions int
+				for i := range members {
+					member := &members[i]
+					if member.UserID == userID {
+						continue
+					}
+					n, err := permissionCount(member.RoleID)
+					if err != nil {
+						return err
+					}
+					if successor == nil || n > successorPermissions {
+						successor, successorPermissions = member, n
+					}
+				}
+
+				if successor == nil {
+					if err := s.boardRepo.Delete(ctx, membership.BoardID, userID); err != nil {
+						return err
+					}
+					continue
+				}
+				successor.RoleID = ownerRole.ID
+				if err := s.boardMemberRepo.Update(ctx, successor); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := s.boardMemberRepo.Delete(ctx, membership.ID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,413 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvitationAlreadyPending  = fiber.NewError(fiber.StatusBadRequest, "email already has a pending invitation to the board")
+	ErrInvalidInvitation         = fiber.NewError(fiber.StatusBadRequest, "invalid or expired invitation")
+	ErrInvitationNotPending      = fiber.NewError(fiber.StatusBadRequest, "invitation was already answered")
+	ErrInvitationForOtherEmail   = fiber.NewError(fiber.StatusForbidden, "invitation was sent to another email address")
+	ErrInvitationEmailUnverified = fiber.NewError(fiber.StatusForbidden, "verify your email address to answer invitations")
+)
+
+const (
+	boardInvitationTemplate = "board_invitation.html"
+
+	defaultInvitationExp = 7 * 24 * time.Hour
+)
+
+type InvitationSettings struct {
+	LinkBaseURL string
+	TokenExp    time.Duration
+}
+
+type boardInvitationEmailData struct {
+	InviterName string
+	BoardName   string
+	RoleName    string
+	Link        string
+	ExpiresIn   string
+}
+
+// BoardInvitationService invites people to boards by email. The invitation
+// token is mailed to the address and only its SHA-256 digest is stored.
+// Registered users with a verified address also see their invitations in the
+// app, and new users join the boards they were invited to once their address
+// is verified. People invited to a board of a workspace they are not in join
+// the workspace as guests.
+type BoardInvitationService struct {
+	invitationRepo      ports.BoardInvitationRepo
+	boardRepo           ports.BoardRepo
+	boardMemberRepo     ports.BoardMemberRepo
+	userRepo            ports.UserRepo
+	roleRepo            ports.RoleRepository
+	workspaceMemberRepo ports.WorkspaceMemberRepo
+	authorizer          ports.Authorizer
+	auditService        *AuditService
+	notifier            ports.Notifier
+	settings            InvitationSettings
+}
+
+func NewBoardInvitationService(invitationRepo ports.BoardInvitationRepo, boardRepo ports.BoardRepo, boardMemberRepo ports.BoardMemberRepo,
+	userRepo ports.UserRepo, roleRepo ports.RoleRepository, workspaceMemberRepo ports.WorkspaceMemberRepo, authorizer ports.Authorizer,
+	auditService *AuditService, notifier ports.Notifier, settings InvitationSettings) *BoardInvitationService {
+	if settings.TokenExp <= 0 {
+		settings.TokenExp = defaultInvitationExp
+	}
+	return &BoardInvitationService{
+		invitationRepo:      invitationRepo,
+		boardRepo:           boardRepo,
+		boardMemberRepo:     boardMemberRepo,
+		userRepo:            userRepo,
+		roleRepo:            roleRepo,
+		workspaceMemberRepo: workspaceMemberRepo,
+		authorizer:          authorizer,
+		auditService:        auditService,
+		notifier:            notifier,
+		settings:            settings,
+	}
+}
+
+// InviteByEmail invites the email address to the board with the role and
+// mails it an accept link, members can not invite with a role holding
+// permissions they do not have themselves.
+func (s *BoardInvitationService) InviteByEmail(ctx context.Context, actorID, boardID uint, email string, roleName string) (*domains.BoardInvitation, error) {
+	role, err := s.roleRepo.GetBoardRoleByName(ctx, boardID, roleName)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.authorizer.AuthorizeRole(ctx, actorID, boardID, domains.ActionBoardMemberInvite, role); err != nil {
+		return nil, err
+	}
+	board, err := s.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	inviter, err := s.userRepo.GetByID(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	email = strings.TrimSpace(email)
+	invitee, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	if invitee != nil {
+		_, err = s.boardMemberRepo.GetBoardMember(ctx, boardID, invitee.ID)
+		if err == nil {
+			return nil, ErrUserIsAlreadyBoardMember
+		}
+		if !isNotFound(err) {
+			return nil, err
+		}
+	}
+
+	email = strings.ToLower(email)
+	pending, err := s.invitationRepo.GetPending(ctx, email, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		return nil, ErrInvitationAlreadyPending
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	invitation := &domains.BoardInvitation{
+		BoardID:   boardID,
+		Email:     email,
+		RoleID:    role.ID,
+		InvitedBy: actorID,
+		Status:    domains.InvitationPending,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(s.settings.TokenExp),
+	}
+	if err = s.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, err
+	}
+	invitation.Board = board
+	invitation.Role = role
+
+	entry := domains.AuditLog{
+		Action:  domains.AuditBoardInvitationSent,
+		ActorID: auditUser(actorID),
+		BoardID: auditUser(boardID),
+		Details: map[string]string{"email": email, "role": role.Name},
+	}
+	if invitee != nil {
+		entry.TargetUserID = auditUser(invitee.ID)
+	}
+	if err = s.auditService.Record(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	data := boardInvitationEmailData{
+		InviterName: inviter.Name,
+		BoardName:   board.Name,
+		RoleName:    role.Name,
+		Link:        strings.TrimRight(s.settings.LinkBaseURL, "/") + "/invitations/accept?token=" + url.QueryEscape(token),
+		ExpiresIn:   formatDuration(s.settings.TokenExp),
+	}
+	subject := fmt.Sprintf("%s invited you to %s", inviter.Name, board.Name)
+	if err = s.notifier.SendTemplatedEmail(email, subject, boardInvitationTemplate, data); err != nil {
+		log.ErrorLog.Printf("Error sending invitation %d: %v\n", invitation.ID, err)
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: "Failed to send email"}
+	}
+
+	if invitee != nil && invitee.EmailVerifiedAt != nil {
+		err = s.notifier.SendInAppNotification(ctx, invitee.ID, ports.NotificationInput{
+			Type:    ports.BoardInvitationNotification,
+			Message: fmt.Sprintf("%s invited you to the board %s as %s", inviter.Name, board.Name, role.Name),
+		})
+		if err != nil {
+			// the invitation was mailed already
+			log.ErrorLog.Printf("Error notifying user %d of invitation %d: %v\n", invitee.ID, invitation.ID, err)
+		}
+	}
+
+	return invitation, nil
+}
+
+// GetBoardInvitations lists the invitations of a board to members who can invite.
+func (s *BoardInvitationService) GetBoardInvitations(ctx context.Context, userID, boardID uint) ([]domains.BoardInvitation, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionBoardMemberInvite); err != nil {
+		return nil, err
+	}
+	if err := s.invitationRepo.ExpireOverdue(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.invitationRepo.GetByBoardID(ctx, boardID)
+}
+
+// GetUserInvitations lists the pending invitations sent to the verified email of the user.
+func (s *BoardInvitationService) GetUserInvitations(ctx context.Context, userID uint) ([]domains.BoardInvitation, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.EmailVerifiedAt == nil {
+		return []domains.BoardInvitation{}, nil
+	}
+	return s.invitationRepo.GetPending(ctx, strings.ToLower(user.Email), 0)
+}
+
+// AcceptByToken adds the user to the board of the invitation from the emailed link.
+func (s *BoardInvitationService) AcceptByToken(ctx context.Context, userID uint, token string) (*domains.BoardInvitation, error) {
+	invitation, err := s.getByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, ErrInvitationForOtherEmail
+	}
+	return invitation, s.accept(ctx, user, invitation)
+}
+
+// DeclineByToken declines the invitation from the emailed link, which also
+// works for people without an account.
+func (s *BoardInvitationService) DeclineByToken(ctx context.Context, token string) error {
+	invitation, err := s.getByToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	return s.respond(ctx, invitation, domains.InvitationDeclined)
+}
+
+// AcceptInvitation accepts an invitation sent to the verified email of the user.
+func (s *BoardInvitationService) AcceptInvitation(ctx context.Context, userID, id uint) (*domains.BoardInvitation, error) {
+	user, invitation, err := s.getUserInvitation(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return invitation, s.accept(ctx, user, invitation)
+}
+
+// DeclineInvitation declines an invitation sent to the verified email of the user.
+func (s *BoardInvitationService) DeclineInvitation(ctx context.Context, userID, id uint) error {
+	_, invitation, err := s.getUserInvitation(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	return s.respond(ctx, invitation, domains.InvitationDeclined)
+}
+
+// JoinInvitedBoards accepts every pending invitation of a user whose email is
+// verified, so people invited before they signed up join the boards on signup.
+// An invitation that can not be accepted is logged and skipped, its changes
+// are rolled back to a save point when the request runs in a transaction.
+func (s *BoardInvitationService) JoinInvitedBoards(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerifiedAt == nil {
+		return nil
+	}
+
+	invitations, err := s.invitationRepo.GetPending(ctx, strings.ToLower(user.Email), 0)
+	if err != nil {
+		return err
+	}
+	savePointer, _ := valuecontext.TryGetSavePointerFromContext(ctx)
+	for i := range invitations {
+		savePoint := fmt.Sprintf("invitation_%d", i)
+		if savePointer != nil {
+			if err = savePointer.SavePoint(savePoint); err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+		}
+
+		if err = s.accept(ctx, user, &invitations[i]); err == nil {
+			continue
+		}
+		log.ErrorLog.Printf("Error accepting invitation %d for user %d: %v\n", invitations[i].ID, user.ID, err)
+
+		if savePointer != nil {
+			if err = savePointer.RollbackTo(savePoint); err != nil {
+				return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func (s *BoardInvitationService) getByToken(ctx context.Context, token string) (*domains.BoardInvitation, error) {
+	if token == "" {
+		return nil, ErrInvalidInvitation
+	}
+	invitation, err := s.invitationRepo.GetByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrInvalidInvitation
+		}
+		return nil, err
+	}
+	return invitation, nil
+}
+
+func (s *BoardInvitationService) getUserInvitation(ctx context.Context, userID, id uint) (*domains.User, *domains.BoardInvitation, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	invitation, err := s.invitationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, nil, ErrInvitationForOtherEmail
+	}
+	if user.EmailVerifiedAt == nil {
+		return nil, nil, ErrInvitationEmailUnverified
+	}
+	return user, invitation, nil
+}
+
+func (s *BoardInvitationService) accept(ctx context.Context, user *domains.User, invitation *domains.BoardInvitation) error {
+	board, err := s.boardRepo.GetByID(ctx, invitation.BoardID)
+	if err != nil {
+		return err
+	}
+	if err = s.respond(ctx, invitation, domains.InvitationAccepted); err != nil {
+		return err
+	}
+	if err = s.joinWorkspace(ctx, user.ID, board); err != nil {
+		return err
+	}
+
+	_, err = s.boardMemberRepo.GetBoardMember(ctx, invitation.BoardID, user.ID)
+	if err == nil {
+		return nil
+	}
+	if !isNotFound(err) {
+		return err
+	}
+	err = s.boardMemberRepo.Create(ctx, &domains.BoardMember{
+		BoardID: invitation.BoardID,
+		UserID:  user.ID,
+		RoleID:  invitation.RoleID,
+	})
+	if err != nil {
+		return err
+	}
+
+	role, err := s.roleRepo.GetByID(ctx, invitation.RoleID)
+	if err != nil {
+		return err
+	}
+	return s.auditService.Record(ctx, domains.AuditLog{
+		Action:       domains.AuditBoardInvitationAccepted,
+		ActorID:      auditUser(user.ID),
+		TargetUserID: auditUser(user.ID),
+		BoardID:      auditUser(invitation.BoardID),
+		Details: map[string]string{
+			"role":       role.Name,
+			"invited_by": strconv.FormatUint(uint64(invitation.InvitedBy), 10),
+		},
+	})
+}
+
+// joinWorkspace makes the user a guest of the workspace of the board unless
+// the user is a member already.
+func (s *BoardInvitationService) joinWorkspace(ctx context.Context, userID uint, board *domains.Board) error {
+	if board.WorkspaceID == nil {
+		return nil
+	}
+	_, err := s.workspaceMemberRepo.GetMember(ctx, *board.WorkspaceID, userID)
+	if err == nil {
+		return nil
+	}
+	if !isNotFound(err) {
+		return err
+	}
+	return s.workspaceMemberRepo.Create(ctx, &domains.WorkspaceMember{
+		WorkspaceID: *board.WorkspaceID,
+		UserID:      userID,
+		Role:        domains.WorkspaceRoleGuest,
+	})
+}
+
+// respond answers a pending invitation, overdue invitations are expired instead.
+func (s *BoardInvitationService) respond(ctx context.Context, invitation *domains.BoardInvitation, status domains.InvitationStatus) error {
+	if invitation.Status != domains.InvitationPending {
+		return ErrInvitationNotPending
+	}
+
+	now := time.Now()
+	if !now.Before(invitation.ExpiresAt) {
+		if err := s.invitationRepo.ExpireOverdue(ctx, now); err != nil {
+			return err
+		}
+		return ErrInvalidInvitation
+	}
+
+	if err := s.invitationRepo.Respond(ctx, invitation.ID, status, now); err != nil {
+		if isNotFound(err) {
+			return ErrInvitationNotPending
+		}
+		return err
+	}
+	invitation.Status = status
+	invitation.RespondedAt = &now
+	return nil
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
+)
+
+const (
+	inviteeID    uint = 7
+	inviteeEmail      = "invitee@example.com"
+
+	missingBoardID uint = 99
+)
+
+type invitedUserRepo struct {
+	fakeUserRepo
+}
+
+func (invitedUserRepo) GetByID(_ context.Context, id uint) (*domains.User, error) {
+	verifiedAt := time.Now()
+	return &domains.User{ID: id, Email: inviteeEmail, EmailVerifiedAt: &verifiedAt}, nil
+}
+
+// pendingInvitationRepo has one pending invitation per board and records the answers.
+type pendingInvitationRepo struct {
+	ports.BoardInvitationRepo
+	boardIDs  []uint
+	responded *[]uint
+}
+
+func (r pendingInvitationRepo) GetPending(context.Context, string, uint) ([]domains.BoardInvitation, error) {
+	invitations := make([]domains.BoardInvitation, 0, len(r.boardIDs))
+	for i, boardID := range r.boardIDs {
+		invitations = append(invitations, domains.BoardInvitation{
+			ID:        uint(i + 1),
+			BoardID:   boardID,
+			Email:     inviteeEmail,
+			RoleID:    roleID(domains.Editor),
+			InvitedBy: ownerID,
+			Status:    domains.InvitationPending,
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+	}
+	return invitations, nil
+}
+
+func (r pendingInvitationRepo) Respond(_ context.Context, id uint, _ domains.InvitationStatus, _ time.Time) error {
+	*r.responded = append(*r.responded, id)
+	return nil
+}
+
+func TestJoinInvitedBoardsSkipsFailingInvitations(t *testing.T) {
+	var responded []uint
+	var entries []domains.AuditLog
+	members := newMemoryBoardMemberRepo(nil)
+	invitations := pendingInvitationRepo{boardIDs: []uint{privateBoardID, missingBoardID, publicBoardID}, responded: &responded}
+	service := NewBoardInvitationService(invitations, fakeBoardRepo{}, members, invitedUserRepo{}, fakeRoleRepo{}, nil, nil,
+		NewAuditService(recordingAuditLogRepo{entries: &entries}), nil, InvitationSettings{})
+
+	committer := &savePointCommitter{}
+	ctx := valuecontext.NewValueContext(context.Background(), &valuecontext.ContextValue{Tx: committer})
+	if err := service.JoinInvitedBoards(ctx, inviteeID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(responded, []uint{1, 3}) {
+		t.Errorf("expected the invitations to boards that exist to be accepted, got %v", responded)
+	}
+	for _, boardID := range []uint{privateBoardID, publicBoardID} {
+		member, err := members.GetBoardMember(context.Background(), boardID, inviteeID)
+		if err != nil || member.RoleID != roleID(domains.Editor) {
+			t.Errorf("expected the invitee to join board %d as editor, got %+v, %v", boardID, member, err)
+		}
+	}
+	if len(entries) != 2 {
+		t.Errorf("expected two accepted invitations in the audit log, got %+v", entries)
+	}
+
+	calls := []string{
+		"savepoint invitation_0",
+		"savepoint invitation_1",
+		"rollback invitation_1",
+		"savepoint invitation_2",
+	}
+	if !reflect.DeepEqual(committer.calls, calls) {
+		t.Errorf("expected save point calls %v, got %v", calls, committer.calls)
+	}
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrInvalidShareLink      = fiber.NewError(fiber.StatusNotFound, "share link is invalid or expired")
+	ErrShareLinkExpiryInPast = fiber.NewError(fiber.StatusBadRequest, "expiry must be in the future")
+)
+
+const shareLinkDisplayLength = 6
+
+// BoardShareService manages share links that give people without an account
+// read-only access to the columns and tasks of a board. Only the SHA-256
+// digest of a link token is stored, the token is shown once on creation.
+type BoardShareService struct {
+	linkRepo   ports.BoardShareLinkRepo
+	boardRepo  ports.BoardRepo
+	columnRepo ports.ColumnRepo
+	taskRepo   ports.TaskRepo
+	authorizer ports.Authorizer
+}
+
+func NewBoardShareService(linkRepo ports.BoardShareLinkRepo, boardRepo ports.BoardRepo, columnRepo ports.ColumnRepo, taskRepo ports.TaskRepo,
+	authorizer ports.Authorizer) *BoardShareService {
+	return &BoardShareService{
+		linkRepo:   linkRepo,
+		boardRepo:  boardRepo,
+		columnRepo: columnRepo,
+		taskRepo:   taskRepo,
+		authorizer: authorizer,
+	}
+}
+
+// CreateLink creates a share link for the board and returns its token, links
+// without an expiry stay valid until they are revoked.
+func (s *BoardShareService) CreateLink(ctx context.Context, userID, boardID uint, expiresAt *time.Time) (*domains.BoardShareLink, string, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionBoardShare); err != nil {
+		return nil, "", err
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", ErrShareLinkExpiryInPast
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	link := &domains.BoardShareLink{
+		BoardID:   boardID,
+		CreatedBy: userID,
+		Prefix:    token[:shareLinkDisplayLength],
+		TokenHash: hashToken(token),
+		ExpiresAt: expiresAt,
+	}
+	if err = s.linkRepo.Create(ctx, link); err != nil {
+		return nil, "", err
+	}
+	return link, token, nil
+}
+
+func (s *BoardShareService) GetLinks(ctx context.Context, userID, boardID uint) ([]domains.BoardShareLink, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionBoardShare); err != nil {
+		return nil, err
+	}
+	return s.linkRepo.GetByBoardID(ctx, boardID)
+}
+
+func (s *BoardShareService) RevokeLink(ctx context.Context, userID, boardID, id uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionBoardShare); err != nil {
+		return err
+	}
+	return s.linkRepo.Revoke(ctx, boardID, id)
+}
+
+// ResolveLink returns the board a share link token gives access to.
+func (s *BoardShareService) ResolveLink(ctx context.Context, token string) (uint, error) {
+	if token == "" {
+		return 0, ErrInvalidShareLink
+	}
+	link, err := s.linkRepo.GetByHash(ctx, hashToken(token))
+	if err != nil {
+		if isNotFound(err) {
+			return 0, ErrInvalidShareLink
+		}
+		return 0, err
+	}
+	if !link.IsActive(time.Now()) {
+		return 0, ErrInvalidShareLink
+	}
+	return link.BoardID, nil
+}
+
+// GetSharedBoard returns the board of a resolved share link with its columns.
+func (s *BoardShareService) GetSharedBoard(ctx context.Context, boardID uint) (*domains.Board, []*domains.Column, error) {
+	board, err := s.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil, ErrInvalidShareLink
+		}
+		return nil, nil, err
+	}
+	columns, err := s.columnRepo.GetAll(ctx, boardID, 0, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return board, columns.Data, nil
+}
+
+// GetSharedTasks returns a page of the tasks of the board of a resolved share link.
+func (s *BoardShareService) GetSharedTasks(ctx context.Context, boardID uint, pageNumber uint, pageSize uint) ([]domains.Task, uint, error) {
+	if _, err := s.boardRepo.GetByID(ctx, boardID); err != nil {
+		if isNotFound(err) {
+			return nil, 0, ErrInvalidShareLink
+		}
+		return nil, 0, err
+	}
+	limit := pageSize
+	offset := (pageNumber - 1) * pageSize
+	return s.taskRepo.GetListByBoardID(ctx, boardID, domains.TaskFilter{}, limit, offset)
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/gofiber/fiber/v2"
+)
+
+// teamOwnerID holds the Owner role on every board through a team only.
+const teamOwnerID uint = 6
+
+type teamOwnerBoardTeamRepo struct {
+	fakeBoardTeamRepo
+}
+
+func (teamOwnerBoardTeamRepo) GetUserRoleIDs(_ context.Context, _ uint, userID uint) ([]uint, error) {
+	if userID == teamOwnerID {
+		return []uint{roleID(domains.Owner)}, nil
+	}
+	return nil, nil
+}
+
+// memoryBoardMemberRepo keeps direct board members in join order, the members
+// it starts with are on the private board.
+type memoryBoardMemberRepo struct {
+	fakeBoardMemberRepo
+	members *[]domains.BoardMember
+}
+
+func newMemoryBoardMemberRepo(roles map[uint]domains.RoleW, order ...uint) memoryBoardMemberRepo {
+	members := make([]domains.BoardMember, 0, len(order))
+	for _, userID := range order {
+		members = append(members, domains.BoardMember{
+			ID:      uint(len(members) + 1),
+			BoardID: privateBoardID,
+			UserID:  userID,
+			RoleID:  roleID(roles[userID]),
+		})
+	}
+	return memoryBoardMemberRepo{members: &members}
+}
+
+func (r memoryBoardMemberRepo) Create(_ context.Context, member *domains.BoardMember) error {
+	member.ID = uint(len(*r.members) + 1)
+	*r.members = append(*r.members, *member)
+	return nil
+}
+
+func (r memoryBoardMemberRepo) Update(_ context.Context, member *domains.BoardMember) error {
+	for i := range *r.members {
+		if (*r.members)[i].ID == member.ID {
+			(*r.members)[i] = *member
+			return nil
+		}
+	}
+	return fiber.NewError(fiber.StatusNotFound, "Board member not found")
+}
+
+func (r memoryBoardMemberRepo) Delete(_ context.Context, id uint) error {
+	for i := range *r.members {
+		if (*r.members)[i].ID == id {
+			*r.members = append((*r.members)[:i], (*r.members)[i+1:]...)
+			return nil
+		}
+	}
+	return fiber.NewError(fiber.StatusNotFound, "Board member not found")
+}
+
+func (r memoryBoardMemberRepo) GetBoardMember(_ context.Context, boardID, userID uint) (*domains.BoardMember, error) {
+	for _, member := range *r.members {
+		if member.BoardID == boardID && member.UserID == userID {
+			return &member, nil
+		}
+	}
+	return nil, fiber.NewError(fiber.StatusNotFound, "Board member not found")
+}
+
+func (r memoryBoardMemberRepo) GetBoardMembers(_ context.Context, boardID uint) ([]domains.BoardMember, error) {
+	var members []domains.BoardMember
+	for _, member := range *r.members {
+		if member.BoardID == boardID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (r memoryBoardMemberRepo) GetUserMemberships(_ context.Context, userID uint) ([]domains.BoardMember, error) {
+	var members []domains.BoardMember
+	for _, member := range *r.members {
+		if member.UserID == userID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+// roles returns the direct role of every member of the private board.
+func (r memoryBoardMemberRepo) roles() map[uint]domains.RoleW {
+	roles := make(map[uint]domains.RoleW)
+	for _, member := range *r.members {
+		roles[member.UserID] = domains.RoleW(member.RoleID - 1)
+	}
+	return roles
+}
+
+func newOwnershipTestService(members memoryBoardMemberRepo) *BoardService {
+	var entries []domains.AuditLog
+	authorizer := NewBoardAuthorizer(fakeBoardRepo{}, members, fakeRoleRepo{}, nil, teamOwnerBoardTeamRepo{})
+	return NewBoardService(auditBoardRepo{}, members, fakeUserRepo{}, fakeRoleRepo{}, nil, authorizer,
+		NewAuditService(recordingAuditLogRepo{entries: &entries}))
+}
+
+func expectRoles(t *testing.T, members memoryBoardMemberRepo, want map[uint]domains.RoleW) {
+	t.Helper()
+	got := members.roles()
+	if len(got) != len(want) {
+		t.Fatalf("expected direct roles %v, got %v", want, got)
+	}
+	for userID, role := range want {
+		if r, ok := got[userID]; !ok || r != role {
+			t.Errorf("expected user %d to be a direct %s, got %v", userID, role, got)
+		}
+	}
+}
+
+func TestTransferOwnershipFromTeamOwner(t *testing.T) {
+	members := newMemoryBoardMemberRepo(map[uint]domains.RoleW{ownerID: domains.Owner, editorID: domains.Editor}, ownerID, editorID)
+	service := newOwnershipTestService(members)
+
+	if err := service.TransferOwnership(context.Background(), teamOwnerID, privateBoardID, editorID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectRoles(t, members, map[uint]domains.RoleW{ownerID: domains.Owner, editorID: domains.Owner})
+}
+
+func TestTransferOwnershipToTeamMember(t *testing.T) {
+	members := newMemoryBoardMemberRepo(map[uint]domains.RoleW{ownerID: domains.Owner}, ownerID)
+	service := newOwnershipTestService(members)
+
+	if err := service.TransferOwnership(context.Background(), ownerID, privateBoardID, teamOwnerID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectRoles(t, members, map[uint]domains.RoleW{ownerID: domains.Maintainer, teamOwnerID: domains.Owner})
+
+	if err := service.TransferOwnership(context.Background(), teamOwnerID, privateBoardID, outsiderID); !isNotFound(err) {
+		t.Errorf("expected a transfer to a non-member to fail with not found, got %v", err)
+	}
+}
+
+func TestTeamOwnersDoNotKeepABoardOwned(t *testing.T) {
+	members := newMemoryBoardMemberRepo(map[uint]domains.RoleW{ownerID: domains.Owner, editorID: domains.Editor}, ownerID, editorID)
+	service := newOwnershipTestService(members)
+	ctx := context.Background()
+
+	if err := service.RemoveUserFromBoard(ctx, teamOwnerID, ownerID, privateBoardID); !errors.Is(err, ErrBoardNeedsOwner) {
+		t.Errorf("remove: expected %v, got %v", ErrBoardNeedsOwner, err)
+	}
+	if err := service.ChangeUserRole(ctx, teamOwnerID, ownerID, privateBoardID, domains.Viewer.String()); !errors.Is(err, ErrBoardNeedsOwner) {
+		t.Errorf("change role: expected %v, got %v", ErrBoardNeedsOwner, err)
+	}
+
+	if err := service.HandOverBoards(ctx, ownerID); err != nil {
+		t.Fatalf("hand over: %v", err)
+	}
+	expectRoles(t, members, map[uint]domains.RoleW{editorID: domains.Owner})
+}
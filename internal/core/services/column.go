@@ -9,22 +9,26 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+var (
+	ErrColumnNotFoundInBoard = fiber.NewError(fiber.StatusNotFound, "column not found in board")
+	ErrMoveToDeletedColumn   = fiber.NewError(fiber.StatusBadRequest, "tasks cannot be moved to the column being deleted")
+)
+
 type ColumnService struct {
-	repo         ports.ColumnRepo
-	boardService *BoardService
+	repo       ports.ColumnRepo
+	authorizer ports.Authorizer
 }
 
-func NewColumnService(repo ports.ColumnRepo, boardService *BoardService) *ColumnService {
+func NewColumnService(repo ports.ColumnRepo, authorizer ports.Authorizer) *ColumnService {
 	return &ColumnService{
-		repo:         repo,
-		boardService: boardService,
+		repo:       repo,
+		authorizer: authorizer,
 	}
 }
 
 func (s *ColumnService) CreateColumn(ctx context.Context, column *domains.Column) error {
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Maintainer, column.CreatedBy, column.BoardID)
-	if !hasAccess {
-		return &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, column.CreatedBy, column.BoardID, domains.ActionColumnCreate); err != nil {
+		return err
 	}
 	return s.repo.Create(ctx, column)
 }
@@ -36,53 +40,89 @@ func (s *ColumnService) GetColumnById(ctx context.Context, userID uint, id uint)
 		return nil, errColumn
 	}
 
-	if column.Board.IsPrivate {
-		//check permissions -> only board members can see task
-		hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Viewer, userID, column.BoardID)
-		if !hasAccess {
-			return nil, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
-		}
+	if err := s.authorizer.Authorize(ctx, userID, column.BoardID, domains.ActionColumnView); err != nil {
+		return nil, err
 	}
 
 	return column, nil
 }
 
 func (s *ColumnService) GetAllColumns(ctx context.Context, userID uint, boardID uint, limit int, offset int) (response.PaginateResponseFromService[[]*domains.Column], error) {
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Viewer, userID, boardID)
-	if !hasAccess {
-		return response.PaginateResponseFromService[[]*domains.Column]{}, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionColumnView); err != nil {
+		return response.PaginateResponseFromService[[]*domains.Column]{}, err
 	}
 	return s.repo.GetAll(ctx, boardID, limit, offset)
 }
 
 func (s *ColumnService) Update(ctx context.Context, boardID uint, userID uint, updateColumn *domains.ColumnUpdate) error {
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Editor, userID, boardID)
-	if !hasAccess {
-		return &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionColumnUpdate); err != nil {
+		return err
 	}
 	return s.repo.Update(ctx, updateColumn)
 }
 
 func (s *ColumnService) Move(ctx context.Context, boardID uint, userID uint, moveColumn *domains.ColumnMove) error {
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Editor, userID, boardID)
-	if !hasAccess {
-		return &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionColumnUpdate); err != nil {
+		return err
 	}
 	return s.repo.Move(ctx, moveColumn)
 }
 
 func (s *ColumnService) Final(ctx context.Context, boardID uint, userID uint, id uint) error {
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Editor, userID, boardID)
-	if !hasAccess {
-		return &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionColumnUpdate); err != nil {
+		return err
 	}
 	return s.repo.Final(ctx, id)
 }
 
-func (s *ColumnService) Delete(ctx context.Context, boardID uint, userID uint, id uint) error {
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Editor, userID, boardID)
-	if !hasAccess {
-		return &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+// Delete removes a column of the board, moving its tasks to moveTo first.
+func (s *ColumnService) Delete(ctx context.Context, boardID uint, userID uint, id uint, moveTo *uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionColumnDelete); err != nil {
+		return err
+	}
+	if err := s.checkBoardColumn(ctx, boardID, id); err != nil {
+		return err
+	}
+
+	if moveTo != nil {
+		if *moveTo == id {
+			return ErrMoveToDeletedColumn
+		}
+		if err := s.checkBoardColumn(ctx, boardID, *moveTo); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.Delete(ctx, id, userID, moveTo)
+}
+
+func (s *ColumnService) ArchiveColumn(ctx context.Context, boardID uint, userID uint, id uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionColumnArchive); err != nil {
+		return err
+	}
+	if err := s.checkBoardColumn(ctx, boardID, id); err != nil {
+		return err
+	}
+	return s.repo.Archive(ctx, id)
+}
+
+func (s *ColumnService) UnarchiveColumn(ctx context.Context, boardID uint, userID uint, id uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionColumnArchive); err != nil {
+		return err
+	}
+	if err := s.checkBoardColumn(ctx, boardID, id); err != nil {
+		return err
+	}
+	return s.repo.Unarchive(ctx, id)
+}
+
+func (s *ColumnService) checkBoardColumn(ctx context.Context, boardID uint, id uint) error {
+	column, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if column.BoardID != boardID {
+		return ErrColumnNotFoundInBoard
 	}
-	return s.repo.Delete(ctx, id)
+	return nil
 }
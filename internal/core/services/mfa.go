@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/totp"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrMFAAlreadyEnabled = fiber.NewError(fiber.StatusBadRequest, "two-factor authentication is already enabled")
+	ErrMFANotEnabled     = fiber.NewError(fiber.StatusBadRequest, "two-factor authentication is not enabled")
+	ErrMFANotEnrolled    = fiber.NewError(fiber.StatusBadRequest, "no two-factor enrollment in progress, please start again")
+	ErrInvalidMFACode    = fiber.NewError(fiber.StatusUnauthorized, "invalid two-factor authentication code")
+)
+
+const (
+	mfaEnrollmentKeyPrefix = "auth:mfa_enrollment:"
+	mfaUsedCodeKeyPrefix   = "auth:mfa_used:"
+
+	mfaEnrollmentExp   = 15 * time.Minute
+	mfaSkew            = 1
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 8
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type MFAEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// MFAService manages TOTP two-factor authentication. A secret is kept in the
+// cache until the user proves their authenticator app works with it, and
+// recovery codes are stored as bcrypt hashes like the passwords.
+type MFAService struct {
+	userRepo         ports.UserRepo
+	recoveryCodeRepo ports.RecoveryCodeRepo
+	cache            ports.CacheRepository
+	hasher           *password.Hasher
+	issuer           string
+}
+
+func NewMFAService(userRepo ports.UserRepo, recoveryCodeRepo ports.RecoveryCodeRepo, cache ports.CacheRepository, hasher *password.Hasher, issuer string) *MFAService {
+	if issuer == "" {
+		issuer = "Task-Management"
+	}
+	return &MFAService{
+		userRepo:         userRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		cache:            cache,
+		hasher:           hasher,
+		issuer:           issuer,
+	}
+}
+
+// Enroll starts the TOTP enrollment, the returned URI is meant to be shown as a QR code.
+func (s *MFAService) Enroll(ctx context.Context, userID uint) (*MFAEnrollment, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.MFAEnabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	if err := s.cache.Set(ctx, mfaKey(mfaEnrollmentKeyPrefix, userID), secret, mfaEnrollmentExp); err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	return &MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables MFA once the user enters a valid code for the
+// pending secret and returns the recovery codes, which are only shown once.
+func (s *MFAService) ConfirmEnrollment(ctx context.Context, userID uint, code string) ([]string, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.MFAEnabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := s.cache.Get(ctx, mfaKey(mfaEnrollmentKeyPrefix, userID))
+	if err != nil {
+		if errors.Is(err, ports.ErrCacheMiss) {
+			return nil, ErrMFANotEnrolled
+		}
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	user.MFASecret = secret
+	if err := s.verifyTOTP(ctx, user, code); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.replaceRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.userRepo.UpdateMFA(ctx, userID, secret, &now); err != nil {
+		return nil, err
+	}
+	if err := s.cache.Delete(ctx, mfaKey(mfaEnrollmentKeyPrefix, userID)); err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes of the user after checking a TOTP code.
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error) {
+	user, err := s.enabledUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyTOTP(ctx, user, code); err != nil {
+		return nil, err
+	}
+	return s.replaceRecoveryCodes(ctx, userID)
+}
+
+// Disable turns MFA off, the user confirms with their password and a TOTP or recovery code.
+func (s *MFAService) Disable(ctx context.Context, userID uint, pass string, code string) error {
+	user, err := s.enabledUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.PasswordIsValid(pass) {
+		return ErrWrongPassword
+	}
+	if err := s.Verify(ctx, user, code); err != nil {
+		return err
+	}
+	return s.ResetMFA(ctx, userID)
+}
+
+// ResetMFA turns MFA off without any check, for admins helping a user who lost their device.
+func (s *MFAService) ResetMFA(ctx context.Context, userID uint) error {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdateMFA(ctx, userID, "", nil); err != nil {
+		return err
+	}
+	return s.recoveryCodeRepo.DeleteByUserID(ctx, userID)
+}
+
+// RemainingRecoveryCodes returns how many unused recovery codes the user has left.
+func (s *MFAService) RemainingRecoveryCodes(ctx context.Context, userID uint) (int64, error) {
+	return s.recoveryCodeRepo.CountUnused(ctx, userID)
+}
+
+// Verify accepts a TOTP code or one of the unused recovery codes of the user.
+func (s *MFAService) Verify(ctx context.Context, user *domains.User, code string) error {
+	if !user.MFAEnabled() {
+		return ErrMFANotEnabled
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		return s.verifyTOTP(ctx, user, code)
+	}
+
+	return s.useRecoveryCode(ctx, user.ID, normalizeRecoveryCode(code))
+}
+
+// useRecoveryCode finds the unused recovery code matching code and uses it up.
+func (s *MFAService) useRecoveryCode(ctx context.Context, userID uint, code string) error {
+	if len(code) != recoveryCodeLength {
+		return ErrInvalidMFACode
+	}
+
+	codes, err := s.recoveryCodeRepo.GetUnused(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, recoveryCode := range codes {
+		if !password.Compare(recoveryCode.CodeHash, code) {
+			continue
+		}
+
+		used, err := s.recoveryCodeRepo.MarkUsed(ctx, recoveryCode.ID)
+		if err != nil {
+			return err
+		}
+		if !used {
+			// a concurrent attempt used it first
+			return ErrInvalidMFACode
+		}
+		return nil
+	}
+	return ErrInvalidMFACode
+}
+
+// verifyTOTP checks the code against the secret of the user, every code is
+// accepted once so an intercepted code cannot be replayed.
+func (s *MFAService) verifyTOTP(ctx context.Context, user *domains.User, code string) error {
+	step, ok := totp.Validate(user.MFASecret, code, time.Now(), mfaSkew)
+	if !ok {
+		return ErrInvalidMFACode
+	}
+
+	key := fmt.Sprintf("%s%d:%d", mfaUsedCodeKeyPrefix, user.ID, step)
+	fresh, err := s.cache.SetNX(ctx, key, "1", totp.Period*(2*mfaSkew+1))
+	if err != nil {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	if !fresh {
+		// the code was used already, possibly by a concurrent attempt
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+func (s *MFAService) enabledUser(ctx context.Context, userID uint) (*domains.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.MFAEnabled() {
+		return nil, ErrMFANotEnabled
+	}
+	return user, nil
+}
+
+func (s *MFAService) replaceRecoveryCodes(ctx context.Context, userID uint) ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		buf := make([]byte, 5)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+		}
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(buf))
+		hash, err := s.hasher.Hash(code)
+		if err != nil {
+			return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+		}
+
+		codes = append(codes, code[:4]+"-"+code[4:])
+		hashes = append(hashes, hash)
+	}
+
+	if err := s.recoveryCodeRepo.Replace(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
+
+func mfaKey(prefix string, userID uint) string {
+	return prefix + strconv.FormatUint(uint64(userID), 10)
+}
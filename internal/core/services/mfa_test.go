@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/totp"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// memoryRecoveryCodeRepo keeps the recovery codes of every user in memory.
+type memoryRecoveryCodeRepo struct {
+	codes *[]domains.RecoveryCode
+}
+
+func (r memoryRecoveryCodeRepo) Replace(_ context.Context, userID uint, codeHashes []string) error {
+	*r.codes = (*r.codes)[:0]
+	for i, hash := range codeHashes {
+		*r.codes = append(*r.codes, domains.RecoveryCode{ID: uint(i + 1), UserID: userID, CodeHash: hash})
+	}
+	return nil
+}
+
+func (r memoryRecoveryCodeRepo) GetUnused(_ context.Context, userID uint) ([]domains.RecoveryCode, error) {
+	var unused []domains.RecoveryCode
+	for _, code := range *r.codes {
+		if code.UserID == userID && code.UsedAt == nil {
+			unused = append(unused, code)
+		}
+	}
+	return unused, nil
+}
+
+func (r memoryRecoveryCodeRepo) MarkUsed(_ context.Context, id uint) (bool, error) {
+	for i := range *r.codes {
+		code := &(*r.codes)[i]
+		if code.ID == id && code.UsedAt == nil {
+			now := time.Now()
+			code.UsedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r memoryRecoveryCodeRepo) CountUnused(ctx context.Context, userID uint) (int64, error) {
+	unused, err := r.GetUnused(ctx, userID)
+	return int64(len(unused)), err
+}
+
+func (r memoryRecoveryCodeRepo) DeleteByUserID(context.Context, uint) error {
+	*r.codes = (*r.codes)[:0]
+	return nil
+}
+
+var _ ports.RecoveryCodeRepo = memoryRecoveryCodeRepo{}
+
+func newTestMFAService(user *domains.User, cache ports.CacheRepository, codes *[]domains.RecoveryCode) *MFAService {
+	return NewMFAService(authUserRepo{user: user}, memoryRecoveryCodeRepo{codes: codes}, cache, password.NewHasher(bcrypt.MinCost), "")
+}
+
+// mfaUser returns a user with MFA enabled, away from the end of a time step
+// so the codes of the test do not move to another step while it runs.
+func mfaUser(t *testing.T) *domains.User {
+	t.Helper()
+	if left := totp.Period - time.Duration(time.Now().Unix()%30)*time.Second; left < 2*time.Second {
+		time.Sleep(left)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabledAt := time.Now()
+	return &domains.User{ID: ownerID, Email: "owner@example.com", MFASecret: secret, MFAEnabledAt: &enabledAt}
+}
+
+func TestMFAVerifyRejectsReplayAndSkew(t *testing.T) {
+	user := mfaUser(t)
+	var codes []domains.RecoveryCode
+	service := newTestMFAService(user, newMemoryCache(), &codes)
+	ctx := context.Background()
+	now := time.Now()
+
+	code := func(at time.Time) string {
+		c, err := totp.Code(user.MFASecret, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	current := code(now)
+	if err := service.Verify(ctx, user, current); err != nil {
+		t.Fatalf("expected the current code to be accepted, got %v", err)
+	}
+	if err := service.Verify(ctx, user, current); !errors.Is(err, ErrInvalidMFACode) {
+		t.Errorf("expected a replayed code to be rejected, got %v", err)
+	}
+
+	if err := service.Verify(ctx, user, code(now.Add(-totp.Period))); err != nil {
+		t.Errorf("expected the code of the previous step to be accepted, got %v", err)
+	}
+	if err := service.Verify(ctx, user, code(now.Add(totp.Period))); err != nil {
+		t.Errorf("expected the code of the next step to be accepted, got %v", err)
+	}
+
+	for _, at := range []time.Time{now.Add(-2 * totp.Period), now.Add(2 * totp.Period)} {
+		if c := code(at); c != current {
+			if err := service.Verify(ctx, user, c); !errors.Is(err, ErrInvalidMFACode) {
+				t.Errorf("expected the code two steps away to be rejected, got %v", err)
+			}
+		}
+	}
+}
+
+func TestMFAVerifyAcceptsConcurrentCodesOnce(t *testing.T) {
+	user := mfaUser(t)
+	var codes []domains.RecoveryCode
+	service := newTestMFAService(user, newMemoryCache(), &codes)
+	code, err := totp.Code(user.MFASecret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var accepted atomic.Int32
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := service.Verify(context.Background(), user, code); err == nil {
+				accepted.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if accepted.Load() != 1 {
+		t.Errorf("expected the code to be accepted once, got %d", accepted.Load())
+	}
+}
+
+func TestMFARecoveryCodesAreHashedAndSingleUse(t *testing.T) {
+	user := mfaUser(t)
+	var codes []domains.RecoveryCode
+	service := newTestMFAService(user, newMemoryCache(), &codes)
+	ctx := context.Background()
+
+	current, err := totp.Code(user.MFASecret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	issued, err := service.RegenerateRecoveryCodes(ctx, user.ID, current)
+	if err != nil {
+		t.Fatalf("regenerate: %v", err)
+	}
+	if len(issued) != recoveryCodeCount || len(codes) != recoveryCodeCount {
+		t.Fatalf("expected %d codes, got %d issued and %d stored", recoveryCodeCount, len(issued), len(codes))
+	}
+	for _, code := range codes {
+		if _, err := bcrypt.Cost([]byte(code.CodeHash)); err != nil {
+			t.Fatalf("expected a bcrypt hash, got %q", code.CodeHash)
+		}
+	}
+
+	if err := service.Verify(ctx, user, strings.ToUpper(issued[3])); err != nil {
+		t.Fatalf("expected a recovery code to be accepted, got %v", err)
+	}
+	if err := service.Verify(ctx, user, issued[3]); !errors.Is(err, ErrInvalidMFACode) {
+		t.Errorf("expected a used recovery code to be rejected, got %v", err)
+	}
+	if remaining, _ := service.RemainingRecoveryCodes(ctx, user.ID); remaining != recoveryCodeCount-1 {
+		t.Errorf("expected %d remaining codes, got %d", recoveryCodeCount-1, remaining)
+	}
+
+	// codes stored before bcrypt was used are SHA-256 digests
+	codes = append(codes, domains.RecoveryCode{ID: 99, UserID: user.ID, CodeHash: legacyDigest("abcd2345")})
+	if err := service.Verify(ctx, user, "abcd-2345"); err != nil {
+		t.Errorf("expected a legacy recovery code to be accepted, got %v", err)
+	}
+}
+
+func TestCompleteMFALoginLimitsAttempts(t *testing.T) {
+	user := mfaUser(t)
+	cache := newMemoryCache()
+	var codes []domains.RecoveryCode
+	service := newTestAuthService(t, user, cache)
+	service.mfaService = newTestMFAService(user, cache, &codes)
+	ctx := context.Background()
+
+	result, err := service.StartSession(ctx, user)
+	if err != nil || !result.MFARequired {
+		t.Fatalf("expected an MFA challenge, got %+v, %v", result, err)
+	}
+
+	for i := 0; i < maxMFAAttempts; i++ {
+		if _, err := service.CompleteMFALogin(ctx, result.MFAToken, "000000"); err == nil {
+			t.Fatal("expected a wrong code to fail")
+		}
+	}
+
+	current, err := totp.Code(user.MFASecret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.CompleteMFALogin(ctx, result.MFAToken, current); !errors.Is(err, ErrInvalidMFAChallenge) {
+		t.Errorf("expected the challenge to be dropped after %d attempts, got %v", maxMFAAttempts, err)
+	}
+
+	result, err = service.StartSession(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.CompleteMFALogin(ctx, result.MFAToken, current); err != nil {
+		t.Fatalf("expected a valid code to complete the login, got %v", err)
+	}
+	if _, err := service.CompleteMFALogin(ctx, result.MFAToken, current); !errors.Is(err, ErrInvalidMFAChallenge) {
+		t.Errorf("expected the challenge to be used up, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrNoTokenScopes      = fiber.NewError(fiber.StatusBadRequest, "at least one scope is required")
+	ErrTokenExpiryInPast  = fiber.NewError(fiber.StatusBadRequest, "expiry must be in the future")
+	ErrTokenBoardNotFound = fiber.NewError(fiber.StatusForbidden, "you are not a member of this board")
+)
+
+// PersonalAccessTokenPrefix starts every personal access token, so the auth
+// middleware can tell them from JWTs and leaked tokens are easy to spot.
+const PersonalAccessTokenPrefix = "tmpat_"
+
+const personalAccessTokenDisplayLength = len(PersonalAccessTokenPrefix) + 6
+
+type PersonalAccessTokenService struct {
+	repo         ports.PersonalAccessTokenRepo
+	boardService *BoardService
+}
+
+func NewPersonalAccessTokenService(repo ports.PersonalAccessTokenRepo, boardService *BoardService) *PersonalAccessTokenService {
+	return &PersonalAccessTokenService{
+		repo:         repo,
+		boardService: boardService,
+	}
+}
+
+// CreateToken creates a token for the user and returns it in plain text,
+// which is the only time it is available.
+func (s *PersonalAccessTokenService) CreateToken(ctx context.Context, token *domains.PersonalAccessToken) (string, error) {
+	if len(token.Scopes) == 0 {
+		return "", ErrNoTokenScopes
+	}
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if err := domains.ValidateTokenScope(scope); err != nil {
+			return "", fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	token.Scopes = scopes
+
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(time.Now()) {
+		return "", ErrTokenExpiryInPast
+	}
+
+	if token.BoardID != nil {
+		if _, err := s.boardService.GetRoleByUserIDAndBoardId(ctx, token.UserID, *token.BoardID); err != nil {
+			return "", ErrTokenBoardNotFound
+		}
+	}
+
+	secret, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	plain := PersonalAccessTokenPrefix + secret
+	token.TokenHash = hashToken(plain)
+	token.Prefix = plain[:personalAccessTokenDisplayLength]
+
+	if err := s.repo.Create(ctx, token); err != nil {
+		return "", err
+	}
+	return plain, nil
+}
+
+func (s *PersonalAccessTokenService) GetUserTokens(ctx context.Context, userID uint) ([]domains.PersonalAccessToken, error) {
+	return s.repo.GetByUserID(ctx, userID)
+}
+
+func (s *PersonalAccessTokenService) RevokeToken(ctx context.Context, userID uint, id uint) error {
+	return s.repo.Revoke(ctx, userID, id)
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+)
+
+const (
+	defaultPurgeAfterDays = 30
+	defaultPurgeInterval  = 60
+)
+
+// RetentionService hard-deletes trashed boards, columns and tasks once they
+// have been in the trash longer than the configured period.
+type RetentionService struct {
+	repo        ports.RetentionRepo
+	purgeAfter  time.Duration
+	runInterval time.Duration
+}
+
+func NewRetentionService(repo ports.RetentionRepo, purgeAfterDays uint, intervalMinutes uint) *RetentionService {
+	if purgeAfterDays == 0 {
+		purgeAfterDays = defaultPurgeAfterDays
+	}
+	if intervalMinutes == 0 {
+		intervalMinutes = defaultPurgeInterval
+	}
+	return &RetentionService{
+		repo:        repo,
+		purgeAfter:  time.Duration(purgeAfterDays) * dayDuration,
+		runInterval: time.Duration(intervalMinutes) * time.Minute,
+	}
+}
+
+// Run purges once right away and then on every interval until ctx is done.
+func (s *RetentionService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.runInterval)
+	defer ticker.Stop()
+
+	for {
+		s.Purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *RetentionService) Purge(ctx context.Context) {
+	result, err := s.repo.PurgeDeletedBefore(ctx, time.Now().Add(-s.purgeAfter))
+	if err != nil {
+		log.ErrorLog.Printf("Error purging trash: %v\n", err)
+		return
+	}
+	if result.Boards+result.Columns+result.Tasks > 0 {
+		log.InfoLog.Printf("Purged trash: %d boards, %d columns, %d tasks\n", result.Boards, result.Columns, result.Tasks)
+	}
+}
@@ -2,32 +2,189 @@ package services
 
 import (
 	"context"
+	"slices"
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/adapters/storage"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/gofiber/fiber/v2"
 
 	"time"
 )
 
+var (
+	ErrNoRolePermissions      = fiber.NewError(fiber.StatusBadRequest, "at least one permission is required")
+	ErrUnknownPermission      = fiber.NewError(fiber.StatusBadRequest, "unknown permission")
+	ErrNotGlobalRole          = fiber.NewError(fiber.StatusBadRequest, "role belongs to a board")
+	ErrGlobalRoleAdmin        = fiber.NewError(fiber.StatusForbidden, "only admins can manage roles available on every board")
+	ErrBuiltinRoleRename      = fiber.NewError(fiber.StatusBadRequest, "built-in roles can not be renamed")
+	ErrBuiltinRolePermissions = fiber.NewError(fiber.StatusBadRequest, "permissions of built-in roles can not be changed")
+	ErrBuiltinRoleDelete      = fiber.NewError(fiber.StatusBadRequest, "built-in roles can not be deleted")
+	ErrRoleInUse              = fiber.NewError(fiber.StatusConflict, "role is still granted on a board")
+)
+
 type RoleService struct {
-	roleRepo ports.RoleRepository
+	roleRepo   ports.RoleRepository
+	userRepo   ports.UserRepo
+	authorizer ports.Authorizer
 }
 
-func NewRoleService(roleRepo ports.RoleRepository) *RoleService {
-	return &RoleService{roleRepo: roleRepo}
+func NewRoleService(roleRepo ports.RoleRepository, userRepo ports.UserRepo, authorizer ports.Authorizer) *RoleService {
+	return &RoleService{roleRepo: roleRepo, userRepo: userRepo, authorizer: authorizer}
 }
 
-func (s *RoleService) CreateRole(ctx context.Context, role *domains.Role) error {
+// CreateRole creates a role, roles available on every board can only be
+// created by admins and custom roles of a board only by members holding every
+// permission of the role.
+func (s *RoleService) CreateRole(ctx context.Context, userID uint, role *domains.Role) error {
+	permissions, err := normalizePermissions(role.Permissions)
+	if err != nil {
+		return err
+	}
+	role.Permissions = permissions
+
+	if role.BoardID == nil {
+		err = s.requireAdmin(ctx, userID)
+	} else {
+		err = s.authorizer.AuthorizeRole(ctx, userID, *role.BoardID, domains.ActionBoardRoleManage, role)
+	}
+	if err != nil {
+		return err
+	}
 	return s.roleRepo.Create(ctx, role)
 }
-func (s *RoleService) UpdateRole(ctx context.Context, role *domains.Role) error {
+
+// UpdateRole updates a role, roles available on every board can only be
+// changed by admins. Custom roles stay on their board and can only be changed
+// by members holding every permission of the role before and after.
+func (s *RoleService) UpdateRole(ctx context.Context, userID uint, role *domains.Role) error {
+	permissions, err := normalizePermissions(role.Permissions)
+	if err != nil {
+		return err
+	}
+	role.Permissions = permissions
+
+	existing, err := s.roleRepo.GetByID(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+	if existing.BoardID == nil {
+		err = s.requireAdmin(ctx, userID)
+	} else {
+		err = s.authorizer.AuthorizeRole(ctx, userID, *existing.BoardID, domains.ActionBoardRoleManage, existing, role)
+	}
+	if err != nil {
+		return err
+	}
+	return s.updateRole(ctx, existing, role)
+}
+
+// DeleteRole deletes a role, with the same access rules as UpdateRole.
+func (s *RoleService) DeleteRole(ctx context.Context, userID uint, id uint) error {
+	existing, err := s.roleRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing.BoardID == nil {
+		err = s.requireAdmin(ctx, userID)
+	} else {
+		err = s.authorizer.AuthorizeRole(ctx, userID, *existing.BoardID, domains.ActionBoardRoleManage, existing)
+	}
+	if err != nil {
+		return err
+	}
+	return s.deleteRole(ctx, existing)
+}
+
+// CreateGlobalRole creates a role available on every board, for admins.
+func (s *RoleService) CreateGlobalRole(ctx context.Context, role *domains.Role) error {
+	permissions, err := normalizePermissions(role.Permissions)
+	if err != nil {
+		return err
+	}
+	role.Permissions = permissions
+	role.BoardID = nil
+	return s.roleRepo.Create(ctx, role)
+}
+
+// UpdateGlobalRole updates a role available on every board, for admins.
+func (s *RoleService) UpdateGlobalRole(ctx context.Context, role *domains.Role) error {
+	permissions, err := normalizePermissions(role.Permissions)
+	if err != nil {
+		return err
+	}
+	role.Permissions = permissions
+
+	existing, err := s.getGlobalRole(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+	return s.updateRole(ctx, existing, role)
+}
+
+// DeleteGlobalRole deletes a role available on every board, for admins.
+func (s *RoleService) DeleteGlobalRole(ctx context.Context, id uint) error {
+	existing, err := s.getGlobalRole(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.deleteRole(ctx, existing)
+}
+
+// updateRole keeps the role on its board, built-in roles keep their name as
+// members and invitations refer to them by name, and their permissions as
+// the ownership rules rely on them and they are seeded on every startup.
+func (s *RoleService) updateRole(ctx context.Context, existing, role *domains.Role) error {
+	if existing.BuiltIn() {
+		if role.Name != existing.Name {
+			return ErrBuiltinRoleRename
+		}
+		if !existing.Covers(role) || !role.Covers(existing) {
+			return ErrBuiltinRolePermissions
+		}
+	}
+	role.BoardID = existing.BoardID
 	return s.roleRepo.Update(ctx, role)
 }
-func (s *RoleService) DeleteRole(ctx context.Context, id uint) error {
-	return s.roleRepo.Delete(ctx, id)
+
+// deleteRole refuses to delete built-in roles and roles still granted.
+func (s *RoleService) deleteRole(ctx context.Context, role *domains.Role) error {
+	if role.BuiltIn() {
+		return ErrBuiltinRoleDelete
+	}
+	inUse, err := s.roleRepo.InUse(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return ErrRoleInUse
+	}
+	return s.roleRepo.Delete(ctx, role.ID)
+}
+
+func (s *RoleService) requireAdmin(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role != domains.UserRoleAdmin {
+		return ErrGlobalRoleAdmin
+	}
+	return nil
+}
+
+func (s *RoleService) getGlobalRole(ctx context.Context, id uint) (*domains.Role, error) {
+	role, err := s.roleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if role.BoardID != nil {
+		return nil, ErrNotGlobalRole
+	}
+	return role, nil
 }
+
 func (s *RoleService) GetAllRoles(ctx context.Context) ([]domains.Role, error) {
 	return s.roleRepo.GetAll(ctx)
 }
@@ -35,37 +192,47 @@ func (s *RoleService) GetRoleById(ctx context.Context, id uint) (*domains.Role,
 	return s.roleRepo.GetByID(ctx, id)
 }
 
-func (s *RoleService) InitRolesInDb(ctx context.Context) error {
+// GetBoardRoles returns the roles that can be granted on the board.
+func (s *RoleService) GetBoardRoles(ctx context.Context, userID, boardID uint) ([]domains.Role, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionBoardView); err != nil {
+		return nil, err
+	}
+	return s.roleRepo.GetBoardRoles(ctx, boardID)
+}
+
+func normalizePermissions(permissions []domains.BoardAction) ([]domains.BoardAction, error) {
+	if len(permissions) == 0 {
+		return nil, ErrNoRolePermissions
+	}
+	normalized := make([]domains.BoardAction, 0, len(permissions))
+	for _, permission := range permissions {
+		if !permission.IsValid() {
+			return nil, fiber.NewError(fiber.StatusBadRequest, ErrUnknownPermission.Message+": "+string(permission))
+		}
+		if !slices.Contains(normalized, permission) {
+			normalized = append(normalized, permission)
+		}
+	}
+	return normalized, nil
+}
 
-	maintainerRoleEnum, _ := domains.ParseRole("Maintainer")
-	editorRoleEnum, _ := domains.ParseRole("Editor")
-	ownerRoleEnum, _ := domains.ParseRole("Owner")
-	viewerRoleEnum, _ := domains.ParseRole("Viewer")
-
-	roles := []domains.Role{
-		{
-			Name:        maintainerRoleEnum.String(),
-			Description: "its maintainer role",
-			Weight:      int(maintainerRoleEnum),
-		},
-		{
-			Name:        editorRoleEnum.String(),
-			Description: "its editor role",
-			Weight:      int(editorRoleEnum),
-		},
-		{
-			Name:        ownerRoleEnum.String(),
-			Description: "its owner role",
-			Weight:      int(ownerRoleEnum),
-		},
-		{
-			Name:        viewerRoleEnum.String(),
-			Description: "its viewer role",
-			Weight:      int(viewerRoleEnum),
-		},
+// InitRolesInDb seeds the built-in roles with their default permissions.
+func (s *RoleService) InitRolesInDb(ctx context.Context) error {
+	builtinRoles := []domains.RoleW{domains.Maintainer, domains.Editor, domains.Owner, domains.Viewer}
+	descriptions := map[domains.RoleW]string{
+		domains.Owner:      "its owner role",
+		domains.Maintainer: "its maintainer role",
+		domains.Editor:     "its editor role",
+		domains.Viewer:     "its viewer role",
 	}
+
 	var lastErr error
-	for _, role := range roles {
+	for _, builtin := range builtinRoles {
+		role := domains.Role{
+			Name:        builtin.String(),
+			Description: descriptions[builtin],
+			Permissions: builtin.Permissions(),
+		}
 		err := createRoleWithRetry(s, role, ctx)
 		if err != nil {
 			lastErr = err
@@ -78,17 +245,39 @@ func (s *RoleService) InitRolesInDb(ctx context.Context) error {
 	return lastErr
 }
 
+// grantDefaultPermissions gives a stored built-in role the default permissions
+// it lacks, such as permissions for actions added after the role was seeded.
+// Admins can not change the permissions of built-in roles, so this never
+// reverts a choice of theirs.
+func (s *RoleService) grantDefaultPermissions(ctx context.Context, role domains.Role) error {
+	existing, err := s.roleRepo.GetByName(ctx, role.Name)
+	if err != nil {
+		return err
+	}
+	missing := false
+	for _, permission := range role.Permissions {
+		if !existing.Can(permission) {
+			existing.Permissions = append(existing.Permissions, permission)
+			missing = true
+		}
+	}
+	if !missing {
+		return nil
+	}
+	return s.roleRepo.Update(ctx, existing)
+}
+
 func createRoleWithRetry(s *RoleService, role domains.Role, ctx context.Context) error {
 
 	const maxRetries = 3
 	var lastErr error
 
 	for i := 0; i < maxRetries; i++ {
-		err := s.CreateRole(ctx, &role)
+		err := s.roleRepo.Create(ctx, &role)
 		if err != nil {
 			if err.Error() == storage.ErrRoleAlreadyExists {
 				// Role already exists, no need to retry
-				return nil
+				return s.grantDefaultPermissions(ctx, role)
 			}
 			// Log the error and prepare for retry
 			log.ErrorLog.Printf("Attempt %d: Failed to create role %s: %v", i+1, role.Name, err)
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+// the admin manages the global roles, a custom global role is still granted on
+// a board
+const (
+	adminID          uint = 9
+	globalRoleID     uint = 10
+	usedGlobalRoleID uint = 11
+)
+
+type adminUserRepo struct {
+	fakeUserRepo
+}
+
+func (r adminUserRepo) GetByID(ctx context.Context, id uint) (*domains.User, error) {
+	user, err := r.fakeUserRepo.GetByID(ctx, id)
+	if err == nil && id == adminID {
+		user.Role = domains.UserRoleAdmin
+	}
+	return user, err
+}
+
+type globalRoleRepo struct {
+	fakeRoleRepo
+}
+
+func (r globalRoleRepo) GetByID(ctx context.Context, id uint) (*domains.Role, error) {
+	if id == globalRoleID || id == usedGlobalRoleID {
+		return &domains.Role{ID: id, Name: "Reviewer", Permissions: domains.Viewer.Permissions()}, nil
+	}
+	return r.fakeRoleRepo.GetByID(ctx, id)
+}
+
+func (globalRoleRepo) Create(context.Context, *domains.Role) error { return nil }
+
+func (globalRoleRepo) Update(context.Context, *domains.Role) error { return nil }
+
+func (globalRoleRepo) Delete(context.Context, uint) error { return nil }
+
+func (globalRoleRepo) InUse(_ context.Context, id uint) (bool, error) {
+	return id != globalRoleID, nil
+}
+
+func TestRoleServiceGlobalRoles(t *testing.T) {
+	service := NewRoleService(globalRoleRepo{}, adminUserRepo{}, nil)
+	ctx := context.Background()
+	role := func(id uint, name string) *domains.Role {
+		return &domains.Role{ID: id, Name: name, Permissions: domains.Viewer.Permissions()}
+	}
+
+	cases := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{name: "owner of a board creates a global role", want: ErrGlobalRoleAdmin, call: func() error {
+			return service.CreateRole(ctx, ownerID, role(0, "Reviewer"))
+		}},
+		{name: "admin creates a global role", call: func() error {
+			return service.CreateRole(ctx, adminID, role(0, "Reviewer"))
+		}},
+		{name: "owner of a board updates a global role", want: ErrGlobalRoleAdmin, call: func() error {
+			return service.UpdateRole(ctx, ownerID, role(globalRoleID, "Auditor"))
+		}},
+		{name: "admin renames a global role", call: func() error {
+			return service.UpdateRole(ctx, adminID, role(globalRoleID, "Auditor"))
+		}},
+		{name: "admin renames a built-in role", want: ErrBuiltinRoleRename, call: func() error {
+			return service.UpdateRole(ctx, adminID, role(roleID(domains.Viewer), "Reader"))
+		}},
+		{name: "admin describes a built-in role", call: func() error {
+			viewer := role(roleID(domains.Viewer), domains.Viewer.String())
+			viewer.Description = "read-only access"
+			return service.UpdateGlobalRole(ctx, viewer)
+		}},
+		{name: "admin changes the permissions of a built-in role", want: ErrBuiltinRolePermissions, call: func() error {
+			viewer := role(roleID(domains.Viewer), domains.Viewer.String())
+			viewer.Permissions = domains.Editor.Permissions()
+			return service.UpdateGlobalRole(ctx, viewer)
+		}},
+		{name: "owner of a board deletes a global role", want: ErrGlobalRoleAdmin, call: func() error {
+			return service.DeleteRole(ctx, ownerID, globalRoleID)
+		}},
+		{name: "admin deletes a built-in role", want: ErrBuiltinRoleDelete, call: func() error {
+			return service.DeleteGlobalRole(ctx, roleID(domains.Editor))
+		}},
+		{name: "admin deletes a granted role", want: ErrRoleInUse, call: func() error {
+			return service.DeleteRole(ctx, adminID, usedGlobalRoleID)
+		}},
+		{name: "admin deletes an unused role", call: func() error {
+			return service.DeleteRole(ctx, adminID, globalRoleID)
+		}},
+	}
+	for _, tc := range cases {
+		if err := tc.call(); !errors.Is(err, tc.want) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
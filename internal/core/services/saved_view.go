@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SavedViewService struct {
+	repo        ports.SavedViewRepo
+	taskService *TaskService
+	authorizer  ports.Authorizer
+}
+
+var (
+	ErrSavedViewNotInBoard     = fiber.NewError(fiber.StatusNotFound, "Saved view not found in this board")
+	ErrSavedViewInvalidSort    = fiber.NewError(fiber.StatusBadRequest, "invalid saved view sort or grouping")
+	ErrSavedViewShareForbidden = &fiber.Error{Code: fiber.StatusForbidden, Message: "only maintainers can share views with the board"}
+)
+
+func NewSavedViewService(repo ports.SavedViewRepo, taskService *TaskService, authorizer ports.Authorizer) *SavedViewService {
+	return &SavedViewService{
+		repo:        repo,
+		taskService: taskService,
+		authorizer:  authorizer,
+	}
+}
+
+func (s *SavedViewService) CreateView(ctx context.Context, view *domains.SavedView) error {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, view.UserID, view.BoardID, domains.ActionSavedViewUse); err != nil {
+		return err
+	}
+
+	if err := s.validateView(ctx, view.UserID, view); err != nil {
+		return err
+	}
+
+	return s.repo.Create(ctx, view)
+}
+
+func (s *SavedViewService) GetViews(ctx context.Context, userID uint, boardID uint) ([]domains.SavedView, error) {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSavedViewUse); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetListByBoardID(ctx, boardID, userID)
+}
+
+func (s *SavedViewService) GetViewByID(ctx context.Context, userID uint, boardID uint, id uint) (*domains.SavedView, error) {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSavedViewUse); err != nil {
+		return nil, err
+	}
+
+	return s.getVisibleView(ctx, userID, boardID, id)
+}
+
+// UpdateView updates a view of the user. Shared views can also be updated by board maintainers.
+func (s *SavedViewService) UpdateView(ctx context.Context, userID uint, view *domains.SavedView) (*domains.SavedView, error) {
+	existing, err := s.GetViewByID(ctx, userID, view.BoardID, view.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkCanModify(ctx, userID, existing); err != nil {
+		return nil, err
+	}
+
+	if err = s.validateView(ctx, userID, view); err != nil {
+		return nil, err
+	}
+
+	existing.Name = view.Name
+	existing.Filter = view.Filter
+	existing.VisibleColumns = view.VisibleColumns
+	existing.IsShared = view.IsShared
+
+	if err = s.repo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (s *SavedViewService) DeleteView(ctx context.Context, userID uint, boardID uint, id uint) error {
+	existing, err := s.GetViewByID(ctx, userID, boardID, id)
+	if err != nil {
+		return err
+	}
+
+	if err = s.checkCanModify(ctx, userID, existing); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+// ExecuteView returns the task page matching the filter, sort and grouping of the view.
+func (s *SavedViewService) ExecuteView(ctx context.Context, userID uint, boardID uint, id uint, pageNumber uint, pageSize uint) ([]domains.Task, uint, error) {
+	view, err := s.GetViewByID(ctx, userID, boardID, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.taskService.GetTasksByBoardID(ctx, userID, view.BoardID, view.Filter, pageNumber, pageSize)
+}
+
+func (s *SavedViewService) getVisibleView(ctx context.Context, userID uint, boardID uint, id uint) (*domains.SavedView, error) {
+	view, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// private views of other users are reported as missing
+	if view.BoardID != boardID || (view.UserID != userID && !view.IsShared) {
+		return nil, ErrSavedViewNotInBoard
+	}
+	return view, nil
+}
+
+func (s *SavedViewService) checkCanModify(ctx context.Context, userID uint, view *domains.SavedView) error {
+	if view.UserID == userID {
+		return nil
+	}
+
+	if err := s.authorizer.Authorize(ctx, userID, view.BoardID, domains.ActionSavedViewManage); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SavedViewService) validateView(ctx context.Context, userID uint, view *domains.SavedView) error {
+	sort := view.Filter.Sort
+	if !sort.GroupBy.IsValid() || (sort.Field != "" && !sort.Field.IsValid()) {
+		return ErrSavedViewInvalidSort
+	}
+
+	if view.IsShared {
+		if err := s.authorizer.Authorize(ctx, userID, view.BoardID, domains.ActionSavedViewManage); err != nil {
+			return ErrSavedViewShareForbidden
+		}
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SprintService struct {
+	repo       ports.SprintRepo
+	taskRepo   ports.TaskRepo
+	columnRepo ports.ColumnRepo
+	authorizer ports.Authorizer
+}
+
+var (
+	ErrSprintNotInBoard       = fiber.NewError(fiber.StatusNotFound, "Sprint not found in this board")
+	ErrSprintInvalidDates     = fiber.NewError(fiber.StatusBadRequest, "sprint end date must be after its start date")
+	ErrSprintClosed           = fiber.NewError(fiber.StatusBadRequest, "sprint is already closed")
+	ErrSprintNotPlanned       = fiber.NewError(fiber.StatusBadRequest, "only planned sprints can be started")
+	ErrSprintNotActive        = fiber.NewError(fiber.StatusBadRequest, "only active sprints can be closed")
+	ErrSprintActiveExists     = fiber.NewError(fiber.StatusBadRequest, "board already has an active sprint")
+	ErrSprintActiveDelete     = fiber.NewError(fiber.StatusBadRequest, "active sprint must be closed before deleting it")
+	ErrSprintInvalidCarryOver = fiber.NewError(fiber.StatusBadRequest, "carry over sprint must be another open sprint of the same board")
+	ErrTaskNotInBoard         = fiber.NewError(fiber.StatusBadRequest, "task does not belong to this board")
+	ErrSprintTaskListIsEmpty  = fiber.NewError(fiber.StatusBadRequest, "no task ids provided")
+)
+
+const (
+	dayDuration = 24 * time.Hour
+	// burndownMaxDays guards against huge responses for misconfigured sprints
+	burndownMaxDays = 366
+)
+
+func NewSprintService(repo ports.SprintRepo, taskRepo ports.TaskRepo, columnRepo ports.ColumnRepo, authorizer ports.Authorizer) *SprintService {
+	return &SprintService{
+		repo:       repo,
+		taskRepo:   taskRepo,
+		columnRepo: columnRepo,
+		authorizer: authorizer,
+	}
+}
+
+func (s *SprintService) CreateSprint(ctx context.Context, sprint *domains.Sprint) error {
+	if err := s.authorizer.Authorize(ctx, sprint.CreatedBy, sprint.BoardID, domains.ActionSprintManage); err != nil {
+		return err
+	}
+
+	if !sprint.EndDate.After(sprint.StartDate) {
+		return ErrSprintInvalidDates
+	}
+
+	sprint.State = domains.SprintPlanned
+	return s.repo.Create(ctx, sprint)
+}
+
+func (s *SprintService) GetSprints(ctx context.Context, userID uint, boardID uint) ([]domains.Sprint, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSprintView); err != nil {
+		return nil, err
+	}
+	return s.repo.GetListByBoardID(ctx, boardID)
+}
+
+func (s *SprintService) GetSprintByID(ctx context.Context, userID uint, boardID uint, id uint) (*domains.Sprint, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSprintView); err != nil {
+		return nil, err
+	}
+	return s.getBoardSprint(ctx, boardID, id)
+}
+
+func (s *SprintService) UpdateSprint(ctx context.Context, userID uint, sprint *domains.Sprint) (*domains.Sprint, error) {
+	if err := s.authorizer.Authorize(ctx, userID, sprint.BoardID, domains.ActionSprintManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getBoardSprint(ctx, sprint.BoardID, sprint.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.State == domains.SprintClosed {
+		return nil, ErrSprintClosed
+	}
+
+	if !sprint.EndDate.After(sprint.StartDate) {
+		return nil, ErrSprintInvalidDates
+	}
+
+	existing.Name = sprint.Name
+	existing.Goal = sprint.Goal
+	existing.StartDate = sprint.StartDate
+	existing.EndDate = sprint.EndDate
+
+	if err = s.repo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (s *SprintService) DeleteSprint(ctx context.Context, userID uint, boardID uint, id uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSprintManage); err != nil {
+		return err
+	}
+
+	sprint, err := s.getBoardSprint(ctx, boardID, id)
+	if err != nil {
+		return err
+	}
+
+	if sprint.State == domains.SprintActive {
+		return ErrSprintActiveDelete
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *SprintService) AssignTasks(ctx context.Context, userID uint, boardID uint, sprintID uint, taskIDs []uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSprintManage); err != nil {
+		return err
+	}
+
+	if len(taskIDs) == 0 {
+		return ErrSprintTaskListIsEmpty
+	}
+
+	sprint, err := s.getBoardSprint(ctx, boardID, sprintID)
+	if err != nil {
+		return err
+	}
+
+	if sprint.State == domains.SprintClosed {
+		return ErrSprintClosed
+	}
+
+	tasks, err := s.taskRepo.GetListByIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(tasks) != len(uniqueIDs(taskIDs)) {
+		return fiber.NewError(fiber.StatusNotFound, "Task not found!")
+	}
+
+	for _, task := range tasks {
+		if task.BoardID != boardID {
+			return ErrTaskNotInBoard
+		}
+	}
+
+	return s.repo.AssignTasks(ctx, sprintID, uniqueIDs(taskIDs))
+}
+
+func (s *SprintService) RemoveTask(ctx context.Context, userID uint, boardID uint, sprintID uint, taskID uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSprintManage); err != nil {
+		return err
+	}
+
+	sprint, err := s.getBoardSprint(ctx, boardID, sprintID)
+	if err != nil {
+		return err
+	}
+
+	if sprint.State == domains.SprintClosed {
+		return ErrSprintClosed
+	}
+
+	return s.repo.RemoveTask(ctx, sprintID, taskID)
+}
+
+func (s *SprintService) StartSprint(ctx context.Context, userID uint, boardID uint, sprintID uint) (*domains.Sprint, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSprintManage); err != nil {
+		return nil, err
+	}
+
+	sprint, err := s.getBoardSprint(ctx, boardID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sprint.State != domains.SprintPlanned {
+		return nil, ErrSprintNotPlanned
+	}
+
+	active, err := s.repo.GetActiveByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return nil, ErrSprintActiveExists
+	}
+
+	now := time.Now()
+	sprint.State = domains.SprintActive
+	sprint.StartedAt = &now
+
+	if err = s.repo.Update(ctx, sprint); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+// CloseSprint closes an active sprint. Tasks that are not in the final column
+// are carried over to carryOverSprintID, or back to the backlog when it is nil.
+func (s *SprintService) CloseSprint(ctx context.Context, userID uint, boardID uint, sprintID uint, carryOverSprintID *uint) (*domains.Sprint, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSprintManage); err != nil {
+		return nil, err
+	}
+
+	sprint, err := s.getBoardSprint(ctx, boardID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sprint.State != domains.SprintActive {
+		return nil, ErrSprintNotActive
+	}
+
+	if carryOverSprintID != nil {
+		target, errTarget := s.repo.GetByID(ctx, *carryOverSprintID)
+		if errTarget != nil {
+			return nil, errTarget
+		}
+		if target.BoardID != boardID || target.ID == sprint.ID || target.State == domains.SprintClosed {
+			return nil, ErrSprintInvalidCarryOver
+		}
+	}
+
+	sprintTasks, err := s.repo.GetSprintTasks(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepo.GetListByIDs(ctx, sprintTaskIDs(sprintTasks))
+	if err != nil {
+		return nil, err
+	}
+
+	var unfinished []uint
+	for _, task := range tasks {
+		if task.SprintID == nil || *task.SprintID != sprintID {
+			continue
+		}
+		if task.Column == nil || !task.Column.IsFinal {
+			unfinished = append(unfinished, task.ID)
+		}
+	}
+
+	now := time.Now()
+	sprint.State = domains.SprintClosed
+	sprint.ClosedAt = &now
+
+	if err = s.repo.Close(ctx, sprint, carryOverSprintID, unfinished); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+// GetBurndown returns the remaining story points of the sprint scope at the
+// end of every sprint day, replayed from the recorded column transitions.
+func (s *SprintService) GetBurndown(ctx context.Context, userID uint, boardID uint, sprintID uint) ([]domains.BurndownPoint, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionSprintView); err != nil {
+		return nil, err
+	}
+
+	sprint, err := s.getBoardSprint(ctx, boardID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	sprintTasks, err := s.repo.GetSprintTasks(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs := sprintTaskIDs(sprintTasks)
+
+	tasks, err := s.taskRepo.GetListByIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.taskRepo.GetColumnTransitions(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.columnRepo.GetAll(ctx, boardID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return calculateBurndown(sprint, sprintTasks, tasks, transitions, finalColumnIDs(columns.Data), time.Now()), nil
+}
+
+func (s *SprintService) getBoardSprint(ctx context.Context, boardID uint, id uint) (*domains.Sprint, error) {
+	sprint, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.BoardID != boardID {
+		return nil, ErrSprintNotInBoard
+	}
+	return sprint, nil
+}
+
+func calculateBurndown(
+	sprint *domains.Sprint,
+	sprintTasks []domains.SprintTask,
+	tasks []domains.Task,
+	transitions []domains.TaskColumnTransition,
+	finalColumns map[uint]bool,
+	now time.Time,
+) []domains.BurndownPoint {
+	start := truncateToDay(sprint.StartDate)
+	end := truncateToDay(sprint.EndDate)
+
+	last := end
+	if today := truncateToDay(now); today.Before(last) {
+		last = today
+	}
+	if sprint.ClosedAt != nil {
+		if closed := truncateToDay(*sprint.ClosedAt); closed.Before(last) {
+			last = closed
+		}
+	}
+
+	scopes := make(map[uint]domains.SprintTask, len(sprintTasks))
+	for _, sprintTask := range sprintTasks {
+		scopes[sprintTask.TaskID] = sprintTask
+	}
+
+	transitionsByTask := groupTransitionsByTask(transitions)
+
+	totalDays := int(end.Sub(start) / dayDuration)
+	points := make([]domains.BurndownPoint, 0, totalDays+1)
+
+	for day, i := start, 0; !day.After(last) && i < burndownMaxDays; day, i = day.Add(dayDuration), i+1 {
+		endOfDay := day.Add(dayDuration)
+
+		remaining := 0
+		for _, task := range tasks {
+			// tasks committed before the sprint started count from its first day,
+			// removed tasks count until the day they were removed
+			if scope, ok := scopes[task.ID]; ok {
+				if !scope.CreatedAt.Before(endOfDay) || (scope.RemovedAt != nil && scope.RemovedAt.Before(endOfDay)) {
+					continue
+				}
+			}
+			if !finalColumns[columnAt(task, transitionsByTask[task.ID], endOfDay)] {
+				remaining += task.StoryPoint
+			}
+		}
+
+		points = append(points, domains.BurndownPoint{
+			Date:            day,
+			RemainingPoints: remaining,
+		})
+	}
+
+	if len(points) > 0 && totalDays > 0 {
+		total := float64(points[0].RemainingPoints)
+		for i := range points {
+			points[i].IdealPoints = total * float64(totalDays-i) / float64(totalDays)
+		}
+	}
+
+	return points
+}
+
+// columnAt returns the column the task was in at the given time.
+// transitions must be ordered by creation time.
+func columnAt(task domains.Task, transitions []domains.TaskColumnTransition, at time.Time) uint {
+	if len(transitions) == 0 {
+		return task.ColumnID
+	}
+
+	column := task.ColumnID
+	if transitions[0].FromColumnID != nil {
+		column = *transitions[0].FromColumnID
+	}
+
+	for _, transition := range transitions {
+		if transition.CreatedAt.After(at) {
+			break
+		}
+		column = transition.ToColumnID
+	}
+	return column
+}
+
+func groupTransitionsByTask(transitions []domains.TaskColumnTransition) map[uint][]domains.TaskColumnTransition {
+	grouped := make(map[uint][]domains.TaskColumnTransition)
+	for _, transition := range transitions {
+		grouped[transition.TaskID] = append(grouped[transition.TaskID], transition)
+	}
+	for _, taskTransitions := range grouped {
+		sort.SliceStable(taskTransitions, func(i, j int) bool {
+			return taskTransitions[i].CreatedAt.Before(taskTransitions[j].CreatedAt)
+		})
+	}
+	return grouped
+}
+
+func finalColumnIDs(columns []*domains.Column) map[uint]bool {
+	final := make(map[uint]bool)
+	for _, column := range columns {
+		if column.IsFinal {
+			final[column.ID] = true
+		}
+	}
+	return final
+}
+
+func sprintTaskIDs(sprintTasks []domains.SprintTask) []uint {
+	ids := make([]uint, len(sprintTasks))
+	for i, sprintTask := range sprintTasks {
+		ids[i] = sprintTask.TaskID
+	}
+	return ids
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
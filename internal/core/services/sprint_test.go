@@ -0,0 +1,56 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+)
+
+func TestCalculateBurndown(t *testing.T) {
+	const (
+		todoColumnID uint = 1
+		doneColumnID uint = 2
+	)
+	day := func(n int, hour int) time.Time {
+		return time.Date(2024, 8, 1+n, hour, 0, 0, 0, time.UTC)
+	}
+	at := func(t time.Time) *time.Time { return &t }
+	from := todoColumnID
+
+	sprint := &domains.Sprint{StartDate: day(0, 0), EndDate: day(4, 0)}
+	sprintTasks := []domains.SprintTask{
+		// committed before the sprint started, finished on day 2
+		{TaskID: 1, CreatedAt: day(-1, 12)},
+		// removed from the sprint during day 1
+		{TaskID: 2, CreatedAt: day(-1, 12), RemovedAt: at(day(1, 10))},
+		// added during day 3
+		{TaskID: 3, CreatedAt: day(3, 9)},
+	}
+	tasks := []domains.Task{
+		{ID: 1, ColumnID: doneColumnID, StoryPoint: 5},
+		{ID: 2, ColumnID: todoColumnID, StoryPoint: 3},
+		{ID: 3, ColumnID: todoColumnID, StoryPoint: 2},
+	}
+	transitions := []domains.TaskColumnTransition{
+		{TaskID: 1, FromColumnID: &from, ToColumnID: doneColumnID, CreatedAt: day(2, 15)},
+	}
+
+	points := calculateBurndown(sprint, sprintTasks, tasks, transitions, map[uint]bool{doneColumnID: true}, day(10, 0))
+
+	want := []int{8, 5, 0, 2, 2}
+	if len(points) != len(want) {
+		t.Fatalf("got %d points, want %d", len(points), len(want))
+	}
+	for i, point := range points {
+		if !point.Date.Equal(day(i, 0)) {
+			t.Errorf("day %d: date = %v, want %v", i, point.Date, day(i, 0))
+		}
+		if point.RemainingPoints != want[i] {
+			t.Errorf("day %d: remaining = %d, want %d", i, point.RemainingPoints, want[i])
+		}
+	}
+	if points[0].IdealPoints != 8 || points[4].IdealPoints != 0 {
+		t.Errorf("ideal line = %v .. %v, want 8 .. 0", points[0].IdealPoints, points[4].IdealPoints)
+	}
+}
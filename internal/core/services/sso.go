@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/oidc"
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/oauth2"
+)
+
+var (
+	ErrUnknownSSOProvider  = fiber.NewError(fiber.StatusNotFound, "unknown identity provider")
+	ErrSSOProviderDown     = fiber.NewError(fiber.StatusBadGateway, "identity provider is not reachable")
+	ErrInvalidSSOState     = fiber.NewError(fiber.StatusBadRequest, "invalid or expired login state, please start again")
+	ErrSSOLoginFailed      = fiber.NewError(fiber.StatusUnauthorized, "login with the identity provider failed")
+	ErrSSOEmailNotVerified = fiber.NewError(fiber.StatusForbidden, "the identity provider did not confirm the email address")
+)
+
+const (
+	ssoStateKeyPrefix = "auth:oidc_state:"
+
+	defaultSSOStateExp = 10 * time.Minute
+)
+
+type ssoState struct {
+	Provider string `json:"provider"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+// SSOService logs users in with OpenID Connect providers using the
+// authorization code flow with PKCE. Users are matched by the provider
+// subject first and by verified email second, new users are created on the
+// fly, and the session is started with the project's own tokens.
+type SSOService struct {
+	userRepo     ports.UserRepo
+	identityRepo ports.UserIdentityRepo
+	cache        ports.CacheRepository
+	authService  *AuthService
+	invitations  *BoardInvitationService
+	configs      map[string]oidc.Config
+	stateExp     time.Duration
+
+	mu        sync.Mutex
+	providers map[string]*oidc.Provider
+}
+
+func NewSSOService(userRepo ports.UserRepo, identityRepo ports.UserIdentityRepo, cache ports.CacheRepository, authService *AuthService,
+	invitationService *BoardInvitationService, configs []oidc.Config, stateExp time.Duration) *SSOService {
+	if stateExp <= 0 {
+		stateExp = defaultSSOStateExp
+	}
+	byName := make(map[string]oidc.Config, len(configs))
+	for _, cfg := range configs {
+		byName[cfg.Name] = cfg
+	}
+	return &SSOService{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		cache:        cache,
+		authService:  authService,
+		invitations:  invitationService,
+		configs:      byName,
+		stateExp:     stateExp,
+		providers:    make(map[string]*oidc.Provider),
+	}
+}
+
+// Providers returns the names of the configured identity providers.
+func (s *SSOService) Providers() []string {
+	names := make([]string, 0, len(s.configs))
+	for name := range s.configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AuthURL starts a login and returns the provider URL to redirect the user to.
+func (s *SSOService) AuthURL(ctx context.Context, providerName string) (string, error) {
+	provider, err := s.provider(ctx, providerName)
+	if err != nil {
+		return "", err
+	}
+
+	stateToken, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	state := ssoState{Provider: providerName, Nonce: nonce, Verifier: oauth2.GenerateVerifier()}
+
+	value, err := json.Marshal(state)
+	if err != nil {
+		return "", &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	if err := s.cache.Set(ctx, ssoStateKeyPrefix+hashToken(stateToken), string(value), s.stateExp); err != nil {
+		return "", &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	return provider.AuthCodeURL(stateToken, state.Nonce, state.Verifier), nil
+}
+
+// Callback finishes a login with the code the provider redirected back with.
+func (s *SSOService) Callback(ctx context.Context, providerName string, stateToken string, code string) (*LoginResult, error) {
+	state, err := s.takeState(ctx, stateToken)
+	if err != nil {
+		return nil, err
+	}
+	if state.Provider != providerName {
+		return nil, ErrInvalidSSOState
+	}
+
+	provider, err := s.provider(ctx, providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := provider.Exchange(ctx, code, state.Verifier, state.Nonce)
+	if err != nil {
+		log.ErrorLog.Printf("Error exchanging %s authorization code: %v\n", providerName, err)
+		return nil, ErrSSOLoginFailed
+	}
+
+	user, err := s.findOrCreateUser(ctx, providerName, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authService.StartSession(ctx, user)
+}
+
+func (s *SSOService) findOrCreateUser(ctx context.Context, providerName string, claims *oidc.Claims) (*domains.User, error) {
+	identity, err := s.identityRepo.GetByProviderSubject(ctx, providerName, claims.Subject)
+	if err == nil {
+		user, err := s.userRepo.GetByID(ctx, identity.UserID)
+		if !isNotFound(err) {
+			return user, err
+		}
+
+		// the account was deleted, the identity is free to link again
+		log.InfoLog.Printf("Removing %s identity %d of deleted user %d\n", providerName, identity.ID, identity.UserID)
+		if err := s.identityRepo.Delete(ctx, identity.ID); err != nil {
+			return nil, err
+		}
+	} else if !isNotFound(err) {
+		return nil, err
+	}
+
+	// linking by email is only safe when the provider vouches for the address
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, ErrSSOEmailNotVerified
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, claims.Email)
+	if err != nil {
+		if !isNotFound(err) {
+			return nil, err
+		}
+
+		now := time.Now()
+		user = &domains.User{
+			Name:            ssoUserName(claims),
+			Email:           claims.Email,
+			Role:            domains.UserRoleUser,
+			EmailVerifiedAt: &now,
+		}
+		if err := s.userRepo.Create(ctx, user); err != nil {
+			return nil, err
+		}
+		log.InfoLog.Printf("User %d created from %s login\n", user.ID, providerName)
+		s.joinInvitedBoards(ctx, user.ID)
+	} else if user.EmailVerifiedAt == nil {
+		if err := s.resetUnverifiedUser(ctx, user); err != nil {
+			return nil, err
+		}
+		if err := s.userRepo.MarkEmailVerified(ctx, user.ID); err != nil {
+			return nil, err
+		}
+		s.joinInvitedBoards(ctx, user.ID)
+	}
+
+	if err := s.identityRepo.Create(ctx, &domains.UserIdentity{
+		UserID:   user.ID,
+		Provider: providerName,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+	}); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// resetUnverifiedUser locks out whoever registered an email they never
+// verified before the owner of the address logs in with it: the password,
+// MFA, sessions and personal access tokens they may have set up are dropped.
+func (s *SSOService) resetUnverifiedUser(ctx context.Context, user *domains.User) error {
+	log.WarningLog.Printf("Linking unverified user %d to an identity provider, resetting its credentials\n", user.ID)
+
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, ""); err != nil {
+		return err
+	}
+	user.Password = ""
+
+	if user.MFAEnabled() {
+		if err := s.authService.mfaService.ResetMFA(ctx, user.ID); err != nil {
+			return err
+		}
+		user.MFASecret, user.MFAEnabledAt = "", nil
+	}
+
+	if err := s.authService.RevokePersonalAccessTokens(ctx, user.ID); err != nil {
+		return err
+	}
+	return s.authService.RevokeUserSessions(ctx, user.ID)
+}
+
+// joinInvitedBoards accepts the invitations of a user whose email the provider
+// verified, a failure does not stop the login.
+func (s *SSOService) joinInvitedBoards(ctx context.Context, userID uint) {
+	if err := s.invitations.JoinInvitedBoards(ctx, userID); err != nil {
+		log.ErrorLog.Printf("Error joining invited boards for user %d: %v\n", userID, err)
+	}
+}
+
+func (s *SSOService) takeState(ctx context.Context, stateToken string) (*ssoState, error) {
+	if stateToken == "" {
+		return nil, ErrInvalidSSOState
+	}
+
+	key := ssoStateKeyPrefix + hashToken(stateToken)
+	value, err := s.cache.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, ports.ErrCacheMiss) {
+			return nil, ErrInvalidSSOState
+		}
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	if err := s.cache.Delete(ctx, key); err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+
+	var state ssoState
+	if err := json.Unmarshal([]byte(value), &state); err != nil {
+		return nil, &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	return &state, nil
+}
+
+// provider discovers the provider endpoints on first use, so an unreachable
+// provider does not keep the API from starting.
+func (s *SSOService) provider(ctx context.Context, name string) (*oidc.Provider, error) {
+	cfg, ok := s.configs[name]
+	if !ok {
+		return nil, ErrUnknownSSOProvider
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if provider, ok := s.providers[name]; ok {
+		return provider, nil
+	}
+
+	provider, err := oidc.NewProvider(ctx, cfg, nil)
+	if err != nil {
+		log.ErrorLog.Printf("Error discovering identity provider %s: %v\n", name, err)
+		return nil, ErrSSOProviderDown
+	}
+	s.providers[name] = provider
+	return provider, nil
+}
+
+func ssoUserName(claims *oidc.Claims) string {
+	if claims.Name != "" {
+		return claims.Name
+	}
+	return strings.SplitN(claims.Email, "@", 2)[0]
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/oidc"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/oidc/oidctest"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const deletedUserID uint = 8
+
+// memoryIdentityRepo starts without any linked identity.
+type memoryIdentityRepo struct {
+	identities *[]domains.UserIdentity
+}
+
+func (r memoryIdentityRepo) GetByProviderSubject(_ context.Context, provider string, subject string) (*domains.UserIdentity, error) {
+	for _, identity := range *r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return &identity, nil
+		}
+	}
+	return nil, fiber.NewError(fiber.StatusNotFound, "identity not found")
+}
+
+func (r memoryIdentityRepo) Create(_ context.Context, identity *domains.UserIdentity) error {
+	*r.identities = append(*r.identities, *identity)
+	return nil
+}
+
+func (r memoryIdentityRepo) Delete(_ context.Context, id uint) error {
+	*r.identities = slices.DeleteFunc(*r.identities, func(identity domains.UserIdentity) bool {
+		return identity.ID == id
+	})
+	return nil
+}
+
+type revokingTokenRepo struct {
+	ports.PersonalAccessTokenRepo
+	revoked *[]uint
+}
+
+func (r revokingTokenRepo) RevokeByUserID(_ context.Context, userID uint) error {
+	*r.revoked = append(*r.revoked, userID)
+	return nil
+}
+
+type noInvitationRepo struct {
+	ports.BoardInvitationRepo
+}
+
+func (noInvitationRepo) GetPending(context.Context, string, uint) ([]domains.BoardInvitation, error) {
+	return nil, nil
+}
+
+type ssoTest struct {
+	service    *SSOService
+	server     *oidctest.Server
+	cache      *memoryCache
+	identities []domains.UserIdentity
+	revoked    []uint
+}
+
+func newSSOTest(t *testing.T, user *domains.User) *ssoTest {
+	t.Helper()
+	test := &ssoTest{server: oidctest.NewServer(t), cache: newMemoryCache()}
+	test.server.Email = user.Email
+
+	var codes []domains.RecoveryCode
+	authService := newTestAuthService(t, user, test.cache)
+	authService.tokenRepo = revokingTokenRepo{revoked: &test.revoked}
+	authService.mfaService = NewMFAService(authUserRepo{user: user}, memoryRecoveryCodeRepo{codes: &codes}, test.cache,
+		password.NewHasher(bcrypt.MinCost), "")
+	invitations := NewBoardInvitationService(noInvitationRepo{}, nil, nil, authUserRepo{user: user}, nil, nil, nil, nil, nil, InvitationSettings{})
+
+	test.service = NewSSOService(authUserRepo{user: user}, memoryIdentityRepo{identities: &test.identities}, test.cache,
+		authService, invitations, []oidc.Config{{
+			Name:         "mock",
+			IssuerURL:    test.server.URL,
+			ClientID:     oidctest.ClientID,
+			ClientSecret: oidctest.ClientSecret,
+			RedirectURL:  "http://localhost/callback",
+		}}, 0)
+	return test
+}
+
+func (s *ssoTest) login(t *testing.T) *LoginResult {
+	t.Helper()
+	ctx := context.Background()
+	authURL, err := s.service.AuthURL(ctx, "mock")
+	if err != nil {
+		t.Fatalf("auth url: %v", err)
+	}
+	code, state := s.server.Authorize(t, authURL)
+	result, err := s.service.Callback(ctx, "mock", state, code)
+	if err != nil {
+		t.Fatalf("callback: %v", err)
+	}
+	return result
+}
+
+func TestSSOLinkResetsUnverifiedUser(t *testing.T) {
+	hash, err := password.NewHasher(bcrypt.MinCost).Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// somebody registered the address and set up MFA without ever verifying it
+	enabledAt := time.Now()
+	user := &domains.User{ID: ownerID, Email: "test@example.com", Password: hash, MFASecret: "SECRET", MFAEnabledAt: &enabledAt}
+	test := newSSOTest(t, user)
+
+	result := test.login(t)
+	if result.MFARequired || result.Token == nil {
+		t.Fatalf("expected the owner of the address to get a session, got %+v", result)
+	}
+
+	if user.HasPassword() {
+		t.Error("expected the password of the unverified user to be cleared")
+	}
+	if user.MFAEnabled() {
+		t.Error("expected the MFA of the unverified user to be reset")
+	}
+	if user.EmailVerifiedAt == nil {
+		t.Error("expected the email to be verified by the provider")
+	}
+	if fmt.Sprint(test.revoked) != fmt.Sprint([]uint{user.ID}) {
+		t.Errorf("expected the personal access tokens of user %d to be revoked, got %v", user.ID, test.revoked)
+	}
+	if _, err := test.cache.Get(context.Background(), fmt.Sprintf("%s%d", sessionsRevokedKeyPrefix, user.ID)); err != nil {
+		t.Errorf("expected the sessions of the user to be revoked, got %v", err)
+	}
+	if len(test.identities) != 1 || test.identities[0].UserID != user.ID || test.identities[0].Subject != test.server.Subject {
+		t.Errorf("expected the identity to be linked to user %d, got %+v", user.ID, test.identities)
+	}
+}
+
+func TestSSOLinkKeepsVerifiedUser(t *testing.T) {
+	hash, err := password.NewHasher(bcrypt.MinCost).Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifiedAt := time.Now()
+	user := &domains.User{ID: ownerID, Email: "test@example.com", Password: hash, EmailVerifiedAt: &verifiedAt}
+	test := newSSOTest(t, user)
+
+	if result := test.login(t); result.Token == nil {
+		t.Fatalf("expected a session, got %+v", result)
+	}
+
+	if !user.PasswordIsValid("Secret#1") {
+		t.Error("expected a verified user to keep the password")
+	}
+	if len(test.revoked) != 0 {
+		t.Errorf("expected no token to be revoked, got %v", test.revoked)
+	}
+	if len(test.identities) != 1 {
+		t.Errorf("expected the identity to be linked, got %+v", test.identities)
+	}
+}
+
+func TestSSOReplacesIdentityOfDeletedUser(t *testing.T) {
+	verifiedAt := time.Now()
+	user := &domains.User{ID: ownerID, Email: "test@example.com", EmailVerifiedAt: &verifiedAt}
+	test := newSSOTest(t, user)
+	// the subject was linked to an account that has been deleted since
+	test.identities = []domains.UserIdentity{{ID: 1, UserID: deletedUserID, Provider: "mock", Subject: test.server.Subject}}
+
+	if result := test.login(t); result.Token == nil {
+		t.Fatalf("expected a session, got %+v", result)
+	}
+
+	if len(test.identities) != 1 || test.identities[0].UserID != user.ID {
+		t.Errorf("expected the identity to be linked to user %d, got %+v", user.ID, test.identities)
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
@@ -13,17 +14,28 @@ import (
 type TaskService struct {
 	repo            ports.TaskRepo
 	taskCommentRepo ports.TaskCommentRepo
+	labelRepo       ports.LabelRepo
 	notifier        ports.Notifier
 	boardService    *BoardService
 	columnService   *ColumnService
+	authorizer      ports.Authorizer
 }
 
+var (
+	ErrColumnNotInBoard    = fiber.NewError(fiber.StatusBadRequest, "column does not belong to the task board")
+	ErrAssigneeNotInBoard  = fiber.NewError(fiber.StatusBadRequest, "assignee is not a member of the board")
+	ErrTaskLabelIsRequired = fiber.NewError(fiber.StatusBadRequest, "label name is required")
+	ErrCommentNotInTask    = fiber.NewError(fiber.StatusNotFound, "comment not found on this task")
+)
+
 func NewTaskService(
 	repo ports.TaskRepo,
 	notifier ports.Notifier,
 	boardService *BoardService,
 	columnService *ColumnService,
 	taskCommentRepo ports.TaskCommentRepo,
+	labelRepo ports.LabelRepo,
+	authorizer ports.Authorizer,
 ) *TaskService {
 	return &TaskService{
 		repo:            repo,
@@ -31,22 +43,15 @@ func NewTaskService(
 		boardService:    boardService,
 		columnService:   columnService,
 		taskCommentRepo: taskCommentRepo,
+		labelRepo:       labelRepo,
+		authorizer:      authorizer,
 	}
 }
 
-func (s *TaskService) GetTasksByBoardID(ctx context.Context, userID uint, boardID uint, pageNumber uint, pageSize uint) ([]domains.Task, uint, error) {
-	//check permission
-	board, errFetchBoard := s.boardService.GetBoardByID(ctx, boardID)
-	if errFetchBoard != nil {
-		return nil, 0, errFetchBoard
-	}
-
-	if board.IsPrivate {
-		//check permissions -> only board members can see task
-		hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Viewer, userID, boardID)
-		if !hasAccess {
-			return nil, 0, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
-		}
+func (s *TaskService) GetTasksByBoardID(ctx context.Context, userID uint, boardID uint, filter domains.TaskFilter, pageNumber uint, pageSize uint) ([]domains.Task, uint, error) {
+	//check permissions -> only board members can see tasks of private boards
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskView); err != nil {
+		return nil, 0, err
 	}
 
 	//pagination calculate
@@ -54,7 +59,7 @@ func (s *TaskService) GetTasksByBoardID(ctx context.Context, userID uint, boardI
 	offset := (pageNumber - 1) * pageSize
 
 	//fetch tasks
-	tasks, total, errFetch := s.repo.GetListByBoardID(ctx, boardID, limit, offset)
+	tasks, total, errFetch := s.repo.GetListByBoardID(ctx, boardID, filter, limit, offset)
 	if errFetch != nil {
 		return nil, 0, errFetch
 	}
@@ -64,9 +69,8 @@ func (s *TaskService) GetTasksByBoardID(ctx context.Context, userID uint, boardI
 
 func (s *TaskService) CreateTask(ctx context.Context, task *domains.Task) (*domains.Task, error) {
 	//check permissions
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Maintainer, task.CreatedBy, task.BoardID)
-	if !hasAccess {
-		return nil, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, task.CreatedBy, task.BoardID, domains.ActionTaskCreate); err != nil {
+		return nil, err
 	}
 
 	//create task
@@ -75,6 +79,12 @@ func (s *TaskService) CreateTask(ctx context.Context, task *domains.Task) (*doma
 		return nil, errCreate
 	}
 
+	//record initial column for analytics
+	errTransition := s.recordColumnTransition(ctx, task.CreatedBy, task, nil, task.ColumnID)
+	if errTransition != nil {
+		return nil, errTransition
+	}
+
 	//load task
 	taskWithRelations, errFetch := s.repo.GetByID(ctx, task.ID)
 	if errFetch != nil {
@@ -98,32 +108,23 @@ func (s *TaskService) CreateTask(ctx context.Context, task *domains.Task) (*doma
 }
 
 func (s *TaskService) GetTaskByID(ctx context.Context, userID uint, boardID uint, id uint) (*domains.Task, error) {
-	task, errFetch := s.repo.GetByID(ctx, id)
-	if errFetch != nil {
-		return nil, errFetch
-	}
-
-	board, errFetchBoard := s.boardService.GetBoardByID(ctx, boardID)
-	if errFetchBoard != nil {
-		return nil, errFetchBoard
-	}
-	//check permission
-	if board.IsPrivate {
-		//check permissions -> only board members can see task
-		hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Viewer, userID, boardID)
-		if !hasAccess {
-			return nil, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
-		}
+	//check permissions -> only board members can see tasks of private boards
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskView); err != nil {
+		return nil, err
 	}
 
-	return task, nil
+	return s.getBoardTask(ctx, boardID, id)
 }
 
 func (s *TaskService) UpdateTask(ctx context.Context, userID uint, boardID uint, task *domains.Task) (*domains.Task, error) {
 	//check permissions
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Maintainer, userID, boardID)
-	if !hasAccess {
-		return nil, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskUpdate); err != nil {
+		return nil, err
+	}
+
+	existingTask, errFetchExisting := s.getBoardTask(ctx, boardID, task.ID)
+	if errFetchExisting != nil {
+		return nil, errFetchExisting
 	}
 
 	errUpdate := s.repo.Update(ctx, task)
@@ -131,6 +132,13 @@ func (s *TaskService) UpdateTask(ctx context.Context, userID uint, boardID uint,
 		return nil, errUpdate
 	}
 
+	if existingTask.ColumnID != task.ColumnID {
+		errTransition := s.recordColumnTransition(ctx, userID, existingTask, &existingTask.ColumnID, task.ColumnID)
+		if errTransition != nil {
+			return nil, errTransition
+		}
+	}
+
 	taskWithRelations, errFetch := s.repo.GetByID(ctx, task.ID)
 	if errFetch != nil {
 		return nil, errFetch
@@ -147,11 +155,10 @@ func (s *TaskService) DeleteTask(ctx context.Context, userID uint, id uint) erro
 	}
 
 	//check permissions
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Maintainer, userID, task.BoardID)
-	if !hasAccess {
-		return &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, task.BoardID, domains.ActionTaskDelete); err != nil {
+		return err
 	}
-	errDelete := s.repo.Delete(ctx, id)
+	errDelete := s.repo.Delete(ctx, id, userID)
 	if errDelete != nil {
 		return errDelete
 	}
@@ -160,9 +167,8 @@ func (s *TaskService) DeleteTask(ctx context.Context, userID uint, id uint) erro
 
 func (s *TaskService) ChangeTaskColumn(ctx context.Context, userID uint, task *domains.Task, newColumnID uint) (*domains.Task, error) {
 	//check permissions
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Editor, userID, task.BoardID)
-	if !hasAccess {
-		return nil, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, task.BoardID, domains.ActionTaskMove); err != nil {
+		return nil, err
 	}
 
 	//fetch task info
@@ -176,6 +182,10 @@ func (s *TaskService) ChangeTaskColumn(ctx context.Context, userID uint, task *d
 		return nil, errFetchColumn
 	}
 
+	if newColumn.BoardID != t.BoardID {
+		return nil, ErrColumnNotInBoard
+	}
+
 	//Check for children tasks
 	if newColumn.IsFinal {
 		childrenTasks, errFetchChildrenTasks := s.repo.GetTaskChildren(ctx, task.ID)
@@ -197,12 +207,20 @@ func (s *TaskService) ChangeTaskColumn(ctx context.Context, userID uint, task *d
 	}
 
 	//change column and update
+	oldColumnID := t.ColumnID
 	t.ColumnID = newColumnID
 	errUpdate := s.repo.Update(ctx, t)
 	if errUpdate != nil {
 		return nil, errUpdate
 	}
 
+	if oldColumnID != newColumnID {
+		errTransition := s.recordColumnTransition(ctx, userID, t, &oldColumnID, newColumnID)
+		if errTransition != nil {
+			return nil, errTransition
+		}
+	}
+
 	taskWithRelations, errFetch := s.repo.GetByID(ctx, task.ID)
 	if errFetch != nil {
 		return nil, errFetch
@@ -211,7 +229,109 @@ func (s *TaskService) ChangeTaskColumn(ctx context.Context, userID uint, task *d
 	return taskWithRelations, nil
 }
 
+func (s *TaskService) ArchiveTask(ctx context.Context, userID uint, boardID uint, id uint) error {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskArchive); err != nil {
+		return err
+	}
+
+	if _, err := s.getBoardTask(ctx, boardID, id); err != nil {
+		return err
+	}
+
+	return s.repo.Archive(ctx, id)
+}
+
+func (s *TaskService) UnarchiveTask(ctx context.Context, userID uint, boardID uint, id uint) error {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskArchive); err != nil {
+		return err
+	}
+
+	if _, err := s.getBoardTask(ctx, boardID, id); err != nil {
+		return err
+	}
+
+	return s.repo.Unarchive(ctx, id)
+}
+
+// AssignTask assigns a board task to a board member, directly or through a team.
+func (s *TaskService) AssignTask(ctx context.Context, userID uint, boardID uint, taskID uint, assigneeID uint) error {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskAssign); err != nil {
+		return err
+	}
+
+	role, err := s.authorizer.MemberRole(ctx, assigneeID, boardID)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return ErrAssigneeNotInBoard
+	}
+
+	if _, err := s.getBoardTask(ctx, boardID, taskID); err != nil {
+		return err
+	}
+
+	return s.repo.AssignUserToTask(ctx, taskID, assigneeID)
+}
+
+// AddLabelToTask labels a board task, creating the board label when it does not exist yet.
+func (s *TaskService) AddLabelToTask(ctx context.Context, userID uint, boardID uint, taskID uint, labelName string) error {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskLabel); err != nil {
+		return err
+	}
+
+	labelName = strings.TrimSpace(labelName)
+	if labelName == "" {
+		return ErrTaskLabelIsRequired
+	}
+
+	if _, err := s.getBoardTask(ctx, boardID, taskID); err != nil {
+		return err
+	}
+
+	label, err := s.labelRepo.GetOrCreate(ctx, boardID, labelName)
+	if err != nil {
+		return err
+	}
+
+	return s.labelRepo.AddToTask(ctx, taskID, label.ID)
+}
+
+func (s *TaskService) getBoardTask(ctx context.Context, boardID uint, id uint) (*domains.Task, error) {
+	task, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if task.BoardID != boardID {
+		return nil, ErrTaskNotInBoard
+	}
+	return task, nil
+}
+
+// recordColumnTransition stores a column move, used by sprint burndown and board analytics.
+func (s *TaskService) recordColumnTransition(ctx context.Context, userID uint, task *domains.Task, fromColumnID *uint, toColumnID uint) error {
+	return s.repo.AddColumnTransition(ctx, &domains.TaskColumnTransition{
+		TaskID:       task.ID,
+		BoardID:      task.BoardID,
+		UserID:       userID,
+		FromColumnID: fromColumnID,
+		ToColumnID:   toColumnID,
+	})
+}
+
 func (s *TaskService) GetTaskChildren(ctx context.Context, userID uint, boardID uint, taskID uint) ([]domains.TaskChild, error) {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskView); err != nil {
+		return nil, err
+	}
+	if _, err := s.getBoardTask(ctx, boardID, taskID); err != nil {
+		return nil, err
+	}
+
 	childrenTasks, errFetchChildrenTasks := s.repo.GetTaskChildren(ctx, taskID)
 
 	if errFetchChildrenTasks != nil {
@@ -221,7 +341,10 @@ func (s *TaskService) GetTaskChildren(ctx context.Context, userID uint, boardID
 	return childrenTasks, nil
 }
 
-func (s *TaskService) AddTaskDependency(ctx context.Context, taskID, dependentTaskID uint) error {
+func (s *TaskService) AddTaskDependency(ctx context.Context, userID, boardID, taskID, dependentTaskID uint) error {
+	if err := s.checkDependencyTasks(ctx, userID, boardID, taskID, dependentTaskID); err != nil {
+		return err
+	}
 
 	existingDependencies, err := s.repo.GetAllTaskDependencies(ctx)
 	if err != nil {
@@ -241,10 +364,21 @@ func (s *TaskService) AddTaskDependency(ctx context.Context, taskID, dependentTa
 
 	return s.repo.AddTaskDependency(ctx, taskID, dependentTaskID)
 }
-func (s *TaskService) RemoveTaskDependency(ctx context.Context, taskID, dependentTaskID uint) error {
+func (s *TaskService) RemoveTaskDependency(ctx context.Context, userID, boardID, taskID, dependentTaskID uint) error {
+	if err := s.checkDependencyTasks(ctx, userID, boardID, taskID, dependentTaskID); err != nil {
+		return err
+	}
 	return s.repo.RemoveTaskDependency(ctx, taskID, dependentTaskID)
 }
-func (s *TaskService) GetTaskDependencies(ctx context.Context, taskID uint) ([]domains.TaskDependency, error) {
+func (s *TaskService) GetTaskDependencies(ctx context.Context, userID, boardID, taskID uint) ([]domains.TaskDependency, error) {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskView); err != nil {
+		return nil, err
+	}
+	if _, err := s.getBoardTask(ctx, boardID, taskID); err != nil {
+		return nil, err
+	}
+
 	taskDependencies, err := s.repo.GetTaskDependencies(ctx, taskID)
 	if err != nil {
 		return nil, err
@@ -252,6 +386,20 @@ func (s *TaskService) GetTaskDependencies(ctx context.Context, taskID uint) ([]d
 	return taskDependencies, nil
 }
 
+// checkDependencyTasks checks that the user can manage dependencies on the
+// board and that both tasks belong to it.
+func (s *TaskService) checkDependencyTasks(ctx context.Context, userID, boardID, taskID, dependentTaskID uint) error {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTaskManageDependency); err != nil {
+		return err
+	}
+	for _, id := range []uint{taskID, dependentTaskID} {
+		if _, err := s.getBoardTask(ctx, boardID, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // hasCycle checks if adding tid -> dtid would create a cycle in the graph
 func hasCycle(graph map[uint][]uint, tid, dtid uint) bool {
 	visited := make(map[uint]bool)
@@ -278,9 +426,11 @@ func isReachable(graph map[uint][]uint, visited map[uint]bool, current, target u
 
 func (s *TaskService) CreateComment(ctx context.Context, userID uint, boardID uint, taskComment *domains.TaskComment) (*domains.TaskComment, error) {
 	//check permissions
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Editor, userID, boardID)
-	if !hasAccess {
-		return nil, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionCommentCreate); err != nil {
+		return nil, err
+	}
+	if _, err := s.getBoardTask(ctx, boardID, taskComment.TaskID); err != nil {
+		return nil, err
 	}
 
 	//create task comment
@@ -300,9 +450,11 @@ func (s *TaskService) CreateComment(ctx context.Context, userID uint, boardID ui
 
 func (s *TaskService) GetTaskComments(ctx context.Context, userID uint, boardID uint, taskID uint, pageNumber uint, pageSize uint) ([]domains.TaskComment, uint, error) {
 	//check permissions
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Viewer, userID, boardID)
-	if !hasAccess {
-		return nil, 0, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionCommentView); err != nil {
+		return nil, 0, err
+	}
+	if _, err := s.getBoardTask(ctx, boardID, taskID); err != nil {
+		return nil, 0, err
 	}
 
 	//pagination calculate
@@ -320,25 +472,20 @@ func (s *TaskService) GetTaskComments(ctx context.Context, userID uint, boardID
 
 func (s *TaskService) GetTaskComment(ctx context.Context, userID uint, boardID uint, taskID uint, commentID string) (*domains.TaskComment, error) {
 	//check permissions
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Viewer, userID, boardID)
-	if !hasAccess {
-		return nil, &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
-	}
-
-	//create task comment
-	comment, errFetch := s.taskCommentRepo.GetByID(ctx, commentID)
-	if errFetch != nil {
-		return nil, errFetch
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionCommentView); err != nil {
+		return nil, err
 	}
 
-	return comment, nil
+	return s.getTaskComment(ctx, boardID, taskID, commentID)
 }
 
 func (s *TaskService) DeleteComment(ctx context.Context, userID uint, boardID uint, taskID uint, id string) error {
 	//check permissions
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Maintainer, userID, boardID)
-	if !hasAccess {
-		return &fiber.Error{Code: fiber.StatusForbidden, Message: "Access denied"}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionCommentDelete); err != nil {
+		return err
+	}
+	if _, err := s.getTaskComment(ctx, boardID, taskID, id); err != nil {
+		return err
 	}
 	errDelete := s.taskCommentRepo.Delete(ctx, id)
 	if errDelete != nil {
@@ -347,6 +494,20 @@ func (s *TaskService) DeleteComment(ctx context.Context, userID uint, boardID ui
 	return nil
 }
 
+func (s *TaskService) getTaskComment(ctx context.Context, boardID uint, taskID uint, id string) (*domains.TaskComment, error) {
+	if _, err := s.getBoardTask(ctx, boardID, taskID); err != nil {
+		return nil, err
+	}
+	comment, err := s.taskCommentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.TaskID != taskID {
+		return nil, ErrCommentNotInTask
+	}
+	return comment, nil
+}
+
 func (s *TaskService) AssignUserToTask(ctx context.Context, userID uint, taskID uint) error {
 	// Fetch task by ID
 	task, err := s.repo.GetByID(ctx, taskID)
@@ -355,9 +516,8 @@ func (s *TaskService) AssignUserToTask(ctx context.Context, userID uint, taskID
 	}
 
 	// Check permission to assign user
-	hasAccess, _ := s.boardService.HasRequiredBoardAccess(ctx, domains.Editor, userID, task.BoardID)
-	if !hasAccess {
-		return fmt.Errorf("access denied")
+	if err = s.authorizer.Authorize(ctx, userID, task.BoardID, domains.ActionTaskAssign); err != nil {
+		return err
 	}
 
 	err = s.repo.AssignUserToTask(ctx, taskID, userID)
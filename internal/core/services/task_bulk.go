@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
+	"github.com/gofiber/fiber/v2"
+)
+
+const bulkTaskMaxItems = 200
+
+var (
+	ErrBulkTaskListIsEmpty    = fiber.NewError(fiber.StatusBadRequest, "no task ids provided")
+	ErrBulkTaskListIsTooLong  = fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("a bulk operation can change at most %d tasks", bulkTaskMaxItems))
+	ErrBulkTaskUnknownType    = fiber.NewError(fiber.StatusBadRequest, "unknown bulk operation")
+	ErrBulkTaskMissingValue   = fiber.NewError(fiber.StatusBadRequest, "bulk operation value is missing")
+	ErrBulkTaskAtomicFailed   = fiber.NewError(fiber.StatusUnprocessableEntity, "bulk operation failed, no task was changed")
+	ErrBulkTaskNotAttempted   = fiber.NewError(fiber.StatusConflict, "not attempted, an earlier task failed")
+	bulkTaskOperationRequires = map[domains.BulkTaskOperationType]domains.BoardAction{
+		domains.BulkTaskMove:           domains.ActionTaskMove,
+		domains.BulkTaskAssign:         domains.ActionTaskAssign,
+		domains.BulkTaskAddLabel:       domains.ActionTaskLabel,
+		domains.BulkTaskSetStoryPoints: domains.ActionTaskUpdate,
+		domains.BulkTaskDelete:         domains.ActionTaskDelete,
+		domains.BulkTaskArchive:        domains.ActionTaskArchive,
+	}
+)
+
+// BulkTaskOperation applies an operation to many tasks of a board. Every task goes
+// through the same checks as the single task endpoints.
+//
+// The caller is expected to run it in a request transaction. In atomic mode it returns
+// ErrBulkTaskAtomicFailed on the first failure so the transaction can be rolled back,
+// otherwise each task runs in its own save point and only failed tasks are undone.
+func (s *TaskService) BulkTaskOperation(ctx context.Context, userID uint, boardID uint, op domains.BulkTaskOperation) ([]domains.BulkTaskResult, error) {
+	action, err := validateBulkTaskOperation(op)
+	if err != nil {
+		return nil, err
+	}
+
+	//check permissions once before touching any task
+	if err = s.authorizer.Authorize(ctx, userID, boardID, action); err != nil {
+		return nil, err
+	}
+
+	savePointer, _ := valuecontext.TryGetSavePointerFromContext(ctx)
+
+	taskIDs := uniqueIDs(op.TaskIDs)
+	results := make([]domains.BulkTaskResult, 0, len(taskIDs))
+	succeeded := 0
+
+	for i, taskID := range taskIDs {
+		savePoint := fmt.Sprintf("bulk_task_%d", i)
+		if !op.Atomic && savePointer != nil {
+			if err = savePointer.SavePoint(savePoint); err != nil {
+				return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+		}
+
+		errItem := s.applyBulkTaskOperation(ctx, userID, boardID, taskID, op)
+		if errItem == nil {
+			succeeded++
+			results = append(results, domains.BulkTaskResult{TaskID: taskID})
+			continue
+		}
+
+		results = append(results, domains.BulkTaskResult{TaskID: taskID, Err: errItem})
+
+		if op.Atomic {
+			for _, skippedID := range taskIDs[i+1:] {
+				results = append(results, domains.BulkTaskResult{TaskID: skippedID, Err: ErrBulkTaskNotAttempted})
+			}
+			return results, ErrBulkTaskAtomicFailed
+		}
+
+		if savePointer != nil {
+			if err = savePointer.RollbackTo(savePoint); err != nil {
+				return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+			}
+		}
+	}
+
+	//notify the assignee once instead of once per task
+	if op.Type == domains.BulkTaskAssign && succeeded > 0 {
+		input := ports.NotificationInput{
+			Type:    ports.NewTaskAssignedNotification,
+			Message: fmt.Sprintf("You have been assigned to %d new tasks.", succeeded),
+		}
+		if err = s.notifier.SendInAppNotification(ctx, op.AssigneeID, input); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func (s *TaskService) applyBulkTaskOperation(ctx context.Context, userID uint, boardID uint, taskID uint, op domains.BulkTaskOperation) error {
+	switch op.Type {
+	case domains.BulkTaskMove:
+		task, err := s.getBoardTask(ctx, boardID, taskID)
+		if err != nil {
+			return err
+		}
+		_, err = s.ChangeTaskColumn(ctx, userID, task, op.ColumnID)
+		return err
+
+	case domains.BulkTaskAssign:
+		return s.AssignTask(ctx, userID, boardID, taskID, op.AssigneeID)
+
+	case domains.BulkTaskSetStoryPoints:
+		task, err := s.getBoardTask(ctx, boardID, taskID)
+		if err != nil {
+			return err
+		}
+		task.StoryPoint = op.StoryPoint
+		_, err = s.UpdateTask(ctx, userID, boardID, task)
+		return err
+
+	case domains.BulkTaskAddLabel:
+		return s.AddLabelToTask(ctx, userID, boardID, taskID, op.Label)
+
+	case domains.BulkTaskDelete:
+		if _, err := s.getBoardTask(ctx, boardID, taskID); err != nil {
+			return err
+		}
+		return s.DeleteTask(ctx, userID, taskID)
+
+	case domains.BulkTaskArchive:
+		return s.ArchiveTask(ctx, userID, boardID, taskID)
+	}
+
+	return ErrBulkTaskUnknownType
+}
+
+func validateBulkTaskOperation(op domains.BulkTaskOperation) (domains.BoardAction, error) {
+	action, ok := bulkTaskOperationRequires[op.Type]
+	if !ok {
+		return "", ErrBulkTaskUnknownType
+	}
+
+	if len(op.TaskIDs) == 0 {
+		return "", ErrBulkTaskListIsEmpty
+	}
+
+	if len(op.TaskIDs) > bulkTaskMaxItems {
+		return "", ErrBulkTaskListIsTooLong
+	}
+
+	switch {
+	case op.Type == domains.BulkTaskMove && op.ColumnID == 0,
+		op.Type == domains.BulkTaskAssign && op.AssigneeID == 0,
+		op.Type == domains.BulkTaskAddLabel && op.Label == "",
+		op.Type == domains.BulkTaskSetStoryPoints && op.StoryPoint < 0:
+		return "", ErrBulkTaskMissingValue
+	}
+
+	return action, nil
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/valuecontext"
+)
+
+var errArchiveFailed = errors.New("archive failed")
+
+// every task is on the private board, archiving a task listed in failing fails
+type bulkTaskRepo struct {
+	fakeTaskRepo
+	failing  map[uint]bool
+	archived *[]uint
+}
+
+func (bulkTaskRepo) GetByID(_ context.Context, id uint) (*domains.Task, error) {
+	return &domains.Task{ID: id, BoardID: privateBoardID}, nil
+}
+
+func (r bulkTaskRepo) Archive(_ context.Context, id uint) error {
+	if r.failing[id] {
+		return errArchiveFailed
+	}
+	*r.archived = append(*r.archived, id)
+	return nil
+}
+
+// savePointCommitter records the save points a service sets and rolls back to.
+type savePointCommitter struct {
+	calls []string
+}
+
+func (c *savePointCommitter) Begin() valuecontext.Committer { return c }
+
+func (c *savePointCommitter) Commit() error { return nil }
+
+func (c *savePointCommitter) Rollback() error { return nil }
+
+func (c *savePointCommitter) Tx() any { return nil }
+
+func (c *savePointCommitter) SavePoint(name string) error {
+	c.calls = append(c.calls, "savepoint "+name)
+	return nil
+}
+
+func (c *savePointCommitter) RollbackTo(name string) error {
+	c.calls = append(c.calls, "rollback "+name)
+	return nil
+}
+
+func newBulkTaskService(failing map[uint]bool, archived *[]uint) *TaskService {
+	authorizer := NewBoardAuthorizer(fakeBoardRepo{}, fakeBoardMemberRepo{}, fakeRoleRepo{}, nil, fakeBoardTeamRepo{})
+	repo := bulkTaskRepo{failing: failing, archived: archived}
+	return NewTaskService(repo, nil, nil, nil, nil, nil, authorizer)
+}
+
+func TestBulkTaskOperationAtomic(t *testing.T) {
+	var archived []uint
+	service := newBulkTaskService(map[uint]bool{11: true}, &archived)
+	committer := &savePointCommitter{}
+	ctx := valuecontext.NewValueContext(context.Background(), &valuecontext.ContextValue{Tx: committer})
+
+	op := domains.BulkTaskOperation{Type: domains.BulkTaskArchive, TaskIDs: []uint{10, 11, 12, 10}, Atomic: true}
+	results, err := service.BulkTaskOperation(ctx, ownerID, privateBoardID, op)
+	if !errors.Is(err, ErrBulkTaskAtomicFailed) {
+		t.Fatalf("expected %v, got %v", ErrBulkTaskAtomicFailed, err)
+	}
+
+	expected := []domains.BulkTaskResult{
+		{TaskID: 10},
+		{TaskID: 11, Err: errArchiveFailed},
+		{TaskID: 12, Err: ErrBulkTaskNotAttempted},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("expected results %v, got %v", expected, results)
+	}
+	if !reflect.DeepEqual(archived, []uint{10}) {
+		t.Errorf("expected only task 10 to be archived before the failure, got %v", archived)
+	}
+	if len(committer.calls) != 0 {
+		t.Errorf("atomic mode must leave the rollback to the transaction, got %v", committer.calls)
+	}
+}
+
+func TestBulkTaskOperationBestEffort(t *testing.T) {
+	var archived []uint
+	service := newBulkTaskService(map[uint]bool{11: true}, &archived)
+	committer := &savePointCommitter{}
+	ctx := valuecontext.NewValueContext(context.Background(), &valuecontext.ContextValue{Tx: committer})
+
+	op := domains.BulkTaskOperation{Type: domains.BulkTaskArchive, TaskIDs: []uint{10, 11, 12}}
+	results, err := service.BulkTaskOperation(ctx, ownerID, privateBoardID, op)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []domains.BulkTaskResult{
+		{TaskID: 10},
+		{TaskID: 11, Err: errArchiveFailed},
+		{TaskID: 12},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("expected results %v, got %v", expected, results)
+	}
+	if !reflect.DeepEqual(archived, []uint{10, 12}) {
+		t.Errorf("expected tasks 10 and 12 to be archived, got %v", archived)
+	}
+
+	calls := []string{
+		"savepoint bulk_task_0",
+		"savepoint bulk_task_1",
+		"rollback bulk_task_1",
+		"savepoint bulk_task_2",
+	}
+	if !reflect.DeepEqual(committer.calls, calls) {
+		t.Errorf("expected save point calls %v, got %v", calls, committer.calls)
+	}
+}
+
+func TestBulkTaskOperationChecksPermissionOnce(t *testing.T) {
+	var archived []uint
+	service := newBulkTaskService(nil, &archived)
+
+	op := domains.BulkTaskOperation{Type: domains.BulkTaskArchive, TaskIDs: []uint{10, 11}}
+	if _, err := service.BulkTaskOperation(context.Background(), viewerID, privateBoardID, op); err == nil {
+		t.Fatal("expected a viewer to be denied")
+	}
+	if len(archived) != 0 {
+		t.Errorf("expected no task to be archived, got %v", archived)
+	}
+}
@@ -0,0 +1,304 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrUserIsAlreadyTeamMember = fiber.NewError(fiber.StatusBadRequest, "user is already a team member")
+	ErrTeamIsAlreadyOnBoard    = fiber.NewError(fiber.StatusBadRequest, "team already has a role on the board")
+	ErrTeamNotInBoardWorkspace = fiber.NewError(fiber.StatusBadRequest, "team is not in the workspace of the board")
+)
+
+// TeamService manages the teams of a workspace and the roles they hold on
+// boards. Workspace admins manage the teams, members of a team hold the
+// permissions of the team roles on top of their own board role.
+type TeamService struct {
+	teamRepo            ports.TeamRepo
+	teamMemberRepo      ports.TeamMemberRepo
+	workspaceMemberRepo ports.WorkspaceMemberRepo
+	boardRepo           ports.BoardRepo
+	boardTeamRepo       ports.BoardTeamRepo
+	roleRepo            ports.RoleRepository
+	userRepo            ports.UserRepo
+	authorizer          ports.Authorizer
+	auditService        *AuditService
+	notifier            ports.Notifier
+}
+
+func NewTeamService(teamRepo ports.TeamRepo, teamMemberRepo ports.TeamMemberRepo, workspaceMemberRepo ports.WorkspaceMemberRepo,
+	boardRepo ports.BoardRepo, boardTeamRepo ports.BoardTeamRepo, roleRepo ports.RoleRepository, userRepo ports.UserRepo,
+	authorizer ports.Authorizer, auditService *AuditService, notifier ports.Notifier) *TeamService {
+	return &TeamService{
+		teamRepo:            teamRepo,
+		teamMemberRepo:      teamMemberRepo,
+		workspaceMemberRepo: workspaceMemberRepo,
+		boardRepo:           boardRepo,
+		boardTeamRepo:       boardTeamRepo,
+		roleRepo:            roleRepo,
+		userRepo:            userRepo,
+		authorizer:          authorizer,
+		auditService:        auditService,
+		notifier:            notifier,
+	}
+}
+
+func (s *TeamService) CreateTeam(ctx context.Context, team *domains.Team) error {
+	if err := s.requireWorkspaceRole(ctx, team.WorkspaceID, team.CreatedBy, domains.WorkspaceRoleAdmin); err != nil {
+		return err
+	}
+	return s.teamRepo.Create(ctx, team)
+}
+
+func (s *TeamService) GetWorkspaceTeams(ctx context.Context, userID, workspaceID uint) ([]domains.Team, error) {
+	if err := s.requireWorkspaceRole(ctx, workspaceID, userID, domains.WorkspaceRoleMember); err != nil {
+		return nil, err
+	}
+	return s.teamRepo.GetByWorkspaceID(ctx, workspaceID)
+}
+
+func (s *TeamService) GetTeam(ctx context.Context, userID, id uint) (*domains.Team, error) {
+	return s.getTeam(ctx, userID, id, domains.WorkspaceRoleMember)
+}
+
+func (s *TeamService) UpdateTeam(ctx context.Context, userID uint, team *domains.Team) error {
+	if _, err := s.getTeam(ctx, userID, team.ID, domains.WorkspaceRoleAdmin); err != nil {
+		return err
+	}
+	return s.teamRepo.Update(ctx, team)
+}
+
+// DeleteTeam deletes the team, its members lose the board roles of the team.
+func (s *TeamService) DeleteTeam(ctx context.Context, userID, id uint) error {
+	if _, err := s.getTeam(ctx, userID, id, domains.WorkspaceRoleAdmin); err != nil {
+		return err
+	}
+	return s.teamRepo.Delete(ctx, id)
+}
+
+func (s *TeamService) GetTeamMembers(ctx context.Context, userID, id uint) ([]domains.TeamMember, error) {
+	if _, err := s.getTeam(ctx, userID, id, domains.WorkspaceRoleMember); err != nil {
+		return nil, err
+	}
+	return s.teamMemberRepo.GetMembers(ctx, id)
+}
+
+// AddTeamMember adds a member of the workspace to the team.
+func (s *TeamService) AddTeamMember(ctx context.Context, actorID, id, userID uint) (*domains.TeamMember, error) {
+	team, err := s.getTeam(ctx, actorID, id, domains.WorkspaceRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.workspaceMemberRepo.GetMember(ctx, team.WorkspaceID, userID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrNotWorkspaceMember
+		}
+		return nil, err
+	}
+
+	_, err = s.teamMemberRepo.GetMember(ctx, id, userID)
+	if err == nil {
+		return nil, ErrUserIsAlreadyTeamMember
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	member := &domains.TeamMember{TeamID: id, UserID: userID, User: user}
+	if err = s.teamMemberRepo.Create(ctx, member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RemoveTeamMember removes a member from the team, members can always leave
+// themselves.
+func (s *TeamService) RemoveTeamMember(ctx context.Context, actorID, id, userID uint) error {
+	required := domains.WorkspaceRoleAdmin
+	if actorID == userID {
+		required = domains.WorkspaceRoleGuest
+	}
+	if _, err := s.getTeam(ctx, actorID, id, required); err != nil {
+		return err
+	}
+	member, err := s.teamMemberRepo.GetMember(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	return s.teamMemberRepo.Delete(ctx, member.ID)
+}
+
+// NotifyTeam sends the message to every member of the team. Failing members
+// are logged so the others are still notified.
+func (s *TeamService) NotifyTeam(ctx context.Context, userID, id uint, message string) error {
+	if _, err := s.getTeam(ctx, userID, id, domains.WorkspaceRoleMember); err != nil {
+		return err
+	}
+	return s.notifyMembers(ctx, id, message)
+}
+
+// GetBoardTeams lists the teams holding a role on the board.
+func (s *TeamService) GetBoardTeams(ctx context.Context, userID, boardID uint) ([]domains.BoardTeam, error) {
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionBoardView); err != nil {
+		return nil, err
+	}
+	return s.boardTeamRepo.GetByBoardID(ctx, boardID)
+}
+
+// GrantBoardRole gives every member of the team the role on the board, like
+// inviting each of them. Only teams of the workspace of the board can be added.
+func (s *TeamService) GrantBoardRole(ctx context.Context, actorID, boardID, teamID uint, roleName string) (*domains.BoardTeam, error) {
+	role, err := s.roleRepo.GetBoardRoleByName(ctx, boardID, roleName)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.authorizer.AuthorizeRole(ctx, actorID, boardID, domains.ActionBoardMemberInvite, role); err != nil {
+		return nil, err
+	}
+	board, err := s.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if board.WorkspaceID == nil || *board.WorkspaceID != team.WorkspaceID {
+		return nil, ErrTeamNotInBoardWorkspace
+	}
+
+	_, err = s.boardTeamRepo.GetBoardTeam(ctx, boardID, teamID)
+	if err == nil {
+		return nil, ErrTeamIsAlreadyOnBoard
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	boardTeam := &domains.BoardTeam{BoardID: boardID, TeamID: teamID, RoleID: role.ID, Team: team, Role: role}
+	if err = s.boardTeamRepo.Create(ctx, boardTeam); err != nil {
+		return nil, err
+	}
+	if err = s.recordBoardTeamChange(ctx, domains.AuditBoardTeamAdded, actorID, boardID, teamID, role.Name); err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Your team %s was added to the board %s as %s", team.Name, board.Name, role.Name)
+	if err = s.notifyMembers(ctx, teamID, message); err != nil {
+		// the team was added already
+		log.ErrorLog.Printf("Error notifying team %d of board %d: %v\n", teamID, boardID, err)
+	}
+	return boardTeam, nil
+}
+
+// ChangeBoardTeamRole changes the role of the team on the board, members need
+// every permission of both the current and the new role of the team.
+func (s *TeamService) ChangeBoardTeamRole(ctx context.Context, actorID, boardID, teamID uint, roleName string) error {
+	if err := s.authorizer.Authorize(ctx, actorID, boardID, domains.ActionBoardMemberChangeRole); err != nil {
+		return err
+	}
+	boardTeam, err := s.boardTeamRepo.GetBoardTeam(ctx, boardID, teamID)
+	if err != nil {
+		return err
+	}
+	role, err := s.roleRepo.GetBoardRoleByName(ctx, boardID, roleName)
+	if err != nil {
+		return err
+	}
+	if err = s.authorizer.AuthorizeRole(ctx, actorID, boardID, domains.ActionBoardMemberChangeRole, boardTeam.Role, role); err != nil {
+		return err
+	}
+	if err = s.boardTeamRepo.UpdateRole(ctx, boardTeam.ID, role.ID); err != nil {
+		return err
+	}
+	return s.recordBoardTeamChange(ctx, domains.AuditBoardTeamRoleChanged, actorID, boardID, teamID, role.Name)
+}
+
+// RevokeBoardRole takes the role of the team on the board away, its members
+// keep their own board roles.
+func (s *TeamService) RevokeBoardRole(ctx context.Context, actorID, boardID, teamID uint) error {
+	if err := s.authorizer.Authorize(ctx, actorID, boardID, domains.ActionBoardMemberRemove); err != nil {
+		return err
+	}
+	boardTeam, err := s.boardTeamRepo.GetBoardTeam(ctx, boardID, teamID)
+	if err != nil {
+		return err
+	}
+	if err = s.authorizer.AuthorizeRole(ctx, actorID, boardID, domains.ActionBoardMemberRemove, boardTeam.Role); err != nil {
+		return err
+	}
+	if err = s.boardTeamRepo.Delete(ctx, boardTeam.ID); err != nil {
+		return err
+	}
+	return s.recordBoardTeamChange(ctx, domains.AuditBoardTeamRemoved, actorID, boardID, teamID, "")
+}
+
+// recordBoardTeamChange adds a change of the role of a team on a board to the
+// audit log, with the role the team holds after the change.
+func (s *TeamService) recordBoardTeamChange(ctx context.Context, action domains.AuditAction, actorID, boardID, teamID uint, roleName string) error {
+	details := map[string]string{"team_id": strconv.FormatUint(uint64(teamID), 10)}
+	if roleName != "" {
+		details["role"] = roleName
+	}
+	return s.auditService.Record(ctx, domains.AuditLog{
+		Action:  action,
+		ActorID: auditUser(actorID),
+		BoardID: auditUser(boardID),
+		Details: details,
+	})
+}
+
+func (s *TeamService) notifyMembers(ctx context.Context, id uint, message string) error {
+	members, err := s.teamMemberRepo.GetMembers(ctx, id)
+	if err != nil {
+		return err
+	}
+	input := ports.NotificationInput{
+		Type:    ports.TeamNotification,
+		Message: message,
+	}
+	for _, member := range members {
+		if err = s.notifier.SendInAppNotification(ctx, member.UserID, input); err != nil {
+			log.ErrorLog.Printf("Error notifying user %d of team %d: %v\n", member.UserID, id, err)
+		}
+	}
+	return nil
+}
+
+// getTeam returns the team when the user holds at least the role in its workspace.
+func (s *TeamService) getTeam(ctx context.Context, userID, id uint, role domains.WorkspaceRole) (*domains.Team, error) {
+	team, err := s.teamRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.requireWorkspaceRole(ctx, team.WorkspaceID, userID, role); err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+func (s *TeamService) requireWorkspaceRole(ctx context.Context, workspaceID, userID uint, role domains.WorkspaceRole) error {
+	member, err := s.workspaceMemberRepo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		if isNotFound(err) {
+			return ErrNotWorkspaceMember
+		}
+		return err
+	}
+	if !member.Role.AtLeast(role) {
+		return ErrAccessDenied
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrRestoreColumnFirst = fiber.NewError(fiber.StatusBadRequest, "the task column is deleted, restore the column first")
+	ErrTrashItemNotFound  = fiber.NewError(fiber.StatusNotFound, "item not found in board trash")
+)
+
+type TrashService struct {
+	taskRepo   ports.TaskRepo
+	columnRepo ports.ColumnRepo
+	boardRepo  ports.BoardRepo
+	authorizer ports.Authorizer
+}
+
+func NewTrashService(taskRepo ports.TaskRepo, columnRepo ports.ColumnRepo, boardRepo ports.BoardRepo, authorizer ports.Authorizer) *TrashService {
+	return &TrashService{
+		taskRepo:   taskRepo,
+		columnRepo: columnRepo,
+		boardRepo:  boardRepo,
+		authorizer: authorizer,
+	}
+}
+
+// GetBoardTrash lists the deleted tasks and columns of a board, newest first.
+func (s *TrashService) GetBoardTrash(ctx context.Context, userID uint, boardID uint) ([]domains.TrashItem, error) {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTrashView); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepo.GetDeletedListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := s.columnRepo.GetDeletedListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := append(columns, tasks...)
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+	return items, nil
+}
+
+func (s *TrashService) RestoreTask(ctx context.Context, userID uint, boardID uint, id uint) error {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTrashRestore); err != nil {
+		return err
+	}
+
+	task, err := s.taskRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if task.BoardID != boardID {
+		return ErrTrashItemNotFound
+	}
+
+	if _, err := s.columnRepo.GetByID(ctx, task.ColumnID); err != nil {
+		if isNotFound(err) {
+			return ErrRestoreColumnFirst
+		}
+		return err
+	}
+
+	// a parent deleted in the meantime is dropped instead of blocking the restore
+	clearParent := false
+	if task.ParentID != nil {
+		if _, err := s.taskRepo.GetByID(ctx, *task.ParentID); err != nil {
+			if !isNotFound(err) {
+				return err
+			}
+			clearParent = true
+		}
+	}
+
+	return s.taskRepo.Restore(ctx, id, clearParent)
+}
+
+func (s *TrashService) RestoreColumn(ctx context.Context, userID uint, boardID uint, id uint) error {
+	//check permissions
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionTrashRestore); err != nil {
+		return err
+	}
+
+	column, err := s.columnRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if column.BoardID != boardID {
+		return ErrTrashItemNotFound
+	}
+
+	return s.columnRepo.Restore(ctx, id)
+}
+
+// RestoreBoard restores a deleted board. Memberships are deleted along with
+// the board and only its owner can delete it, so the owner who deleted the
+// board is the one allowed to restore it.
+func (s *TrashService) RestoreBoard(ctx context.Context, userID uint, boardID uint) error {
+	board, err := s.boardRepo.GetDeletedByID(ctx, boardID)
+	if err != nil {
+		return err
+	}
+
+	//check permissions
+	if board.DeletedBy == nil || *board.DeletedBy != userID {
+		return ErrAccessDenied
+	}
+
+	return s.boardRepo.Restore(ctx, boardID)
+}
+
+func isNotFound(err error) bool {
+	var fiberErr *fiber.Error
+	return errors.As(err, &fiberErr) && fiberErr.Code == fiber.StatusNotFound
+}
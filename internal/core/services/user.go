@@ -2,24 +2,212 @@ package services
 
 import (
 	"context"
+	"strings"
+
 	user_model "github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
 	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/log"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrWrongPassword     = fiber.NewError(fiber.StatusBadRequest, "current password is incorrect")
+	ErrEmailAlreadyInUse = fiber.NewError(fiber.StatusBadRequest, "email is already in use")
+	ErrReassignToSelf    = fiber.NewError(fiber.StatusBadRequest, "tasks cannot be reassigned to the deleted user")
+	ErrPasswordUnchanged = fiber.NewError(fiber.StatusBadRequest, "new password must be different from the current one")
 )
 
+// ProfileUpdate holds the profile fields to change, nil fields are left as they
+// are. Changing the email needs the current password.
+type ProfileUpdate struct {
+	Name      *string
+	Email     *string
+	AvatarURL *string
+	Timezone  *string
+	Locale    *string
+	// CurrentPassword confirms an email change
+	CurrentPassword string
+}
+
 type UserService struct {
-	repo ports.UserRepo
+	repo           ports.UserRepo
+	taskRepo       ports.TaskRepo
+	boardService   *BoardService
+	authService    *AuthService
+	accountService *AccountService
+	hasher         *password.Hasher
+	passwordPolicy user_model.PasswordPolicy
 }
 
-func NewUserService(repo ports.UserRepo) *UserService {
+func NewUserService(repo ports.UserRepo, taskRepo ports.TaskRepo, boardService *BoardService, authService *AuthService,
+	accountService *AccountService, hasher *password.Hasher, passwordPolicy user_model.PasswordPolicy) *UserService {
 	return &UserService{
-		repo: repo,
+		repo:           repo,
+		taskRepo:       taskRepo,
+		boardService:   boardService,
+		authService:    authService,
+		accountService: accountService,
+		hasher:         hasher,
+		passwordPolicy: passwordPolicy,
 	}
 }
 
 func (s *UserService) CreateUser(ctx context.Context, user *user_model.User) error {
+	if err := user.ValidatePassword(s.passwordPolicy); err != nil {
+		return err
+	}
+
+	hash, err := s.hasher.Hash(user.Password)
+	if err != nil {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	user.Password = hash
+
+	if user.Role == 0 {
+		user.Role = user_model.UserRoleUser
+	}
+
 	return s.repo.Create(ctx, user)
 }
 
 func (s *UserService) GetUserByID(ctx context.Context, id uint) (*user_model.User, error) {
 	return s.repo.GetByID(ctx, id)
 }
+
+// UpdateProfile changes the profile of the user. A new email address has to
+// be verified again, so a verification link is mailed to it and the old
+// address is told about the change.
+func (s *UserService) UpdateProfile(ctx context.Context, userID uint, update ProfileUpdate) (*user_model.User, error) {
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	oldEmail := user.Email
+	emailChanged := false
+	if update.Email != nil && !strings.EqualFold(*update.Email, user.Email) {
+		if err := checkCurrentPassword(user, update.CurrentPassword); err != nil {
+			return nil, err
+		}
+		existing, err := s.repo.GetByEmail(ctx, *update.Email)
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+		if existing != nil && existing.ID != user.ID {
+			return nil, ErrEmailAlreadyInUse
+		}
+		user.Email = *update.Email
+		user.EmailVerifiedAt = nil
+		emailChanged = true
+	}
+	if update.Name != nil {
+		user.Name = *update.Name
+	}
+	if update.AvatarURL != nil {
+		user.AvatarURL = *update.AvatarURL
+	}
+	if update.Timezone != nil {
+		user.Timezone = *update.Timezone
+	}
+	if update.Locale != nil {
+		user.Locale = *update.Locale
+	}
+
+	if err := s.repo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if emailChanged {
+		// links mailed to the old address must not verify or reset the new one
+		if err := s.accountService.RevokeTokens(ctx, user.ID); err != nil {
+			return nil, err
+		}
+		if err := s.accountService.SendVerificationEmail(ctx, user); err != nil {
+			// the change is saved already, the user can ask for a new link
+			log.ErrorLog.Printf("Error sending verification email to user %d: %v\n", user.ID, err)
+		}
+		if err := s.accountService.SendEmailChangedNotice(user, oldEmail); err != nil {
+			log.ErrorLog.Printf("Error notifying the old email of user %d: %v\n", user.ID, err)
+		}
+	}
+
+	return user, nil
+}
+
+// ChangePassword sets a new password after checking the current one and logs
+// the user out of all sessions. Users without a password set their first one.
+func (s *UserService) ChangePassword(ctx context.Context, userID uint, currentPassword string, newPassword string) error {
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := checkCurrentPassword(user, currentPassword); err != nil {
+		return err
+	}
+	if user.HasPassword() && currentPassword == newPassword {
+		return ErrPasswordUnchanged
+	}
+
+	user.Password = newPassword
+	if err := user.ValidatePassword(s.passwordPolicy); err != nil {
+		return err
+	}
+
+	hash, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		return &fiber.Error{Code: fiber.StatusInternalServerError, Message: err.Error()}
+	}
+	if err := s.repo.UpdatePassword(ctx, userID, hash); err != nil {
+		return err
+	}
+
+	return s.authService.RevokeUserSessions(ctx, userID)
+}
+
+// DeleteAccount anonymizes the user. Their tasks are reassigned to reassignTo
+// on the boards that user is a member of and left unassigned elsewhere, and
+// their boards are handed over as BoardService.HandOverBoards describes.
+func (s *UserService) DeleteAccount(ctx context.Context, userID uint, currentPassword string, reassignTo *uint) error {
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := checkCurrentPassword(user, currentPassword); err != nil {
+		return err
+	}
+
+	if reassignTo != nil {
+		if *reassignTo == userID {
+			return ErrReassignToSelf
+		}
+		if _, err := s.repo.GetByID(ctx, *reassignTo); err != nil {
+			return err
+		}
+	}
+
+	if err := s.taskRepo.UnassignUser(ctx, userID, reassignTo); err != nil {
+		return err
+	}
+	if err := s.boardService.HandOverBoards(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.repo.Anonymize(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.authService.RevokePersonalAccessTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	return s.authService.RevokeUserSessions(ctx, userID)
+}
+
+// checkCurrentPassword confirms a sensitive change with the current password.
+// Users who only log in through an identity provider have no password to give,
+// their login is the confirmation.
+func checkCurrentPassword(user *user_model.User, currentPassword string) error {
+	if user.HasPassword() && !user.PasswordIsValid(currentPassword) {
+		return ErrWrongPassword
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/password"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestUserService(t *testing.T, user *domains.User, sent *[]sentEmail) *UserService {
+	t.Helper()
+	cache := newMemoryCache()
+	hasher := password.NewHasher(bcrypt.MinCost)
+	authService := newTestAuthService(t, user, cache)
+	accountService := newTestAccountService(user, cache, sent)
+	return NewUserService(authUserRepo{user: user}, nil, nil, authService, accountService, hasher, domains.PasswordPolicy{})
+}
+
+func TestUpdateProfileEmailNeedsCurrentPassword(t *testing.T) {
+	hash, err := password.NewHasher(bcrypt.MinCost).Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &domains.User{ID: ownerID, Name: "owner", Email: "old@example.com", Password: hash}
+	var sent []sentEmail
+	service := newTestUserService(t, user, &sent)
+	ctx := context.Background()
+	newEmail := "new@example.com"
+
+	for _, current := range []string{"", "Secret#2"} {
+		_, err := service.UpdateProfile(ctx, user.ID, ProfileUpdate{Email: &newEmail, CurrentPassword: current})
+		if !errors.Is(err, ErrWrongPassword) {
+			t.Errorf("password %q: expected %v, got %v", current, ErrWrongPassword, err)
+		}
+	}
+	if user.Email != "old@example.com" || len(sent) != 0 {
+		t.Fatalf("expected the email to stay unchanged, got %s with %d emails sent", user.Email, len(sent))
+	}
+
+	name := "renamed"
+	if _, err := service.UpdateProfile(ctx, user.ID, ProfileUpdate{Name: &name}); err != nil {
+		t.Fatalf("expected other fields to change without the password, got %v", err)
+	}
+
+	if _, err := service.UpdateProfile(ctx, user.ID, ProfileUpdate{Email: &newEmail, CurrentPassword: "Secret#1"}); err != nil {
+		t.Fatalf("update email: %v", err)
+	}
+	if user.Email != newEmail || user.EmailVerifiedAt != nil {
+		t.Errorf("expected the unverified new email, got %s verified at %v", user.Email, user.EmailVerifiedAt)
+	}
+
+	recipients := map[string]string{}
+	for _, email := range sent {
+		recipients[email.template] = email.to
+	}
+	if recipients[emailVerificationTemplate] != newEmail {
+		t.Errorf("expected a verification link for the new email, got %v", recipients)
+	}
+	if recipients[emailChangedTemplate] != "old@example.com" {
+		t.Errorf("expected the old email to be told about the change, got %v", recipients)
+	}
+}
+
+func TestPasswordlessUserConfirmsWithoutPassword(t *testing.T) {
+	// users created by single sign-on have no password
+	user := &domains.User{ID: ownerID, Name: "owner", Email: "old@example.com"}
+	var sent []sentEmail
+	service := newTestUserService(t, user, &sent)
+	ctx := context.Background()
+	newEmail := "new@example.com"
+
+	if _, err := service.UpdateProfile(ctx, user.ID, ProfileUpdate{Email: &newEmail}); err != nil {
+		t.Fatalf("update email: %v", err)
+	}
+
+	if err := service.ChangePassword(ctx, user.ID, "", "Secret#1"); err != nil {
+		t.Fatalf("set first password: %v", err)
+	}
+	if !user.PasswordIsValid("Secret#1") {
+		t.Fatal("expected the first password to be set")
+	}
+
+	if err := service.ChangePassword(ctx, user.ID, "", "Secret#2"); !errors.Is(err, ErrWrongPassword) {
+		t.Errorf("expected the password to be required once it is set, got %v", err)
+	}
+}
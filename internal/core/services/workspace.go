@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/domains"
+	"github.com/GoBootCamp-Group1/Task-Management/internal/core/ports"
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	ErrNotWorkspaceMember           = fiber.NewError(fiber.StatusForbidden, "not a member of the workspace")
+	ErrInvalidWorkspaceRole         = fiber.NewError(fiber.StatusBadRequest, "invalid workspace role")
+	ErrUserIsAlreadyWorkspaceMember = fiber.NewError(fiber.StatusBadRequest, "user is already a workspace member")
+	ErrUserNotInWorkspace           = fiber.NewError(fiber.StatusBadRequest, "user is not a member of the workspace of the board")
+	ErrWorkspaceNeedsOwner          = fiber.NewError(fiber.StatusBadRequest, "workspace must keep at least one owner")
+	ErrWorkspaceHasBoards           = fiber.NewError(fiber.StatusBadRequest, "workspace still has boards, deleted boards count until they are purged")
+	ErrBoardInWorkspace             = fiber.NewError(fiber.StatusBadRequest, "board already belongs to a workspace")
+)
+
+// WorkspaceService manages workspaces and their members. Admins manage the
+// members, only owners can hand out or take away the owner role. Member
+// changes are recorded in the audit log.
+type WorkspaceService struct {
+	workspaceRepo   ports.WorkspaceRepo
+	memberRepo      ports.WorkspaceMemberRepo
+	teamMemberRepo  ports.TeamMemberRepo
+	boardRepo       ports.BoardRepo
+	boardMemberRepo ports.BoardMemberRepo
+	userRepo        ports.UserRepo
+	authorizer      ports.Authorizer
+	auditService    *AuditService
+}
+
+func NewWorkspaceService(workspaceRepo ports.WorkspaceRepo, memberRepo ports.WorkspaceMemberRepo, teamMemberRepo ports.TeamMemberRepo,
+	boardRepo ports.BoardRepo, boardMemberRepo ports.BoardMemberRepo, userRepo ports.UserRepo, authorizer ports.Authorizer,
+	auditService *AuditService) *WorkspaceService {
+	return &WorkspaceService{
+		workspaceRepo:   workspaceRepo,
+		memberRepo:      memberRepo,
+		teamMemberRepo:  teamMemberRepo,
+		boardRepo:       boardRepo,
+		boardMemberRepo: boardMemberRepo,
+		userRepo:        userRepo,
+		authorizer:      authorizer,
+		auditService:    auditService,
+	}
+}
+
+// CreateWorkspace creates the workspace with its creator as owner.
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, workspace *domains.Workspace) error {
+	if err := s.workspaceRepo.Create(ctx, workspace); err != nil {
+		return err
+	}
+	return s.memberRepo.Create(ctx, &domains.WorkspaceMember{
+		WorkspaceID: workspace.ID,
+		UserID:      workspace.CreatedBy,
+		Role:        domains.WorkspaceRoleOwner,
+	})
+}
+
+func (s *WorkspaceService) GetUserWorkspaces(ctx context.Context, userID uint) ([]domains.Workspace, error) {
+	return s.workspaceRepo.GetByUserID(ctx, userID)
+}
+
+func (s *WorkspaceService) GetWorkspace(ctx context.Context, userID, id uint) (*domains.Workspace, error) {
+	if _, err := s.requireRole(ctx, id, userID, domains.WorkspaceRoleGuest); err != nil {
+		return nil, err
+	}
+	return s.workspaceRepo.GetByID(ctx, id)
+}
+
+func (s *WorkspaceService) UpdateWorkspace(ctx context.Context, userID uint, workspace *domains.Workspace) error {
+	if _, err := s.requireRole(ctx, workspace.ID, userID, domains.WorkspaceRoleAdmin); err != nil {
+		return err
+	}
+	return s.workspaceRepo.Update(ctx, workspace)
+}
+
+// DeleteWorkspace deletes an empty workspace, its boards have to be deleted
+// and purged first so none of them can be restored into it.
+func (s *WorkspaceService) DeleteWorkspace(ctx context.Context, userID, id uint) error {
+	if _, err := s.requireRole(ctx, id, userID, domains.WorkspaceRoleOwner); err != nil {
+		return err
+	}
+	count, err := s.boardRepo.CountByWorkspace(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrWorkspaceHasBoards
+	}
+	return s.workspaceRepo.Delete(ctx, id)
+}
+
+// GetMembers returns the member directory of the workspace, which guests can not see.
+func (s *WorkspaceService) GetMembers(ctx context.Context, userID, id uint, search string) ([]domains.WorkspaceMember, error) {
+	if _, err := s.requireRole(ctx, id, userID, domains.WorkspaceRoleMember); err != nil {
+		return nil, err
+	}
+	return s.memberRepo.GetMembers(ctx, id, search)
+}
+
+func (s *WorkspaceService) AddMember(ctx context.Context, actorID, id uint, email string, role domains.WorkspaceRole) (*domains.WorkspaceMember, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidWorkspaceRole
+	}
+	if err := s.authorizeMemberChange(ctx, id, actorID, role); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.memberRepo.GetMember(ctx, id, user.ID)
+	if err == nil {
+		return nil, ErrUserIsAlreadyWorkspaceMember
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	member := &domains.WorkspaceMember{WorkspaceID: id, UserID: user.ID, Role: role, User: user}
+	if err = s.memberRepo.Create(ctx, member); err != nil {
+		return nil, err
+	}
+	if err = s.recordMemberChange(ctx, domains.AuditWorkspaceMemberAdded, actorID, id, user.ID, role); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func (s *WorkspaceService) ChangeMemberRole(ctx context.Context, actorID, id, userID uint, role domains.WorkspaceRole) error {
+	if !role.IsValid() {
+		return ErrInvalidWorkspaceRole
+	}
+	member, err := s.memberRepo.GetMember(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if err = s.authorizeMemberChange(ctx, id, actorID, member.Role, role); err != nil {
+		return err
+	}
+	if role != domains.WorkspaceRoleOwner {
+		if err = s.ensureNotLastOwner(ctx, member); err != nil {
+			return err
+		}
+	}
+	if err = s.memberRepo.UpdateRole(ctx, member.ID, role); err != nil {
+		return err
+	}
+	return s.recordMemberChange(ctx, domains.AuditWorkspaceMemberRoleChanged, actorID, id, userID, role)
+}
+
+// RemoveMember removes a member from the workspace and its teams, members can
+// always leave themselves. Removed members lose access to the boards of the
+// workspace.
+func (s *WorkspaceService) RemoveMember(ctx context.Context, actorID, id, userID uint) error {
+	member, err := s.memberRepo.GetMember(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if actorID != userID {
+		if err = s.authorizeMemberChange(ctx, id, actorID, member.Role); err != nil {
+			return err
+		}
+	}
+	if err = s.ensureNotLastOwner(ctx, member); err != nil {
+		return err
+	}
+	if err = s.teamMemberRepo.DeleteByWorkspaceUser(ctx, id, userID); err != nil {
+		return err
+	}
+	if err = s.memberRepo.Delete(ctx, member.ID); err != nil {
+		return err
+	}
+	return s.recordMemberChange(ctx, domains.AuditWorkspaceMemberRemoved, actorID, id, userID, "")
+}
+
+// recordMemberChange adds a change of the membership of a user to the audit
+// log, with the role the user holds after the change.
+func (s *WorkspaceService) recordMemberChange(ctx context.Context, action domains.AuditAction, actorID, id, userID uint, role domains.WorkspaceRole) error {
+	details := map[string]string{"workspace_id": strconv.FormatUint(uint64(id), 10)}
+	if role != "" {
+		details["role"] = string(role)
+	}
+	return s.auditService.Record(ctx, domains.AuditLog{
+		Action:       action,
+		ActorID:      auditUser(actorID),
+		TargetUserID: auditUser(userID),
+		Details:      details,
+	})
+}
+
+// MoveBoard moves a board without a workspace into the workspace. Board
+// members outside of the workspace join it as guests so they keep their access.
+func (s *WorkspaceService) MoveBoard(ctx context.Context, userID, id, boardID uint) error {
+	if _, err := s.requireRole(ctx, id, userID, domains.WorkspaceRoleMember); err != nil {
+		return err
+	}
+	if err := s.authorizer.Authorize(ctx, userID, boardID, domains.ActionBoardMove); err != nil {
+		return err
+	}
+	board, err := s.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	if board.WorkspaceID != nil {
+		return ErrBoardInWorkspace
+	}
+
+	boardMembers, err := s.boardMemberRepo.GetBoardMembers(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	for _, boardMember := range boardMembers {
+		_, err = s.memberRepo.GetMember(ctx, id, boardMember.UserID)
+		if err == nil {
+			continue
+		}
+		if !isNotFound(err) {
+			return err
+		}
+		err = s.memberRepo.Create(ctx, &domains.WorkspaceMember{
+			WorkspaceID: id,
+			UserID:      boardMember.UserID,
+			Role:        domains.WorkspaceRoleGuest,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.boardRepo.SetWorkspace(ctx, boardID, id)
+}
+
+// requireRole returns the membership of the user in the workspace when the
+// user holds at least the role.
+func (s *WorkspaceService) requireRole(ctx context.Context, id, userID uint, role domains.WorkspaceRole) (*domains.WorkspaceMember, error) {
+	member, err := s.memberRepo.GetMember(ctx, id, userID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrNotWorkspaceMember
+		}
+		return nil, err
+	}
+	if !member.Role.AtLeast(role) {
+		return nil, ErrAccessDenied
+	}
+	return member, nil
+}
+
+// authorizeMemberChange lets admins manage members, changes involving the
+// owner role need an owner.
+func (s *WorkspaceService) authorizeMemberChange(ctx context.Context, id, actorID uint, roles ...domains.WorkspaceRole) error {
+	required := domains.WorkspaceRoleAdmin
+	for _, role := range roles {
+		if role == domains.WorkspaceRoleOwner {
+			required = domains.WorkspaceRoleOwner
+		}
+	}
+	_, err := s.requireRole(ctx, id, actorID, required)
+	return err
+}
+
+func (s *WorkspaceService) ensureNotLastOwner(ctx context.Context, member *domains.WorkspaceMember) error {
+	if member.Role != domains.WorkspaceRoleOwner {
+		return nil
+	}
+	count, err := s.memberRepo.CountByRole(ctx, member.WorkspaceID, domains.WorkspaceRoleOwner)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return ErrWorkspaceNeedsOwner
+	}
+	return nil
+}
@@ -2,9 +2,26 @@ package jwt
 
 import jwt2 "github.com/golang-jwt/jwt/v5"
 
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+	// MFAChallengeTokenType is only good for finishing a login with an MFA code
+	MFAChallengeTokenType = "mfa_challenge"
+	// PersonalAccessTokenType marks claims built from a personal access token
+	PersonalAccessTokenType = "personal_access_token"
+)
+
 type UserClaims struct {
 	jwt2.RegisteredClaims
-	UserID   uint
-	Role     string
-	Sections []string
+	UserID    uint
+	Role      string
+	Sections  []string
+	TokenType string
+	// IssuedAtMilli is the issue time in milliseconds, iat only keeps seconds
+	IssuedAtMilli int64 `json:",omitempty"`
+	// FamilyID groups the refresh tokens rotated from one login
+	FamilyID string `json:",omitempty"`
+	// Scopes and BoardID restrict personal access tokens
+	Scopes  []string `json:",omitempty"`
+	BoardID uint     `json:",omitempty"`
 }
@@ -0,0 +1,235 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgHS512 = "HS512"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+
+	// LegacyKeyID names the HMAC key built from the token secret, tokens
+	// signed before key IDs were introduced have no kid and map to it
+	LegacyKeyID = "default"
+)
+
+var (
+	ErrNoSigningKey = errors.New("no signing key is active")
+	ErrUnknownKey   = errors.New("token is signed with an unknown or retired key")
+)
+
+// Key is one signing key. It signs tokens from ActiveFrom until the next key
+// becomes active and verifies them until the grace period after that ends.
+type Key struct {
+	ID         string
+	Algorithm  string
+	ActiveFrom time.Time
+
+	method     jwt.SigningMethod
+	signingKey any
+	verifyKey  any
+}
+
+func NewHMACKey(id string, secret []byte, activeFrom time.Time) *Key {
+	return &Key{
+		ID:         id,
+		Algorithm:  AlgHS512,
+		ActiveFrom: activeFrom,
+		method:     jwt.SigningMethodHS512,
+		signingKey: secret,
+		verifyKey:  secret,
+	}
+}
+
+// NewKeyFromPEM parses a PEM encoded RSA (RS256) or Ed25519 (EdDSA) private key.
+func NewKeyFromPEM(id string, algorithm string, pemData []byte, activeFrom time.Time) (*Key, error) {
+	key := &Key{ID: id, Algorithm: algorithm, ActiveFrom: activeFrom}
+
+	switch algorithm {
+	case AlgRS256:
+		private, err := jwt.ParseRSAPrivateKeyFromPEM(pemData)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+		key.method, key.signingKey, key.verifyKey = jwt.SigningMethodRS256, private, &private.PublicKey
+	case AlgEdDSA:
+		private, err := jwt.ParseEdPrivateKeyFromPEM(pemData)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", id, err)
+		}
+		edKey, ok := private.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("key %s: not an Ed25519 key", id)
+		}
+		key.method, key.signingKey, key.verifyKey = jwt.SigningMethodEdDSA, edKey, edKey.Public()
+	default:
+		return nil, fmt.Errorf("key %s: unsupported algorithm %q", id, algorithm)
+	}
+
+	return key, nil
+}
+
+// KeySet signs tokens with the newest active key and verifies them with any
+// key that is not retired yet, so keys can be rotated without logging
+// everybody out.
+type KeySet struct {
+	keys        []*Key
+	gracePeriod time.Duration
+	now         func() time.Time
+}
+
+func NewKeySet(keys []*Key, gracePeriod time.Duration) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoSigningKey
+	}
+
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key.ID] {
+			return nil, fmt.Errorf("duplicate key id %q", key.ID)
+		}
+		seen[key.ID] = true
+	}
+
+	sorted := append([]*Key(nil), keys...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ActiveFrom.Before(sorted[j].ActiveFrom)
+	})
+
+	return &KeySet{keys: sorted, gracePeriod: gracePeriod, now: time.Now}, nil
+}
+
+// Sign creates a token signed with the current key.
+func (ks *KeySet) Sign(claims *UserClaims) (string, error) {
+	key := ks.signingKey(ks.now())
+	if key == nil {
+		return "", ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.signingKey)
+}
+
+// Parse verifies a token and returns its claims. The algorithm of the token
+// has to match the algorithm of its key.
+func (ks *KeySet) Parse(tokenString string) (*UserClaims, error) {
+	claims := &UserClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		if kid == "" {
+			kid = LegacyKeyID
+		}
+
+		key := ks.verificationKey(kid, ks.now())
+		if key == nil {
+			return nil, ErrUnknownKey
+		}
+		if t.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %s", t.Method.Alg())
+		}
+		return key.verifyKey, nil
+	})
+	if err != nil {
+		return claims, err
+	}
+
+	if !token.Valid {
+		return claims, errors.New("token is not valid")
+	}
+
+	return claims, nil
+}
+
+// JWKS returns the public keys that are not retired, including scheduled
+// ones so verifiers know them before they sign. HMAC keys are secret and
+// never published.
+func (ks *KeySet) JWKS() JSONWebKeySet {
+	now := ks.now()
+	set := JSONWebKeySet{Keys: []JSONWebKey{}}
+	for i, key := range ks.keys {
+		if ks.retired(i, now) {
+			continue
+		}
+
+		switch public := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, JSONWebKey{
+				Kty: "RSA",
+				Kid: key.ID,
+				Use: "sig",
+				Alg: key.Algorithm,
+				N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			set.Keys = append(set.Keys, JSONWebKey{
+				Kty: "OKP",
+				Kid: key.ID,
+				Use: "sig",
+				Alg: key.Algorithm,
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(public),
+			})
+		}
+	}
+	return set
+}
+
+func (ks *KeySet) signingKey(now time.Time) *Key {
+	var current *Key
+	for _, key := range ks.keys {
+		if key.ActiveFrom.After(now) {
+			break
+		}
+		current = key
+	}
+	return current
+}
+
+func (ks *KeySet) verificationKey(kid string, now time.Time) *Key {
+	for i, key := range ks.keys {
+		if key.ID != kid {
+			continue
+		}
+		if key.ActiveFrom.After(now) || ks.retired(i, now) {
+			return nil
+		}
+		return key
+	}
+	return nil
+}
+
+// retired reports whether the key was replaced longer than the grace period ago.
+func (ks *KeySet) retired(i int, now time.Time) bool {
+	if i+1 >= len(ks.keys) {
+		return false
+	}
+	next := ks.keys[i+1]
+	return !next.ActiveFrom.After(now) && now.After(next.ActiveFrom.Add(ks.gracePeriod))
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
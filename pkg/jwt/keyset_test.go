@@ -0,0 +1,232 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const gracePeriod = 48 * time.Hour
+
+var (
+	legacyFrom = time.Time{}
+	rsaFrom    = time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	edFrom     = time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func privateKeyPEM(t *testing.T, key any) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+// newTestKeySet returns the legacy HMAC key, an RSA key that replaced it and
+// an Ed25519 key that replaced the RSA key, with the clock set to now.
+func newTestKeySet(t *testing.T, now time.Time) *KeySet {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaSigner, err := NewKeyFromPEM("2024-08-rsa", AlgRS256, privateKeyPEM(t, rsaKey), rsaFrom)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edSigner, err := NewKeyFromPEM("2024-11-ed25519", AlgEdDSA, privateKeyPEM(t, edKey), edFrom)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// keys are sorted by activation, whatever the configured order
+	ks, err := NewKeySet([]*Key{edSigner, NewHMACKey(LegacyKeyID, []byte("secret"), legacyFrom), rsaSigner}, gracePeriod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks.now = func() time.Time { return now }
+	return ks
+}
+
+func testClaims() *UserClaims {
+	return &UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		UserID:           7,
+		TokenType:        AccessTokenType,
+	}
+}
+
+func signedKeyID(t *testing.T, ks *KeySet) string {
+	t.Helper()
+	token, err := ks.Sign(testClaims())
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &UserClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+func TestKeySetSignsWithNewestActiveKey(t *testing.T) {
+	cases := []struct {
+		now  time.Time
+		want string
+	}{
+		{now: rsaFrom.Add(-time.Second), want: LegacyKeyID},
+		{now: rsaFrom, want: "2024-08-rsa"},
+		{now: edFrom.Add(-time.Second), want: "2024-08-rsa"},
+		{now: edFrom.Add(time.Hour), want: "2024-11-ed25519"},
+	}
+	for _, tc := range cases {
+		if got := signedKeyID(t, newTestKeySet(t, tc.now)); got != tc.want {
+			t.Errorf("at %s signed with %s, want %s", tc.now, got, tc.want)
+		}
+	}
+}
+
+func TestKeySetWithoutActiveKey(t *testing.T) {
+	ks, err := NewKeySet([]*Key{NewHMACKey("future", []byte("secret"), time.Now().Add(time.Hour))}, gracePeriod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Sign(testClaims()); !errors.Is(err, ErrNoSigningKey) {
+		t.Errorf("expected %v, got %v", ErrNoSigningKey, err)
+	}
+
+	if _, err := NewKeySet(nil, gracePeriod); !errors.Is(err, ErrNoSigningKey) {
+		t.Errorf("expected an empty key set to fail, got %v", err)
+	}
+	duplicate := NewHMACKey("a", []byte("secret"), time.Time{})
+	if _, err := NewKeySet([]*Key{duplicate, duplicate}, gracePeriod); err == nil {
+		t.Error("expected duplicate key ids to fail")
+	}
+}
+
+func TestKeySetRetiresKeysAfterGracePeriod(t *testing.T) {
+	ks := newTestKeySet(t, edFrom.Add(-time.Hour))
+	token, err := ks.Sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		now     time.Time
+		wantErr bool
+	}{
+		{name: "while the key signs", now: edFrom.Add(-time.Minute)},
+		{name: "within the grace period", now: edFrom.Add(gracePeriod)},
+		{name: "after the grace period", now: edFrom.Add(gracePeriod + time.Second), wantErr: true},
+	}
+	for _, tc := range cases {
+		ks.now = func() time.Time { return tc.now }
+		claims, err := ks.Parse(token)
+		if tc.wantErr {
+			if !errors.Is(err, ErrUnknownKey) {
+				t.Errorf("%s: expected %v, got %v", tc.name, ErrUnknownKey, err)
+			}
+			continue
+		}
+		if err != nil || claims.UserID != 7 {
+			t.Errorf("%s: expected the token to verify, got %+v, %v", tc.name, claims, err)
+		}
+	}
+
+	ks.now = func() time.Time { return rsaFrom.Add(-time.Hour) }
+	if _, err := ks.Parse(token); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected a key to verify nothing before it is active, got %v", err)
+	}
+}
+
+func TestKeySetRejectsAlgorithmOfAnotherKey(t *testing.T) {
+	ks := newTestKeySet(t, edFrom.Add(time.Hour))
+
+	// an HMAC token claiming the kid of the RSA key must not be checked with the HMAC secret
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, testClaims())
+	token.Header["kid"] = "2024-08-rsa"
+	signed, err := token.SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Parse(signed); err == nil {
+		t.Error("expected a token with the algorithm of another key to be rejected")
+	}
+
+	token = jwt.NewWithClaims(jwt.SigningMethodHS512, testClaims())
+	token.Header["kid"] = "unknown"
+	signed, err = token.SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Parse(signed); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected an unknown kid to be rejected, got %v", err)
+	}
+}
+
+func TestKeySetMapsMissingKeyIDToLegacyKey(t *testing.T) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, testClaims())
+	signed, err := token.SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ks := newTestKeySet(t, rsaFrom.Add(time.Hour))
+	if claims, err := ks.Parse(signed); err != nil || claims.UserID != 7 {
+		t.Errorf("expected a token without kid to verify with the legacy key, got %+v, %v", claims, err)
+	}
+
+	ks.now = func() time.Time { return rsaFrom.Add(gracePeriod + time.Second) }
+	if _, err := ks.Parse(signed); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected the legacy key to retire like the others, got %v", err)
+	}
+}
+
+func TestKeySetJWKS(t *testing.T) {
+	cases := []struct {
+		name string
+		now  time.Time
+		want []string
+	}{
+		{name: "scheduled keys are published early", now: rsaFrom.Add(time.Hour), want: []string{"2024-08-rsa", "2024-11-ed25519"}},
+		{name: "retired keys are dropped", now: edFrom.Add(gracePeriod + time.Second), want: []string{"2024-11-ed25519"}},
+	}
+	for _, tc := range cases {
+		set := newTestKeySet(t, tc.now).JWKS()
+		if len(set.Keys) != len(tc.want) {
+			t.Errorf("%s: got %+v, want %v", tc.name, set.Keys, tc.want)
+			continue
+		}
+		for i, key := range set.Keys {
+			if key.Kid != tc.want[i] || key.Use != "sig" {
+				t.Errorf("%s: key %d = %+v, want %s", tc.name, i, key, tc.want[i])
+			}
+			switch key.Kid {
+			case "2024-08-rsa":
+				if key.Kty != "RSA" || key.Alg != AlgRS256 || key.N == "" || key.E != "AQAB" {
+					t.Errorf("%s: unexpected RSA key %+v", tc.name, key)
+				}
+			case "2024-11-ed25519":
+				if key.Kty != "OKP" || key.Crv != "Ed25519" || key.Alg != AlgEdDSA || key.X == "" {
+					t.Errorf("%s: unexpected Ed25519 key %+v", tc.name, key)
+				}
+			}
+		}
+	}
+}
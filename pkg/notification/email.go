@@ -84,7 +84,7 @@ func (e *EmailNotifierConf) Send(to string, subject string, message string) erro
 	d := gomail.NewDialer(e.SmtpHost, e.SmtpPort, e.SmtpUsername, e.SmtpPassword)
 
 	if err := d.DialAndSend(m); err != nil {
-		panic(err)
+		return err
 	}
 
 	fmt.Println("Email Sent Successfully!")
@@ -0,0 +1,240 @@
+// Package oidc is a small OpenID Connect relying party: it discovers the
+// provider endpoints, builds authorization code URLs with PKCE and verifies
+// the ID token returned by the token endpoint against the provider's JWKS.
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+var (
+	ErrMissingIDToken = errors.New("oidc: token response has no id_token")
+	ErrNonceMismatch  = errors.New("oidc: id token nonce does not match")
+	ErrUnknownKey     = errors.New("oidc: id token is signed with an unknown key")
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+type Config struct {
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Claims are the ID token claims used to find or create the local user.
+type Claims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type Provider struct {
+	name       string
+	issuer     string
+	jwksURI    string
+	oauth      *oauth2.Config
+	httpClient *http.Client
+
+	mu   sync.RWMutex
+	keys map[string]*rsa.PublicKey
+}
+
+// NewProvider fetches the discovery document of the issuer.
+func NewProvider(ctx context.Context, cfg Config, httpClient *http.Client) (*Provider, error) {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	var doc discovery
+	wellKnown := strings.TrimRight(cfg.IssuerURL, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, httpClient, wellKnown, &doc); err != nil {
+		return nil, err
+	}
+	if strings.TrimRight(doc.Issuer, "/") != strings.TrimRight(cfg.IssuerURL, "/") {
+		return nil, fmt.Errorf("oidc: issuer %q does not match the configured %q", doc.Issuer, cfg.IssuerURL)
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	return &Provider{
+		name:       cfg.Name,
+		issuer:     doc.Issuer,
+		jwksURI:    doc.JWKSURI,
+		httpClient: httpClient,
+		oauth: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  doc.AuthorizationEndpoint,
+				TokenURL: doc.TokenEndpoint,
+			},
+		},
+		keys: make(map[string]*rsa.PublicKey),
+	}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// AuthCodeURL returns the URL to send the user to, the verifier has to be
+// kept for Exchange.
+func (p *Provider) AuthCodeURL(state, nonce, verifier string) string {
+	return p.oauth.AuthCodeURL(state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.S256ChallengeOption(verifier),
+	)
+}
+
+// Exchange redeems the authorization code and returns the verified ID token claims.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Claims, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, ErrMissingIDToken
+	}
+
+	return p.Verify(ctx, rawIDToken, nonce)
+}
+
+// Verify checks the signature, issuer, audience, expiry and nonce of an ID token.
+func (p *Provider) Verify(ctx context.Context, rawIDToken, nonce string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512"}),
+		jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(p.oauth.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if nonce != "" && claims.Nonce != nonce {
+		return nil, ErrNonceMismatch
+	}
+	return claims, nil
+}
+
+// key returns the signing key with the kid, refetching the JWKS once for
+// unknown kids so key rotation at the provider is picked up.
+func (p *Provider) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	if key := p.cachedKey(kid); key != nil {
+		return key, nil
+	}
+
+	if err := p.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	if key := p.cachedKey(kid); key != nil {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+func (p *Provider) cachedKey(kid string) *rsa.PublicKey {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key
+		}
+	}
+	return p.keys[kid]
+}
+
+func (p *Provider) refreshKeys(ctx context.Context) error {
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := getJSON(ctx, p.httpClient, p.jwksURI, &set); err != nil {
+		return err
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return fmt.Errorf("oidc: invalid key %q: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return fmt.Errorf("oidc: invalid key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	return nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidc: GET %s: unexpected status %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
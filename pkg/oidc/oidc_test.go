@@ -0,0 +1,94 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoBootCamp-Group1/Task-Management/pkg/oidc/oidctest"
+)
+
+func TestProviderLogin(t *testing.T) {
+	tests := []struct {
+		name      string
+		audience  string
+		omitEmail bool
+		verifier  func(verifier string) string
+		nonce     func(nonce string) string
+		wantErr   bool
+	}{
+		{name: "valid login"},
+		{name: "wrong PKCE verifier", verifier: func(string) string { return "another-verifier-that-is-long-enough-for-pkce-0000" }, wantErr: true},
+		{name: "nonce mismatch", nonce: func(string) string { return "other-nonce" }, wantErr: true},
+		{name: "token for another client", audience: "other-client", wantErr: true},
+		{name: "no email claim", omitEmail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := oidctest.NewServer(t)
+			if tt.audience != "" {
+				server.Audience = tt.audience
+			}
+			server.OmitEmail = tt.omitEmail
+
+			ctx := context.Background()
+			provider, err := NewProvider(ctx, Config{
+				Name:         "mock",
+				IssuerURL:    server.URL,
+				ClientID:     oidctest.ClientID,
+				ClientSecret: oidctest.ClientSecret,
+				RedirectURL:  "http://localhost/callback",
+			}, server.Client())
+			if err != nil {
+				t.Fatalf("NewProvider() error = %v", err)
+			}
+
+			verifier, nonce := "verifier-0123456789-0123456789-0123456789-0123456789", "nonce-123"
+			code, state := server.Authorize(t, provider.AuthCodeURL("state-123", nonce, verifier))
+			if state != "state-123" {
+				t.Fatalf("state = %q, want state-123", state)
+			}
+
+			if tt.verifier != nil {
+				verifier = tt.verifier(verifier)
+			}
+			if tt.nonce != nil {
+				nonce = tt.nonce(nonce)
+			}
+
+			claims, err := provider.Exchange(ctx, code, verifier, nonce)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Exchange() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Exchange() error = %v", err)
+			}
+
+			if claims.Subject != "user-42" {
+				t.Errorf("Subject = %q, want user-42", claims.Subject)
+			}
+			if tt.omitEmail {
+				if claims.Email != "" || claims.EmailVerified {
+					t.Errorf("Email = %q, EmailVerified = %v, want no email", claims.Email, claims.EmailVerified)
+				}
+				return
+			}
+			if claims.Email != "test@example.com" || !claims.EmailVerified {
+				t.Errorf("Email = %q, EmailVerified = %v, want verified test@example.com", claims.Email, claims.EmailVerified)
+			}
+		})
+	}
+}
+
+func TestNewProviderIssuerMismatch(t *testing.T) {
+	server := oidctest.NewServer(t)
+	server.Issuer = "https://idp.example.com"
+
+	_, err := NewProvider(context.Background(), Config{IssuerURL: server.URL}, server.Client())
+	if err == nil {
+		t.Fatal("NewProvider() error = nil, want an error")
+	}
+}
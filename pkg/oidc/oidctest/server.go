@@ -0,0 +1,185 @@
+// Package oidctest runs a minimal OpenID Connect provider for tests.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	ClientID     = "task-management"
+	ClientSecret = "secret"
+
+	keyID = "test-key"
+)
+
+// Server hands out one code per authorization request and checks the PKCE
+// verifier when it is redeemed. The exported fields shape the ID tokens it
+// issues and can be changed between logins.
+type Server struct {
+	*httptest.Server
+
+	// Audience and Issuer default to the client ID and the server URL
+	Audience string
+	Issuer   string
+
+	Subject       string
+	Name          string
+	Email         string
+	EmailVerified bool
+	// OmitEmail leaves the email claims out of the ID token
+	OmitEmail bool
+
+	key   *rsa.PrivateKey
+	mu    sync.Mutex
+	codes map[string]authRequest
+}
+
+type authRequest struct {
+	nonce     string
+	challenge string
+}
+
+// NewServer starts a provider whose ID tokens carry a verified test@example.com
+// for the subject user-42. It is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Server{
+		Audience:      ClientID,
+		Subject:       "user-42",
+		Name:          "Test User",
+		Email:         "test@example.com",
+		EmailVerified: true,
+		key:           key,
+		codes:         make(map[string]authRequest),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer := s.Issuer
+		if issuer == "" {
+			issuer = s.URL
+		}
+		writeJSON(w, map[string]string{
+			"issuer":                 issuer,
+			"authorization_endpoint": s.URL + "/authorize",
+			"token_endpoint":         s.URL + "/token",
+			"jwks_uri":               s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": keyID,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", s.token)
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Authorize plays the user logging in at the provider and returns the code
+// it would redirect back with.
+func (s *Server) Authorize(t testing.TB, authURL string) (code, state string) {
+	t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" {
+		t.Fatalf("code_challenge_method = %q, want S256", q.Get("code_challenge_method"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	code = "code-" + q.Get("state")
+	s.codes[code] = authRequest{nonce: q.Get("nonce"), challenge: q.Get("code_challenge")}
+	return code, q.Get("state")
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != ClientID || clientSecret != ClientSecret {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	s.mu.Lock()
+	req, ok := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	s.mu.Unlock()
+
+	digest := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(digest[:]) != req.challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   s.URL,
+		"sub":   s.Subject,
+		"aud":   s.Audience,
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": req.nonce,
+		"name":  s.Name,
+	}
+	if !s.OmitEmail {
+		claims["email"] = s.Email
+		claims["email_verified"] = s.EmailVerified
+	}
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	idToken.Header["kid"] = keyID
+	signed, err := idToken.SignedString(s.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, map[string]any{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"expires_in":   60,
+		"id_token":     signed,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,62 @@
+package password
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MaxLength is the longest password bcrypt takes into account.
+const MaxLength = 72
+
+type Hasher struct {
+	cost int
+}
+
+// NewHasher returns a bcrypt hasher, falling back to bcrypt.DefaultCost when
+// cost is outside the range bcrypt accepts.
+func NewHasher(cost int) *Hasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &Hasher{cost: cost}
+}
+
+// Hash returns a salted bcrypt hash of the password.
+func (h *Hasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// NeedsRehash reports whether hash is a legacy SHA-256 digest or a bcrypt
+// hash made with a different cost than the configured one.
+func (h *Hasher) NeedsRehash(hash string) bool {
+	if isLegacy(hash) {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.cost
+}
+
+// Compare checks a password against a bcrypt hash, or against an unsalted
+// SHA-256 hex digest stored before bcrypt was introduced.
+func Compare(hash string, password string) bool {
+	if isLegacy(hash) {
+		digest := sha256.Sum256([]byte(password))
+		return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(digest[:])), []byte(hash)) == 1
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+func isLegacy(hash string) bool {
+	if len(hash) != hex.EncodedLen(sha256.Size) {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
@@ -0,0 +1,114 @@
+package password
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func legacyHash(password string) string {
+	digest := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(digest[:])
+}
+
+func TestHashAndCompare(t *testing.T) {
+	hasher := NewHasher(bcrypt.MinCost)
+
+	hash, err := hasher.Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, "$2a$") {
+		t.Errorf("expected a bcrypt hash, got %q", hash)
+	}
+	if !Compare(hash, "Secret#1") {
+		t.Error("expected the password to match its hash")
+	}
+	if Compare(hash, "Secret#2") {
+		t.Error("expected another password not to match")
+	}
+
+	other, err := hasher.Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == hash {
+		t.Error("expected hashes of the same password to be salted differently")
+	}
+}
+
+func TestCompareLegacyHash(t *testing.T) {
+	hash := legacyHash("Secret#1")
+
+	if !Compare(hash, "Secret#1") {
+		t.Error("expected the password to match its legacy hash")
+	}
+	if Compare(hash, "Secret#2") {
+		t.Error("expected another password not to match the legacy hash")
+	}
+	if Compare(strings.ToUpper(hash), "Secret#1") {
+		t.Error("expected a legacy hash to be compared exactly")
+	}
+}
+
+func TestIsLegacy(t *testing.T) {
+	bcryptHash, err := NewHasher(bcrypt.MinCost).Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		hash string
+		want bool
+	}{
+		{hash: legacyHash("Secret#1"), want: true},
+		{hash: bcryptHash, want: false},
+		{hash: legacyHash("Secret#1")[:63], want: false},
+		{hash: strings.Repeat("z", 64), want: false},
+		{hash: "", want: false},
+	}
+	for _, tc := range cases {
+		if got := isLegacy(tc.hash); got != tc.want {
+			t.Errorf("isLegacy(%q) = %t, want %t", tc.hash, got, tc.want)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	hasher := NewHasher(bcrypt.MinCost)
+	current, err := hasher.Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	outdated, err := NewHasher(bcrypt.MinCost + 1).Hash("Secret#1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{name: "current cost", hash: current, want: false},
+		{name: "other cost", hash: outdated, want: true},
+		{name: "legacy digest", hash: legacyHash("Secret#1"), want: true},
+		{name: "unknown format", hash: "not a hash", want: true},
+	}
+	for _, tc := range cases {
+		if got := hasher.NeedsRehash(tc.hash); got != tc.want {
+			t.Errorf("%s: NeedsRehash = %t, want %t", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestNewHasherFallsBackToDefaultCost(t *testing.T) {
+	for _, cost := range []int{0, bcrypt.MinCost - 1, bcrypt.MaxCost + 1} {
+		if hasher := NewHasher(cost); hasher.cost != bcrypt.DefaultCost {
+			t.Errorf("NewHasher(%d) cost = %d, want %d", cost, hasher.cost, bcrypt.DefaultCost)
+		}
+	}
+}
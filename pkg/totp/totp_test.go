@@ -0,0 +1,109 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 seed of the RFC 6238 test vectors, "12345678901234567890" in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// RFC 6238 appendix B lists 8 digit codes, the 6 digit codes are their last digits.
+var rfcVectors = []struct {
+	unix int64
+	code string
+}{
+	{unix: 59, code: "287082"},
+	{unix: 1111111109, code: "081804"},
+	{unix: 1111111111, code: "050471"},
+	{unix: 1234567890, code: "005924"},
+	{unix: 2000000000, code: "279037"},
+	{unix: 20000000000, code: "353130"},
+}
+
+func TestCodeMatchesRFC6238(t *testing.T) {
+	for _, v := range rfcVectors {
+		got, err := Code(rfcSecret, time.Unix(v.unix, 0))
+		if err != nil {
+			t.Fatalf("code at %d: %v", v.unix, err)
+		}
+		if got != v.code {
+			t.Errorf("code at %d = %s, want %s", v.unix, got, v.code)
+		}
+	}
+}
+
+func TestCodeAcceptsLowercaseAndPaddedSecrets(t *testing.T) {
+	for _, secret := range []string{strings.ToLower(rfcSecret), rfcSecret + "===="} {
+		got, err := Code(secret, time.Unix(59, 0))
+		if err != nil || got != "287082" {
+			t.Errorf("code for %q = %s, %v, want 287082", secret, got, err)
+		}
+	}
+	if _, err := Code("not base32!", time.Unix(59, 0)); err == nil {
+		t.Error("expected an invalid secret to fail")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+
+	cases := []struct {
+		name     string
+		at       time.Time
+		skew     int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current step", at: now, skew: 1, wantStep: step, wantOK: true},
+		{name: "previous step within skew", at: now.Add(-Period), skew: 1, wantStep: step - 1, wantOK: true},
+		{name: "next step within skew", at: now.Add(Period), skew: 1, wantStep: step + 1, wantOK: true},
+		{name: "two steps back", at: now.Add(-2 * Period), skew: 1},
+		{name: "previous step without skew", at: now.Add(-Period), skew: 0},
+	}
+	for _, tc := range cases {
+		code, err := Code(rfcSecret, tc.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotStep, ok := Validate(rfcSecret, " "+code+" ", now, tc.skew)
+		if ok != tc.wantOK || (ok && gotStep != tc.wantStep) {
+			t.Errorf("%s: Validate = %d, %t, want %d, %t", tc.name, gotStep, ok, tc.wantStep, tc.wantOK)
+		}
+	}
+
+	for _, code := range []string{"", "12345", "1234567", "abcdef"} {
+		if _, ok := Validate(rfcSecret, code, now, 1); ok {
+			t.Errorf("expected %q to be rejected", code)
+		}
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := encoding.DecodeString(secret)
+	if err != nil || len(key) != secretSize {
+		t.Fatalf("expected %d random bytes in base32, got %q", secretSize, secret)
+	}
+
+	other, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == secret {
+		t.Error("expected secrets to differ")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("Task Manager", "user@example.com", rfcSecret)
+	want := "otpauth://totp/Task%20Manager:user@example.com?algorithm=SHA1&digits=6&issuer=Task+Manager&period=30&secret=" + rfcSecret
+	if uri != want {
+		t.Errorf("uri = %s, want %s", uri, want)
+	}
+}
@@ -1,19 +1,10 @@
 package utils
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
-
 	"github.com/GoBootCamp-Group1/Task-Management/pkg/jwt"
 	"github.com/gofiber/fiber/v2"
 )
 
-func HashPassword(password string) string {
-	h := sha256.New()
-	h.Write([]byte(password))
-	return hex.EncodeToString(h.Sum(nil))
-}
-
 func GetUserID(c *fiber.Ctx) (uint, error) {
 	userClaims, ok := c.Locals(jwt.UserClaimKey).(*jwt.UserClaims)
 	if !ok {
@@ -21,3 +12,14 @@ func GetUserID(c *fiber.Ctx) (uint, error) {
 	}
 	return userClaims.UserID, nil
 }
+
+// SharedBoardIDKey holds the board a share link resolved to on public routes.
+const SharedBoardIDKey = "Shared-Board-ID"
+
+func GetSharedBoardID(c *fiber.Ctx) (uint, error) {
+	boardID, ok := c.Locals(SharedBoardIDKey).(uint)
+	if !ok {
+		return 0, fiber.NewError(fiber.StatusNotFound, "share link is invalid or expired")
+	}
+	return boardID, nil
+}
@@ -21,9 +21,18 @@ type Committer interface {
 	Tx() any
 }
 
+// SavePointer is implemented by committers that can partially roll back a transaction.
+type SavePointer interface {
+	SavePoint(name string) error
+	RollbackTo(name string) error
+}
+
 type ContextValue struct {
 	Tx     Committer
 	Logger *slog.Logger
+	// ClientIP and UserAgent describe the client that sent the request.
+	ClientIP  string
+	UserAgent string
 }
 
 func NewValueContext(parent context.Context, val *ContextValue) context.Context {
@@ -72,11 +81,32 @@ func TryGetTxFromContext(ctx context.Context) (Committer, bool) {
 	return ctxVal.Tx, true
 }
 
+// TryGetSavePointerFromContext returns the transaction of the context when it supports save points.
+func TryGetSavePointerFromContext(ctx context.Context) (SavePointer, bool) {
+	tx, ok := TryGetTxFromContext(ctx)
+	if !ok || tx == nil {
+		return nil, false
+	}
+
+	savePointer, ok := tx.(SavePointer)
+	return savePointer, ok
+}
+
 func GetLogger(ctx context.Context) *slog.Logger {
 	val, _ := tryGetValueFromContext(ctx)
 	return val.Logger
 }
 
+// GetClient returns the IP address and user agent of the client that sent the
+// request, both are empty outside of requests.
+func GetClient(ctx context.Context) (string, string) {
+	val, ok := tryGetValueFromContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	return val.ClientIP, val.UserAgent
+}
+
 func SetTx(ctx context.Context, tx Committer) {
 	val, ok := tryGetValueFromContext(ctx)
 	if !ok {